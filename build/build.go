@@ -0,0 +1,114 @@
+// Package build synthesizes AST nodes for code generation. Its functions
+// wrap the generated parser constructors: they assign node IDs, use zero
+// locations and set the flags the parser would set for the same code, such
+// as IGNORE_VISIBILITY on calls without a receiver or CONTAINS_SPLAT on
+// arrays with a splat.
+//
+// Built nodes have no source, so the unparser and the formatter choose
+// their layout, and their output can be spliced into a source with the
+// rewriter:
+//
+//	call := build.Call(build.Self(), "puts", build.Str("hi"))
+//	source, err := unparser.Unparse(call)
+//
+// Built nodes have negative node IDs, so that they never collide with the
+// IDs of the parsed nodes they are combined with. They are not on any line,
+// so none of them has the NEWLINE flag.
+package build
+
+import (
+	"sync/atomic"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+var lastID atomic.Int64
+
+func nextID() int {
+	return int(lastID.Add(-1))
+}
+
+// loc returns a present optional location. It has no source range, but
+// records which keyword or delimiter the node has.
+func loc() *parser.Location {
+	return &parser.Location{}
+}
+
+// Program returns the root of a file with the given statements.
+func Program(statements ...parser.Node) *parser.ProgramNode {
+	body := Statements(statements...)
+	return parser.NewProgramNode(nextID(), parser.Location{}, 0, locals(nil, body), body)
+}
+
+// Statements returns a list of statements. Statements given as statements
+// nodes are spliced into the list.
+func Statements(statements ...parser.Node) *parser.StatementsNode {
+	var body []parser.Node
+	for _, statement := range statements {
+		if list, ok := statement.(*parser.StatementsNode); ok {
+			if list != nil {
+				body = append(body, list.Body...)
+			}
+			continue
+		}
+		if statement != nil {
+			body = append(body, statement)
+		}
+	}
+	return parser.NewStatementsNode(nextID(), parser.Location{}, 0, body)
+}
+
+// body returns the statements of a scope or clause, or nil when there are
+// none, as the parser does.
+func body(statements []parser.Node) *parser.StatementsNode {
+	list := Statements(statements...)
+	if len(list.Body) == 0 {
+		return nil
+	}
+	return list
+}
+
+// orNil returns statements as a node, keeping nil a nil interface.
+func orNil(statements *parser.StatementsNode) parser.Node {
+	if statements == nil {
+		return nil
+	}
+	return statements
+}
+
+// locals returns the local variables of a scope: its parameters in order,
+// then the locals written in its body outside nested scopes.
+func locals(parameters *parser.ParametersNode, body *parser.StatementsNode) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if parameters != nil {
+		for _, name := range parameterNames(parameters) {
+			add(name)
+		}
+	}
+	var visit func(node parser.Node)
+	visit = func(node parser.Node) {
+		switch n := node.(type) {
+		case *parser.DefNode, *parser.ClassNode, *parser.ModuleNode, *parser.SingletonClassNode,
+			*parser.BlockNode, *parser.LambdaNode:
+			return
+		case *parser.LocalVariableWriteNode:
+			add(n.Name)
+		case *parser.LocalVariableTargetNode:
+			add(n.Name)
+		}
+		for _, child := range node.CompactChildNodes() {
+			visit(child)
+		}
+	}
+	if body != nil {
+		visit(body)
+	}
+	return names
+}
@@ -0,0 +1,124 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/unparser"
+)
+
+// TestBuild checks that built nodes unparse into the source, which parses
+// back into nodes of the same structure. Built nodes hold no parentheses, so
+// where the unparser adds them the parsed node only has to unparse into the
+// same source. The locals the sources read are assigned first, so that they
+// parse as locals.
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		node   parser.Node
+		source string
+	}{
+		{Call(nil, "puts", Str("hi")), `puts "hi"`},
+		{Call(Local("a"), "+", Int(1)), "a + 1"},
+		{SafeCall(Ivar("@user"), "name"), "@user&.name"},
+		{Call(Const("Foo"), "new", Kwargs(Pair(Sym("a"), Int(1)))), "Foo.new(a: 1)"},
+		{Call(nil, "each", BlockArg(Sym("to_s"))), "each(&:to_s)"},
+		{Not(Local("a")), "!a"},
+		{And(True(), Or(False(), Nil())), "true and false || nil"},
+		{Array(Int(1), Splat(Local("a"))), "[1, *a]"},
+		{Hash(Pair(Str("k"), Float(1.5)), DoubleSplat(Local("a"))), `{ "k" => 1.5, **a }`},
+		{Range(Int(1), Int(2)), "1..2"},
+		{ExclusiveRange(Int(1), nil), "(1...)"},
+		{Interpolate(Str("a"), Local("a")), `"a#{a}"`},
+		{LocalWrite("x", Int(1)), "x = 1"},
+		{IvarWrite("@x", Self()), "@x = self"},
+		{GvarWrite("$x", Gvar("$y")), "$x = $y"},
+		{CvarWrite("@@x", Cvar("@@y")), "@@x = @@y"},
+		{ConstWrite("X", ConstPath(Const("A"), "B")), "X = A::B"},
+		{If(Local("a"), Int(1), Int(2)), "if a\n  1\nelse\n  2\nend"},
+		{Unless(Local("a"), Return(Int(1)), nil), "unless a\n  return 1\nend"},
+		{While(Local("a"), Call(nil, "step")), "while a\n  step()\nend"},
+		{
+			Def("initialize", Params(Param("a"), OptionalParam("b", Int(1)), RestParam("c"), KeywordParam("d", nil), KeywordRestParam("e"), BlockParam("f")), Super()),
+			"def initialize(a, b = 1, *c, d:, **e, &f)\n  super()\nend",
+		},
+		{SingletonDef(Self(), "build", nil, Yield(Int(1))), "def self.build\n  yield 1\nend"},
+		{Call(nil, "foo", Block(Params(Param("x")), Local("x"))), "foo { |x| x }"},
+		{Class("Foo::Bar", Const("Base"), Call(nil, "attr_reader", Sym("x"))), "class Foo::Bar < Base\n  attr_reader :x\nend"},
+		{Module("Baz"), "module Baz\nend"},
+	}
+	parenthesized := map[string]bool{
+		"Foo.new(a: 1)":          true,
+		"each(&:to_s)":           true,
+		"(1...)":                 true,
+		"while a\n  step()\nend": true,
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			source, err := unparser.Unparse(test.node)
+			if err != nil {
+				t.Fatalf("Unparse() failed: %v", err)
+			}
+			if source != test.source {
+				t.Errorf("Unparse() = %q, want %q", source, test.source)
+			}
+			statements := parsetest.Parse(t, "a = nil\n"+source).Value.Statements.Body
+			parsed := statements[len(statements)-1]
+			if parenthesized[test.source] {
+				if again, err := unparser.Unparse(parsed); err != nil || again != source {
+					t.Errorf("Unparse(parsed) = %q, %v, want %q", again, err, source)
+				}
+				return
+			}
+			if !parser.Equal(test.node, parsed, parser.IgnoreLocations(), parser.IgnoreNodeIDs(), parser.IgnoreFlags()) {
+				t.Errorf("built node =\n%v\nwant\n%v", test.node, parsed)
+			}
+		})
+	}
+}
+
+// TestBuildFlags checks that built nodes have the flags of the parsed
+// nodes, but for NEWLINE.
+func TestBuildFlags(t *testing.T) {
+	tests := []struct {
+		node   parser.Node
+		source string
+	}{
+		{Call(nil, "foo"), "foo()"},
+		{SafeCall(Local("a"), "b"), "a&.b"},
+		{Array(Splat(Local("a"))), "[*a]"},
+		{Int(10), "10"},
+		{Str("s"), `"s"`},
+		{Sym("s"), ":s"},
+		{ExclusiveRange(Int(1), Int(2)), "1...2"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			parsed := parsetest.Parse(t, test.source).Value.Statements.Body[0]
+			want := parsed.Flags().Bits() &^ parser.NodeFlagsNEWLINE
+			if got := test.node.Flags().Bits(); got != want {
+				t.Errorf("Flags() = %s, want the flags of %s without NEWLINE", test.node.Flags(), parsed.Flags())
+			}
+		})
+	}
+}
+
+func TestBuildProgram(t *testing.T) {
+	program := Program(LocalWrite("x", Int(1)), Statements(Call(nil, "p", Local("x"))), nil)
+	if len(program.Statements.Body) != 2 {
+		t.Fatalf("%d statements, want 2", len(program.Statements.Body))
+	}
+	if len(program.Locals) != 1 || program.Locals[0] != "x" {
+		t.Errorf("Locals = %v, want [x]", program.Locals)
+	}
+	if id := program.GetNodeID(); id >= 0 {
+		t.Errorf("node ID = %d, want a negative ID", id)
+	}
+	source, err := unparser.Unparse(program)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "x = 1\np x"; source != want {
+		t.Errorf("Unparse() = %q, want %q", source, want)
+	}
+}
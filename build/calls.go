@@ -0,0 +1,148 @@
+package build
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Call returns a method call. A nil receiver calls a method of self
+// without a receiver, as in puts x. A block or a block argument given as
+// the last argument becomes the block of the call:
+//
+//	build.Call(build.Local("list"), "each", build.Block(build.Params(build.Param("x")),
+//		build.Call(nil, "puts", build.Local("x"))))
+func Call(receiver parser.Node, name string, arguments ...parser.Node) *parser.CallNode {
+	return newCall(receiver, name, arguments, 0)
+}
+
+// SafeCall returns a call with safe navigation, as in a&.b.
+func SafeCall(receiver parser.Node, name string, arguments ...parser.Node) *parser.CallNode {
+	return newCall(receiver, name, arguments, parser.CallNodeFlagsSAFE_NAVIGATION)
+}
+
+func newCall(receiver parser.Node, name string, arguments []parser.Node, flags uint32) *parser.CallNode {
+	arguments, block := splitBlock(arguments)
+	switch receiver.(type) {
+	case nil, *parser.SelfNode:
+		// Private methods can be called on self.
+		flags |= parser.CallNodeFlagsIGNORE_VISIBILITY
+	}
+	var operator *parser.Location
+	if receiver != nil && isSetter(name) {
+		flags |= parser.CallNodeFlagsATTRIBUTE_WRITE
+	}
+	if receiver != nil && (flags&parser.CallNodeFlagsSAFE_NAVIGATION != 0 || isMethodName(name)) {
+		operator = loc()
+	}
+	return parser.NewCallNode(nextID(), parser.Location{}, flags, receiver, operator, name, loc(), nil, Arguments(arguments...), nil, block)
+}
+
+// splitBlock separates a trailing block or block argument from the
+// arguments of a call.
+func splitBlock(arguments []parser.Node) ([]parser.Node, parser.Node) {
+	if len(arguments) == 0 {
+		return arguments, nil
+	}
+	switch last := arguments[len(arguments)-1].(type) {
+	case *parser.BlockNode, *parser.BlockArgumentNode:
+		return arguments[:len(arguments)-1], last
+	}
+	return arguments, nil
+}
+
+// isSetter reports whether a method is called by assignment, as in
+// a.b = c or a[b] = c.
+func isSetter(name string) bool {
+	switch name {
+	case "==", "!=", "<=", ">=", "===":
+		return false
+	}
+	return strings.HasSuffix(name, "=")
+}
+
+// isMethodName reports whether a method is called with a dot, unlike
+// operators and [].
+func isMethodName(name string) bool {
+	return ruby.IsIdentifier(strings.TrimRight(name, "?!="))
+}
+
+// Arguments returns the arguments of a call, or nil when there are none.
+// Keyword arguments are given with Kwargs.
+func Arguments(arguments ...parser.Node) *parser.ArgumentsNode {
+	if len(arguments) == 0 {
+		return nil
+	}
+	var flags uint32
+	splats := 0
+	for _, argument := range arguments {
+		switch argument := argument.(type) {
+		case *parser.SplatNode:
+			splats++
+		case *parser.KeywordHashNode:
+			flags |= parser.ArgumentsNodeFlagsCONTAINS_KEYWORDS
+			if contains[*parser.AssocSplatNode](argument.Elements) {
+				flags |= parser.ArgumentsNodeFlagsCONTAINS_KEYWORD_SPLAT
+			}
+		case *parser.ForwardingArgumentsNode:
+			flags |= parser.ArgumentsNodeFlagsCONTAINS_FORWARDING
+		}
+	}
+	if splats > 0 {
+		flags |= parser.ArgumentsNodeFlagsCONTAINS_SPLAT
+	}
+	if splats > 1 {
+		flags |= parser.ArgumentsNodeFlagsCONTAINS_MULTIPLE_SPLATS
+	}
+	return parser.NewArgumentsNode(nextID(), parser.Location{}, flags, arguments)
+}
+
+// Kwargs returns keyword arguments, as in f(a: 1, **b). Its elements are
+// pairs and double splats.
+func Kwargs(elements ...parser.Node) *parser.KeywordHashNode {
+	flags := uint32(parser.KeywordHashNodeFlagsSYMBOL_KEYS)
+	for _, element := range elements {
+		if assoc, ok := element.(*parser.AssocNode); !ok {
+			flags = 0
+		} else if _, ok := assoc.Key.(*parser.SymbolNode); !ok {
+			flags = 0
+		}
+	}
+	return parser.NewKeywordHashNode(nextID(), parser.Location{}, flags, elements)
+}
+
+// BlockArg returns &value, passed as the block of a call. A nil value
+// forwards the anonymous block parameter.
+func BlockArg(value parser.Node) *parser.BlockArgumentNode {
+	return parser.NewBlockArgumentNode(nextID(), parser.Location{}, 0, value, parser.Location{})
+}
+
+// Block returns a block with the given parameters, which may be nil.
+func Block(parameters *parser.ParametersNode, statements ...parser.Node) *parser.BlockNode {
+	body := body(statements)
+	var blockParameters parser.Node
+	if parameters != nil {
+		blockParameters = parser.NewBlockParametersNode(nextID(), parser.Location{}, 0, parameters, nil, loc(), loc())
+	}
+	return parser.NewBlockNode(nextID(), parser.Location{}, 0, locals(parameters, body), blockParameters, orNil(body), parser.Location{}, parser.Location{})
+}
+
+// Yield returns a call of the block of the current method.
+func Yield(arguments ...parser.Node) *parser.YieldNode {
+	return parser.NewYieldNode(nextID(), parser.Location{}, 0, parser.Location{}, nil, Arguments(arguments...), nil)
+}
+
+// Super returns a call of the overridden method with the given arguments,
+// which may end with a block. Use ZSuper to pass the arguments of the
+// current method.
+func Super(arguments ...parser.Node) *parser.SuperNode {
+	arguments, block := splitBlock(arguments)
+	return parser.NewSuperNode(nextID(), parser.Location{}, 0, parser.Location{}, loc(), Arguments(arguments...), loc(), block)
+}
+
+// ZSuper returns super without arguments, which passes the arguments of
+// the current method. The block may be nil.
+func ZSuper(block *parser.BlockNode) *parser.ForwardingSuperNode {
+	return parser.NewForwardingSuperNode(nextID(), parser.Location{}, 0, block)
+}
@@ -0,0 +1,73 @@
+package build
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// If returns a conditional. The branches are single statements or
+// statements nodes, and may be nil. An if node as the else branch is an
+// elsif clause.
+func If(predicate, then, otherwise parser.Node) *parser.IfNode {
+	var subsequent parser.Node
+	switch otherwise := otherwise.(type) {
+	case nil:
+	case *parser.IfNode, *parser.ElseNode:
+		subsequent = otherwise
+	default:
+		subsequent = Else(otherwise)
+	}
+	return parser.NewIfNode(nextID(), parser.Location{}, 0, loc(), predicate, nil, branch(then), subsequent, loc())
+}
+
+// Unless returns a negated conditional. The branches are single
+// statements or statements nodes, and may be nil.
+func Unless(predicate, then, otherwise parser.Node) *parser.UnlessNode {
+	var elseClause *parser.ElseNode
+	if otherwise != nil {
+		elseClause = Else(otherwise)
+	}
+	return parser.NewUnlessNode(nextID(), parser.Location{}, 0, parser.Location{}, predicate, nil, branch(then), elseClause, loc())
+}
+
+// Else returns the else clause of a conditional.
+func Else(statements parser.Node) *parser.ElseNode {
+	return parser.NewElseNode(nextID(), parser.Location{}, 0, parser.Location{}, branch(statements), loc())
+}
+
+func branch(statements parser.Node) *parser.StatementsNode {
+	if statements == nil {
+		return nil
+	}
+	return body([]parser.Node{statements})
+}
+
+// While returns a loop that runs while the predicate holds.
+func While(predicate parser.Node, statements ...parser.Node) *parser.WhileNode {
+	return parser.NewWhileNode(nextID(), parser.Location{}, 0, parser.Location{}, nil, loc(), predicate, body(statements))
+}
+
+// Until returns a loop that runs until the predicate holds.
+func Until(predicate parser.Node, statements ...parser.Node) *parser.UntilNode {
+	return parser.NewUntilNode(nextID(), parser.Location{}, 0, parser.Location{}, nil, loc(), predicate, body(statements))
+}
+
+// Return returns a return statement. Several values are returned as an
+// array.
+func Return(values ...parser.Node) *parser.ReturnNode {
+	return parser.NewReturnNode(nextID(), parser.Location{}, 0, parser.Location{}, Arguments(values...))
+}
+
+// And returns left && right.
+func And(left, right parser.Node) *parser.AndNode {
+	return parser.NewAndNode(nextID(), parser.Location{}, 0, left, right, parser.Location{})
+}
+
+// Or returns left || right.
+func Or(left, right parser.Node) *parser.OrNode {
+	return parser.NewOrNode(nextID(), parser.Location{}, 0, left, right, parser.Location{})
+}
+
+// Not returns !value.
+func Not(value parser.Node) *parser.CallNode {
+	return Call(value, "!")
+}
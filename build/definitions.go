@@ -0,0 +1,176 @@
+package build
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Def returns a method definition with the given parameters, which may be
+// nil. The locals of the method are its parameters and the locals written
+// in its body.
+func Def(name string, parameters *parser.ParametersNode, statements ...parser.Node) *parser.DefNode {
+	return newDef(nil, name, parameters, statements)
+}
+
+// SingletonDef returns a definition of a method of receiver, as in
+// def self.name.
+func SingletonDef(receiver parser.Node, name string, parameters *parser.ParametersNode, statements ...parser.Node) *parser.DefNode {
+	return newDef(receiver, name, parameters, statements)
+}
+
+func newDef(receiver parser.Node, name string, parameters *parser.ParametersNode, statements []parser.Node) *parser.DefNode {
+	body := body(statements)
+	var operator, lparen, rparen *parser.Location
+	if receiver != nil {
+		operator = loc()
+	}
+	if parameters != nil {
+		lparen, rparen = loc(), loc()
+	}
+	return parser.NewDefNode(nextID(), parser.Location{}, 0, name, parser.Location{}, receiver, parameters, orNil(body),
+		locals(parameters, body), parser.Location{}, operator, lparen, rparen, nil, loc())
+}
+
+// Params returns the parameters of a method or block. They are given in
+// the order they are written: required parameters before a rest parameter
+// are leading, and those after it trailing.
+func Params(parameters ...parser.Node) *parser.ParametersNode {
+	var requireds, optionals, posts, keywords []parser.Node
+	var rest, keywordRest parser.Node
+	var block *parser.BlockParameterNode
+	for _, parameter := range parameters {
+		switch parameter := parameter.(type) {
+		case *parser.RequiredParameterNode, *parser.MultiTargetNode:
+			if rest == nil && len(optionals) == 0 {
+				requireds = append(requireds, parameter)
+			} else {
+				posts = append(posts, parameter)
+			}
+		case *parser.OptionalParameterNode:
+			optionals = append(optionals, parameter)
+		case *parser.RestParameterNode:
+			rest = parameter
+		case *parser.RequiredKeywordParameterNode, *parser.OptionalKeywordParameterNode:
+			keywords = append(keywords, parameter)
+		case *parser.KeywordRestParameterNode, *parser.NoKeywordsParameterNode, *parser.ForwardingParameterNode:
+			keywordRest = parameter
+		case *parser.BlockParameterNode:
+			block = parameter
+		}
+	}
+	return parser.NewParametersNode(nextID(), parser.Location{}, 0, requireds, optionals, rest, posts, keywords, keywordRest, block)
+}
+
+// parameterNames returns the names of the parameters in the order the
+// parser declares them as locals.
+func parameterNames(parameters *parser.ParametersNode) []string {
+	var names []string
+	nodes := slices.Concat(parameters.Requireds, parameters.Optionals, []parser.Node{parameters.Rest}, parameters.Posts,
+		parameters.Keywords, []parser.Node{parameters.KeywordRest})
+	if parameters.Block != nil {
+		nodes = append(nodes, parameters.Block)
+	}
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *parser.RequiredParameterNode:
+			names = append(names, n.Name)
+		case *parser.OptionalParameterNode:
+			names = append(names, n.Name)
+		case *parser.RequiredKeywordParameterNode:
+			names = append(names, n.Name)
+		case *parser.OptionalKeywordParameterNode:
+			names = append(names, n.Name)
+		case *parser.RestParameterNode:
+			names = appendName(names, n.Name)
+		case *parser.KeywordRestParameterNode:
+			names = appendName(names, n.Name)
+		case *parser.BlockParameterNode:
+			names = appendName(names, n.Name)
+		}
+	}
+	return names
+}
+
+func appendName(names []string, name *string) []string {
+	if name == nil {
+		return names
+	}
+	return append(names, *name)
+}
+
+// Param returns a required parameter.
+func Param(name string) *parser.RequiredParameterNode {
+	return parser.NewRequiredParameterNode(nextID(), parser.Location{}, 0, name)
+}
+
+// OptionalParam returns a parameter with a default value, as in a = 1.
+func OptionalParam(name string, value parser.Node) *parser.OptionalParameterNode {
+	return parser.NewOptionalParameterNode(nextID(), parser.Location{}, 0, name, parser.Location{}, parser.Location{}, value)
+}
+
+// RestParam returns a rest parameter, as in *args. An empty name is the
+// anonymous rest parameter.
+func RestParam(name string) *parser.RestParameterNode {
+	pointer, nameLoc := optionalName(name)
+	return parser.NewRestParameterNode(nextID(), parser.Location{}, 0, pointer, nameLoc, parser.Location{})
+}
+
+// KeywordParam returns a keyword parameter. A nil value makes it required.
+func KeywordParam(name string, value parser.Node) parser.Node {
+	if value == nil {
+		return parser.NewRequiredKeywordParameterNode(nextID(), parser.Location{}, 0, name, parser.Location{})
+	}
+	return parser.NewOptionalKeywordParameterNode(nextID(), parser.Location{}, 0, name, parser.Location{}, value)
+}
+
+// KeywordRestParam returns a keyword rest parameter, as in **options. An
+// empty name is the anonymous keyword rest parameter.
+func KeywordRestParam(name string) *parser.KeywordRestParameterNode {
+	pointer, nameLoc := optionalName(name)
+	return parser.NewKeywordRestParameterNode(nextID(), parser.Location{}, 0, pointer, nameLoc, parser.Location{})
+}
+
+// BlockParam returns a block parameter, as in &block. An empty name is the
+// anonymous block parameter.
+func BlockParam(name string) *parser.BlockParameterNode {
+	pointer, nameLoc := optionalName(name)
+	return parser.NewBlockParameterNode(nextID(), parser.Location{}, 0, pointer, nameLoc, parser.Location{})
+}
+
+func optionalName(name string) (*string, *parser.Location) {
+	if name == "" {
+		return nil, nil
+	}
+	return &name, loc()
+}
+
+// Class returns a class definition. The path is a constant name such as
+// "Foo::Bar", and the superclass may be nil.
+func Class(path string, superclass parser.Node, statements ...parser.Node) *parser.ClassNode {
+	constant := Namespace(path)
+	body := body(statements)
+	var operator *parser.Location
+	if superclass != nil {
+		operator = loc()
+	}
+	return parser.NewClassNode(nextID(), parser.Location{}, 0, locals(nil, body), parser.Location{}, constant, operator, superclass,
+		orNil(body), parser.Location{}, lastName(path))
+}
+
+// Module returns a module definition. The path is a constant name such as
+// "Foo::Bar".
+func Module(path string, statements ...parser.Node) *parser.ModuleNode {
+	constant := Namespace(path)
+	body := body(statements)
+	return parser.NewModuleNode(nextID(), parser.Location{}, 0, locals(nil, body), parser.Location{}, constant, orNil(body),
+		parser.Location{}, lastName(path))
+}
+
+func lastName(path string) string {
+	if index := strings.LastIndex(path, "::"); index >= 0 {
+		return path[index+len("::"):]
+	}
+	return path
+}
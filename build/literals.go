@@ -0,0 +1,197 @@
+package build
+
+import (
+	"unicode/utf8"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Nil returns nil.
+func Nil() *parser.NilNode {
+	return parser.NewNilNode(nextID(), parser.Location{}, parser.NodeFlagsSTATIC_LITERAL)
+}
+
+// True returns true.
+func True() *parser.TrueNode {
+	return parser.NewTrueNode(nextID(), parser.Location{}, parser.NodeFlagsSTATIC_LITERAL)
+}
+
+// False returns false.
+func False() *parser.FalseNode {
+	return parser.NewFalseNode(nextID(), parser.Location{}, parser.NodeFlagsSTATIC_LITERAL)
+}
+
+// Self returns self.
+func Self() *parser.SelfNode {
+	return parser.NewSelfNode(nextID(), parser.Location{}, 0)
+}
+
+// Int returns a decimal integer literal.
+func Int(value int64) *parser.IntegerNode {
+	return parser.NewIntegerNode(nextID(), parser.Location{}, parser.NodeFlagsSTATIC_LITERAL|parser.IntegerBaseFlagsDECIMAL, value)
+}
+
+// Float returns a float literal.
+func Float(value float64) *parser.FloatNode {
+	return parser.NewFloatNode(nextID(), parser.Location{}, parser.NodeFlagsSTATIC_LITERAL, value)
+}
+
+// Str returns a string literal.
+func Str(value string) *parser.StringNode {
+	return parser.NewStringNode(nextID(), parser.Location{}, 0, loc(), parser.Location{}, loc(), rubyString(value))
+}
+
+// Sym returns a symbol literal.
+func Sym(name string) *parser.SymbolNode {
+	flags := uint32(parser.NodeFlagsSTATIC_LITERAL)
+	if ascii(name) {
+		flags |= parser.SymbolFlagsFORCED_US_ASCII_ENCODING
+	}
+	return parser.NewSymbolNode(nextID(), parser.Location{}, flags, loc(), loc(), nil, rubyString(name))
+}
+
+func rubyString(value string) parser.RubyString {
+	return parser.RubyString{Value: value, Encoding: "UTF-8", ValidEncoding: utf8.ValidString(value)}
+}
+
+func ascii(value string) bool {
+	for index := 0; index < len(value); index++ {
+		if value[index] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// Interpolate returns an interpolated string, as in "a#{b}c". Strings among
+// the parts are literal text, and other nodes are interpolated.
+func Interpolate(parts ...parser.Node) *parser.InterpolatedStringNode {
+	list := make([]parser.Node, len(parts))
+	for index, part := range parts {
+		if s, ok := part.(*parser.StringNode); ok {
+			// Literal parts have no delimiters of their own.
+			list[index] = parser.NewStringNode(s.NodeID, parser.Location{}, parser.NodeFlagsSTATIC_LITERAL|parser.StringFlagsFROZEN,
+				nil, parser.Location{}, nil, s.Unescaped)
+			continue
+		}
+		list[index] = parser.NewEmbeddedStatementsNode(nextID(), parser.Location{}, 0, parser.Location{}, Statements(part), parser.Location{})
+	}
+	return parser.NewInterpolatedStringNode(nextID(), parser.Location{}, 0, loc(), list, loc())
+}
+
+// Array returns an array literal.
+func Array(elements ...parser.Node) *parser.ArrayNode {
+	var flags uint32
+	if contains[*parser.SplatNode](elements) {
+		flags |= parser.ArrayNodeFlagsCONTAINS_SPLAT
+	}
+	if staticLiterals(elements) {
+		flags |= parser.NodeFlagsSTATIC_LITERAL
+	}
+	return parser.NewArrayNode(nextID(), parser.Location{}, flags, elements, loc(), loc())
+}
+
+// Hash returns a hash literal. Its elements are pairs and double splats.
+func Hash(elements ...parser.Node) *parser.HashNode {
+	var flags uint32
+	if staticLiterals(elements) {
+		flags |= parser.NodeFlagsSTATIC_LITERAL
+	}
+	return parser.NewHashNode(nextID(), parser.Location{}, flags, parser.Location{}, elements, parser.Location{})
+}
+
+// Pair returns a key and value of a hash or of keyword arguments. Symbol
+// keys are written as labels, as in { key: value }.
+func Pair(key, value parser.Node) *parser.AssocNode {
+	var operator *parser.Location
+	switch k := key.(type) {
+	case *parser.SymbolNode:
+		// A label has no opening colon, but a closing one.
+		key = parser.NewSymbolNode(k.NodeID, parser.Location{}, k.Flags().Bits(), nil, k.ValueLoc, loc(), k.Unescaped)
+	case *parser.StringNode:
+		// String keys are frozen.
+		key = parser.NewStringNode(k.NodeID, parser.Location{}, parser.NodeFlagsSTATIC_LITERAL|parser.StringFlagsFROZEN,
+			k.OpeningLoc, parser.Location{}, k.ClosingLoc, k.Unescaped)
+		operator = loc()
+	default:
+		operator = loc()
+	}
+	var flags uint32
+	if staticLiteral(key) && staticLiteral(value) {
+		flags |= parser.NodeFlagsSTATIC_LITERAL
+	}
+	return parser.NewAssocNode(nextID(), parser.Location{}, flags, key, value, operator)
+}
+
+// DoubleSplat returns **value, within a hash or keyword arguments.
+func DoubleSplat(value parser.Node) *parser.AssocSplatNode {
+	return parser.NewAssocSplatNode(nextID(), parser.Location{}, 0, value, parser.Location{})
+}
+
+// Splat returns *value, within an array or arguments.
+func Splat(value parser.Node) *parser.SplatNode {
+	return parser.NewSplatNode(nextID(), parser.Location{}, 0, parser.Location{}, value)
+}
+
+// Range returns an inclusive range, as in a..b. Either end may be nil.
+func Range(left, right parser.Node) *parser.RangeNode {
+	return newRange(left, right, 0)
+}
+
+// ExclusiveRange returns a range that excludes its end, as in a...b.
+// Either end may be nil.
+func ExclusiveRange(left, right parser.Node) *parser.RangeNode {
+	return newRange(left, right, parser.RangeFlagsEXCLUDE_END)
+}
+
+func newRange(left, right parser.Node, flags uint32) *parser.RangeNode {
+	if integerOrNil(left) && integerOrNil(right) {
+		flags |= parser.NodeFlagsSTATIC_LITERAL
+	}
+	return parser.NewRangeNode(nextID(), parser.Location{}, flags, left, right, parser.Location{})
+}
+
+func integerOrNil(node parser.Node) bool {
+	switch node.(type) {
+	case nil, *parser.IntegerNode, *parser.NilNode:
+		return true
+	}
+	return false
+}
+
+// staticLiteral reports whether the parser marks a node as a literal whose
+// value is known without evaluating anything. Collections only get the flag
+// when all their elements are such literals, but do not count as one.
+func staticLiteral(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.IntegerNode, *parser.FloatNode, *parser.RationalNode, *parser.ImaginaryNode,
+		*parser.SymbolNode, *parser.NilNode, *parser.TrueNode, *parser.FalseNode:
+		return true
+	case *parser.StringNode:
+		return n.IsFROZEN()
+	case *parser.RangeNode:
+		return n.IsStaticLiteral()
+	}
+	return false
+}
+
+func staticLiterals(elements []parser.Node) bool {
+	for _, element := range elements {
+		if assoc, ok := element.(*parser.AssocNode); ok && assoc.IsStaticLiteral() {
+			continue
+		}
+		if !staticLiteral(element) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains[T parser.Node](nodes []parser.Node) bool {
+	for _, node := range nodes {
+		if _, ok := node.(T); ok {
+			return true
+		}
+	}
+	return false
+}
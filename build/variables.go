@@ -0,0 +1,82 @@
+package build
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Local returns a read of a local variable of the current scope. Set Depth
+// on the node to read a local of an enclosing block.
+func Local(name string) *parser.LocalVariableReadNode {
+	return parser.NewLocalVariableReadNode(nextID(), parser.Location{}, 0, name, 0)
+}
+
+// LocalWrite returns an assignment to a local variable of the current
+// scope. The enclosing Program, Def or Block declares the variable.
+func LocalWrite(name string, value parser.Node) *parser.LocalVariableWriteNode {
+	return parser.NewLocalVariableWriteNode(nextID(), parser.Location{}, 0, name, 0, parser.Location{}, value, parser.Location{})
+}
+
+// Ivar returns a read of an instance variable. The name includes the @.
+func Ivar(name string) *parser.InstanceVariableReadNode {
+	return parser.NewInstanceVariableReadNode(nextID(), parser.Location{}, 0, name)
+}
+
+// IvarWrite returns an assignment to an instance variable.
+func IvarWrite(name string, value parser.Node) *parser.InstanceVariableWriteNode {
+	return parser.NewInstanceVariableWriteNode(nextID(), parser.Location{}, 0, name, parser.Location{}, value, parser.Location{})
+}
+
+// Cvar returns a read of a class variable. The name includes the @@.
+func Cvar(name string) *parser.ClassVariableReadNode {
+	return parser.NewClassVariableReadNode(nextID(), parser.Location{}, 0, name)
+}
+
+// CvarWrite returns an assignment to a class variable.
+func CvarWrite(name string, value parser.Node) *parser.ClassVariableWriteNode {
+	return parser.NewClassVariableWriteNode(nextID(), parser.Location{}, 0, name, parser.Location{}, value, parser.Location{})
+}
+
+// Gvar returns a read of a global variable. The name includes the $.
+func Gvar(name string) *parser.GlobalVariableReadNode {
+	return parser.NewGlobalVariableReadNode(nextID(), parser.Location{}, 0, name)
+}
+
+// GvarWrite returns an assignment to a global variable.
+func GvarWrite(name string, value parser.Node) *parser.GlobalVariableWriteNode {
+	return parser.NewGlobalVariableWriteNode(nextID(), parser.Location{}, 0, name, parser.Location{}, value, parser.Location{})
+}
+
+// Const returns a read of a constant looked up lexically, as in Foo.
+func Const(name string) *parser.ConstantReadNode {
+	return parser.NewConstantReadNode(nextID(), parser.Location{}, 0, name)
+}
+
+// ConstPath returns a read of a constant of a namespace, as in Foo::Bar.
+// A nil parent is the top level, as in ::Bar.
+func ConstPath(parent parser.Node, name string) *parser.ConstantPathNode {
+	return parser.NewConstantPathNode(nextID(), parser.Location{}, 0, parent, &name, parser.Location{}, parser.Location{})
+}
+
+// Namespace returns the constant read by a path such as "Foo::Bar". A
+// leading "::" starts from the top level.
+func Namespace(path string) parser.Node {
+	var node parser.Node
+	for index, name := range strings.Split(path, "::") {
+		switch {
+		case index == 0 && name == "":
+			continue
+		case index == 0:
+			node = Const(name)
+		default:
+			node = ConstPath(node, name)
+		}
+	}
+	return node
+}
+
+// ConstWrite returns an assignment to a constant of the current namespace.
+func ConstWrite(name string, value parser.Node) *parser.ConstantWriteNode {
+	return parser.NewConstantWriteNode(nextID(), parser.Location{}, 0, name, parser.Location{}, value, parser.Location{})
+}
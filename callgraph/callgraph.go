@@ -0,0 +1,145 @@
+// Package callgraph builds the static call graph of a project: the calls,
+// super calls and yields of each method, with the methods they call as far
+// as they can be worked out without running the code.
+//
+// Callers and callees are named like in the symbols package, such as
+// Admin::User#save for an instance method and Admin::User.find for a
+// singleton method. Code outside of methods is attributed to the class or
+// module body it is in, or to main at the top level. Receivers are
+// resolved heuristically:
+//
+//   - no receiver and self call a method of self: an instance method within
+//     an instance method, a singleton method within a singleton method, a
+//     class body or class << self, and a method of Object at the top level;
+//   - a constant calls a singleton method of the class or module it
+//     resolves to;
+//   - Foo.new, new within a singleton method of Foo, and a local variable
+//     only ever assigned one of those, call an instance method of Foo;
+//   - super calls the method of the same name of the nearest ancestor
+//     defining it in the project.
+//
+// Other receivers are unknown. send, public_send and __send__ with a
+// literal name call the named method, and are flagged as dynamic dispatch
+// otherwise:
+//
+//	resolver := constants.NewResolver(tables)
+//	graph := callgraph.New(resolver, tables)
+//	for index, table := range tables {
+//		graph.Add(table, roots[index])
+//	}
+//	for _, site := range graph.Callers("User#save") {
+//		fmt.Println(site.File, site.Caller, site.Node.GetLocation())
+//	}
+package callgraph
+
+import (
+	"github.com/danielgatis/go-ruby-prism/constants"
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// Kind is the kind of a call site.
+type Kind int
+
+const (
+	// Call is a method call.
+	Call Kind = iota
+	// Super is super with arguments.
+	Super
+	// ForwardingSuper is super without arguments, which passes the
+	// arguments of the current method.
+	ForwardingSuper
+	// Yield calls the block of the current method.
+	Yield
+)
+
+var kindNames = enum.Names[Kind]{
+	Call:            "call",
+	Super:           "super",
+	ForwardingSuper: "forwarding super",
+	Yield:           "yield",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Site is a call site.
+type Site struct {
+	Kind Kind
+	File string
+	// Node is a *parser.CallNode, *parser.SuperNode,
+	// *parser.ForwardingSuperNode or *parser.YieldNode.
+	Node parser.Node
+	// Caller is the qualified name of the method the site is in, or of the
+	// class or module body, or main at the top level.
+	Caller string
+	// Name is the name of the method called: the literal name given to
+	// send, and for super the name of the current method. It is empty for
+	// yield.
+	Name string
+	// Target is the qualified name of the method called, or empty if the
+	// receiver is unknown.
+	Target string
+	// Dynamic reports whether the method is called with send, public_send
+	// or __send__ and a name that is not a literal.
+	Dynamic bool
+}
+
+// Graph is the call graph of a project.
+type Graph struct {
+	// Sites are the call sites of the files added, in the order they are
+	// added and then in source order.
+	Sites    []*Site
+	resolver *constants.Resolver
+	// methods are the qualified names of the methods of the project.
+	methods map[string]bool
+}
+
+// New returns an empty call graph for the methods and constants of a
+// project. The tables are the definition indexes of its files, and the
+// resolver resolves constants across them.
+func New(resolver *constants.Resolver, tables []*symbols.Table) *Graph {
+	g := &Graph{resolver: resolver, methods: map[string]bool{}}
+	for _, table := range tables {
+		for _, s := range table.Symbols {
+			if s.Kind == symbols.Method || s.Kind == symbols.SingletonMethod {
+				g.methods[s.QualifiedName] = true
+			}
+		}
+	}
+	return g
+}
+
+// Callers returns the sites that call a method, by qualified name.
+func (g *Graph) Callers(target string) []*Site {
+	return g.filter(func(s *Site) bool { return s.Target == target })
+}
+
+// Callees returns the sites within a method, class or module body, by
+// qualified name.
+func (g *Graph) Callees(caller string) []*Site {
+	return g.filter(func(s *Site) bool { return s.Caller == caller })
+}
+
+// Calls returns the sites that call a method by name, whatever the
+// receiver, including those whose receiver is unknown.
+func (g *Graph) Calls(name string) []*Site {
+	return g.filter(func(s *Site) bool { return s.Name == name })
+}
+
+// Dynamic returns the sites that dispatch dynamically.
+func (g *Graph) Dynamic() []*Site {
+	return g.filter(func(s *Site) bool { return s.Dynamic })
+}
+
+func (g *Graph) filter(keep func(*Site) bool) []*Site {
+	var sites []*Site
+	for _, s := range g.Sites {
+		if keep(s) {
+			sites = append(sites, s)
+		}
+	}
+	return sites
+}
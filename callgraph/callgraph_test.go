@@ -0,0 +1,143 @@
+package callgraph_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/callgraph"
+	"github.com/danielgatis/go-ruby-prism/constants"
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// build parses files given as name and source pairs and builds their call
+// graph.
+func build(t *testing.T, files ...string) *callgraph.Graph {
+	t.Helper()
+	var tables []*symbols.Table
+	var roots []parser.Node
+	for index := 0; index < len(files); index += 2 {
+		root := parsetest.Parse(t, files[index+1]).Value
+		tables = append(tables, symbols.Collect(files[index], root))
+		roots = append(roots, root)
+	}
+	g := callgraph.New(constants.NewResolver(tables), tables)
+	for index, table := range tables {
+		g.Add(table, roots[index])
+	}
+	return g
+}
+
+// describe lists sites as caller -> target, with the kind unless it is a
+// call, the name when the target is unknown, and * for dynamic dispatch.
+func describe(sites []*callgraph.Site) string {
+	lines := make([]string, len(sites))
+	for index, s := range sites {
+		target := s.Target
+		if target == "" {
+			target = "?" + s.Name
+		}
+		if s.Dynamic {
+			target += "*"
+		}
+		if s.Kind != callgraph.Call {
+			target = s.Kind.String() + " " + target
+		}
+		lines[index] = s.Caller + " -> " + target
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestSites(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			"class User\n  def save\n    validate\n    self.log\n  end\nend",
+			"User#save -> User#validate\nUser#save -> User#log",
+		},
+		{
+			"class User\n  def self.find\n    where\n    new.save\n  end\nend",
+			"User.find -> User.where\nUser.find -> User#save\nUser.find -> User.new",
+		},
+		{
+			"class User\n  has_many :posts\n  User.find(1)\n  Other.thing\nend",
+			"User -> User.has_many\nUser -> User.find\nUser -> Other.thing",
+		},
+		{
+			"puts 1\ndef helper = 1\nhelper",
+			"main -> Object#puts\nmain -> Object#helper",
+		},
+		{
+			"u = User.new\nu.save\nv = User.new\nv = Post.new\nv.save\nobject.save",
+			"main -> User.new\nmain -> User#save\nmain -> User.new\nmain -> Post.new\nmain -> ?save\nmain -> ?save\nmain -> Object#object",
+		},
+		{
+			"class User\n  def run(name)\n    send(:save)\n    public_send(name)\n    yield\n  end\nend",
+			"User#run -> User#save\nUser#run -> ?public_send*\nUser#run -> yield ?",
+		},
+		{
+			"class A\n  class << self\n    attr_accessor :x\n    def build = create\n    new.save\n  end\nend",
+			"#<Class:A> -> A.attr_accessor\nA.build -> A.create\n#<Class:A> -> A#save\n#<Class:A> -> A.new",
+		},
+		{
+			"class << object\n  helper\nend",
+			"main -> Object#object\n#<Class:?> -> ?helper",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := describe(build(t, "app.rb", test.source).Sites); got != test.want {
+				t.Errorf("sites =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSuper(t *testing.T) {
+	g := build(t,
+		"base.rb", "class Base\n  def save(x) = x\n  def self.find = 1\nend\nmodule Logging\n  def save(x) = x\nend",
+		"user.rb", "class User < Base\n  include Logging\n  def save(x)\n    super(x)\n  end\n  def self.find\n    super\n  end\n  def other\n    super\n  end\nend",
+	)
+	want := "User -> User.include\nUser#save -> super Logging#save\nUser.find -> forwarding super Base.find\nUser#other -> forwarding super ?other"
+	if got := describe(g.Sites); got != want {
+		t.Errorf("sites =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	g := build(t,
+		"user.rb", "class User\n  def self.find(id) = new\n  def save = valid?\n  def valid? = true\nend",
+		"app.rb", "User.find(1)\nUser.new.save\nUser.new.send(name)\nrecord.save",
+	)
+	tests := []struct {
+		name  string
+		sites []*callgraph.Site
+		want  string
+	}{
+		{"Callers", g.Callers("User#save"), "main -> User#save"},
+		{"Callees", g.Callees("User#save"), "User#save -> User#valid?"},
+		{"Calls", g.Calls("save"), "main -> User#save\nmain -> ?save"},
+		{"Dynamic", g.Dynamic(), "main -> ?send*"},
+	}
+	for _, test := range tests {
+		if got := describe(test.sites); got != test.want {
+			t.Errorf("%s() =\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+	for _, site := range g.Callers("User.find") {
+		if site.File != "app.rb" {
+			t.Errorf("User.find called in %s, want app.rb", site.File)
+		}
+	}
+}
+
+func TestKindString(t *testing.T) {
+	got := fmt.Sprint(callgraph.ForwardingSuper, " ", callgraph.Kind(7))
+	if got != "forwarding super callgraph.Kind(7)" {
+		t.Errorf("Kind.String() = %q", got)
+	}
+}
@@ -0,0 +1,254 @@
+package callgraph
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/constants"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/scope"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// receiver is what a method is called on: the instances of a class or
+// module, or the class or module itself.
+type receiver struct {
+	// name is the qualified name of the class or module, or empty if it is
+	// unknown.
+	name     string
+	instance bool
+}
+
+func (r receiver) target(method string) string {
+	switch {
+	case r.name == "":
+		return ""
+	case r.instance:
+		return r.name + "#" + method
+	}
+	return r.name + "." + method
+}
+
+// frame is the context of a call site.
+type frame struct {
+	caller string
+	self   receiver
+	// method is the method the site is in, if any, and owner the class or
+	// module it is defined in.
+	method *symbols.Symbol
+	owner  string
+}
+
+type walker struct {
+	graph     *Graph
+	file      string
+	constants *constants.Result
+	variables *scope.Analysis
+	symbols   map[parser.Node]*symbols.Symbol
+}
+
+// Add adds the call sites of the tree rooted at root, whose definition
+// index is table.
+func (g *Graph) Add(table *symbols.Table, root parser.Node) {
+	w := &walker{
+		graph:     g,
+		file:      table.File,
+		constants: g.resolver.Resolve(table, root),
+		variables: scope.Analyze(root),
+		symbols:   map[parser.Node]*symbols.Symbol{},
+	}
+	for _, s := range table.Symbols {
+		w.symbols[s.Node] = s
+	}
+	w.visit(root, frame{caller: "main", self: receiver{name: "Object", instance: true}})
+}
+
+func (w *walker) visit(node parser.Node, f frame) {
+	if node == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ClassNode:
+		w.visit(n.ConstantPath, f)
+		w.visit(n.Superclass, f)
+		w.visit(n.Body, w.body(n, f))
+		return
+	case *parser.ModuleNode:
+		w.visit(n.ConstantPath, f)
+		w.visit(n.Body, w.body(n, f))
+		return
+	case *parser.SingletonClassNode:
+		w.visit(n.Expression, f)
+		w.visit(n.Body, w.body(n, f))
+		return
+	case *parser.DefNode:
+		w.visit(n.Receiver, f)
+		inner := w.method(n, f)
+		if n.Parameters != nil {
+			w.visit(n.Parameters, inner)
+		}
+		w.visit(n.Body, inner)
+		return
+	case *parser.CallNode:
+		w.call(n, f)
+	case *parser.SuperNode:
+		w.super(Super, n, f)
+	case *parser.ForwardingSuperNode:
+		w.super(ForwardingSuper, n, f)
+	case *parser.YieldNode:
+		w.add(&Site{Kind: Yield, Node: n}, f)
+	}
+	for _, child := range node.CompactChildNodes() {
+		w.visit(child, f)
+	}
+}
+
+// body returns the frame of a class, module or singleton class body, whose
+// self is the class or module. Within class << object, methods called on
+// self are named as singleton methods of the object, like the methods
+// defined there, so that Foo.find is called rather than #<Class:Foo>.find.
+func (w *walker) body(node parser.Node, f frame) frame {
+	s, ok := w.symbols[node]
+	if !ok {
+		return frame{caller: f.caller}
+	}
+	inner := frame{caller: s.QualifiedName, self: receiver{name: s.QualifiedName}}
+	if s.Kind == symbols.SingletonClass {
+		inner.self.name = strings.TrimSuffix(strings.TrimPrefix(s.QualifiedName, "#<Class:"), ">")
+		if inner.self.name == "?" {
+			inner.self.name = ""
+		}
+	}
+	return inner
+}
+
+// method returns the frame of a method body.
+func (w *walker) method(node *parser.DefNode, f frame) frame {
+	s, ok := w.symbols[node]
+	if !ok {
+		return frame{caller: f.caller}
+	}
+	inner := frame{caller: s.QualifiedName, method: s}
+	if s.Kind == symbols.Method {
+		inner.owner = strings.TrimSuffix(s.QualifiedName, "#"+s.Name)
+		inner.self = receiver{name: inner.owner, instance: true}
+	} else {
+		inner.owner = strings.TrimSuffix(s.QualifiedName, "."+s.Name)
+		if inner.owner != "?" {
+			inner.self = receiver{name: inner.owner}
+		}
+	}
+	return inner
+}
+
+func (w *walker) add(site *Site, f frame) {
+	site.File = w.file
+	site.Caller = f.caller
+	w.graph.Sites = append(w.graph.Sites, site)
+}
+
+func (w *walker) call(call *parser.CallNode, f frame) {
+	site := &Site{Kind: Call, Node: call, Name: call.Name}
+	r := w.receiver(call.Receiver, f)
+	switch call.Name {
+	case "send", "public_send", "__send__":
+		if call.Arguments == nil || len(call.Arguments.Arguments) == 0 {
+			break
+		}
+		switch name := call.Arguments.Arguments[0].(type) {
+		case *parser.SymbolNode:
+			site.Name = name.Unescaped.Value
+		case *parser.StringNode:
+			site.Name = name.Unescaped.Value
+		default:
+			site.Dynamic = true
+			w.add(site, f)
+			return
+		}
+	}
+	site.Target = r.target(site.Name)
+	w.add(site, f)
+}
+
+// super adds a super call, which calls the method of the same name of the
+// nearest ancestor of the owner of the current method that defines it.
+func (w *walker) super(kind Kind, node parser.Node, f frame) {
+	site := &Site{Kind: kind, Node: node}
+	if f.method != nil && f.owner != "" {
+		site.Name = f.method.Name
+		ancestors := w.graph.resolver.Ancestors(f.owner)
+		if index := slices.Index(ancestors, f.owner); index >= 0 {
+			self := receiver{instance: f.method.Kind == symbols.Method}
+			for _, ancestor := range ancestors[index+1:] {
+				self.name = ancestor
+				if target := self.target(site.Name); w.graph.methods[target] {
+					site.Target = target
+					break
+				}
+			}
+		}
+	}
+	w.add(site, f)
+}
+
+// receiver works out what a method is called on.
+func (w *walker) receiver(node parser.Node, f frame) receiver {
+	switch n := node.(type) {
+	case nil, *parser.SelfNode:
+		return f.self
+	case *parser.ConstantReadNode, *parser.ConstantPathNode:
+		return receiver{name: w.constant(n)}
+	case *parser.CallNode:
+		return receiver{name: w.instantiated(n, f), instance: true}
+	case *parser.LocalVariableReadNode:
+		v := w.variables.Resolve(n)
+		if v == nil || v.Kind != scope.Local || len(v.Writes) == 0 {
+			return receiver{}
+		}
+		name := ""
+		for index, write := range v.Writes {
+			assignment, ok := write.(*parser.LocalVariableWriteNode)
+			if !ok {
+				return receiver{}
+			}
+			class := w.instantiated(assignment.Value, f)
+			if class == "" || index > 0 && class != name {
+				return receiver{}
+			}
+			name = class
+		}
+		return receiver{name: name, instance: true}
+	}
+	return receiver{}
+}
+
+// instantiated returns the class a Foo.new call instantiates, or new
+// called on self within a class, or an empty string for other expressions.
+func (w *walker) instantiated(node parser.Node, f frame) string {
+	call, ok := node.(*parser.CallNode)
+	if !ok || call.Name != "new" {
+		return ""
+	}
+	switch call.Receiver.(type) {
+	case *parser.ConstantReadNode, *parser.ConstantPathNode:
+		return w.constant(call.Receiver)
+	case nil, *parser.SelfNode:
+		if !f.self.instance {
+			return f.self.name
+		}
+	}
+	return ""
+}
+
+// constant returns the qualified name of a constant, or the name it is
+// written with if it cannot be resolved.
+func (w *walker) constant(node parser.Node) string {
+	ref := w.constants.Reference(node)
+	switch {
+	case ref == nil || strings.HasPrefix(ref.Name, "?::"):
+		return ""
+	case ref.Resolved():
+		return ref.QualifiedName
+	}
+	return strings.TrimPrefix(ref.Name, "::")
+}
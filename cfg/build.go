@@ -0,0 +1,631 @@
+package cfg
+
+import (
+	"slices"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// loop is where break, next and redo lead within a loop, or within the
+// body of a block or lambda.
+type loop struct {
+	next, brk, redo *Block
+	ensures         int
+}
+
+// handler is where an exception raised within a begin with rescue clauses
+// leads.
+type handler struct {
+	block   *Block
+	ensures int
+}
+
+// ensure is an ensure clause that jumps out of its begin go through.
+type ensure struct {
+	entry *Block
+	// pending are the jumps that lead through the clause, and raised
+	// reports whether an exception does.
+	pending []jump
+	raised  bool
+}
+
+type jump struct {
+	to      *Block
+	ensures int
+}
+
+type builder struct {
+	g        *Graph
+	current  *Block
+	loops    []loop
+	handlers []handler
+	ensures  []*ensure
+	// retries are the bodies retry within a rescue clause leads back to.
+	retries []jump
+	flows   map[parser.Node]bool
+}
+
+// Build returns the control-flow graph of the body of a *parser.DefNode,
+// *parser.BlockNode, *parser.LambdaNode or *parser.ProgramNode. The source
+// is used for the labels of the DOT output and may be nil.
+func Build(node parser.Node, source []byte) (*Graph, bool) {
+	var body parser.Node
+	closure := false
+	switch n := node.(type) {
+	case *parser.DefNode:
+		body = n.Body
+	case *parser.BlockNode:
+		body, closure = n.Body, true
+	case *parser.LambdaNode:
+		body, closure = n.Body, true
+	case *parser.ProgramNode:
+		if n.Statements != nil {
+			body = n.Statements
+		}
+	default:
+		return nil, false
+	}
+	b := &builder{
+		g:     &Graph{Node: node, blocks: map[parser.Node]*Block{}, source: source},
+		flows: map[parser.Node]bool{},
+	}
+	b.g.Entry = b.block()
+	b.g.Exit = b.block()
+	start := b.block()
+	b.edge(b.g.Entry, start, Normal)
+	b.current = start
+	if closure {
+		b.loops = append(b.loops, loop{next: b.g.Exit, brk: b.g.Exit, redo: start})
+	}
+	b.expr(body)
+	b.edge(b.current, b.g.Exit, Normal)
+	b.simplify()
+	return b.g, true
+}
+
+func (b *builder) block() *Block {
+	block := &Block{ID: len(b.g.Blocks)}
+	b.g.Blocks = append(b.g.Blocks, block)
+	return block
+}
+
+func (b *builder) edge(from, to *Block, kind Kind) {
+	for _, e := range from.Successors {
+		if e.To == to && e.Kind == kind {
+			return
+		}
+	}
+	e := &Edge{From: from, To: to, Kind: kind}
+	from.Successors = append(from.Successors, e)
+	to.Predecessors = append(to.Predecessors, e)
+}
+
+func (b *builder) add(node parser.Node) {
+	b.current.Nodes = append(b.current.Nodes, node)
+	b.g.blocks[node] = b.current
+}
+
+// jump leads the current block to a block outside of the ensure clauses
+// entered since the first ensures, through those clauses, and continues
+// in an unreachable block.
+func (b *builder) jump(to *Block, ensures int) {
+	b.route(b.current, to, ensures)
+	b.current = b.block()
+}
+
+func (b *builder) route(from, to *Block, ensures int) {
+	if len(b.ensures) > ensures {
+		inner := b.ensures[len(b.ensures)-1]
+		inner.pending = append(inner.pending, jump{to, ensures})
+		to = inner.entry
+	}
+	b.edge(from, to, Normal)
+}
+
+// raise leads a block to the rescue clauses or ensure clause that handle
+// the exceptions it raises, or to the exit.
+func (b *builder) raise(from *Block) {
+	h := handler{block: b.g.Exit}
+	if len(b.handlers) > 0 {
+		h = b.handlers[len(b.handlers)-1]
+	}
+	if len(b.ensures) > h.ensures {
+		inner := b.ensures[len(b.ensures)-1]
+		inner.raised = true
+		b.edge(from, inner.entry, Exception)
+		return
+	}
+	b.edge(from, h.block, Exception)
+}
+
+// expr adds a node evaluated for its value or its effects.
+func (b *builder) expr(node parser.Node) {
+	switch n := node.(type) {
+	case nil:
+	case *parser.StatementsNode:
+		for _, statement := range n.Body {
+			b.expr(statement)
+		}
+	case *parser.ArgumentsNode:
+		for _, argument := range n.Arguments {
+			b.expr(argument)
+		}
+	case *parser.ParenthesesNode:
+		if !b.flow(n) {
+			b.add(n)
+			return
+		}
+		b.expr(n.Body)
+	case *parser.IfNode:
+		b.branch(n.Predicate, statements(n.Statements), n.Subsequent)
+	case *parser.UnlessNode:
+		b.branch(n.Predicate, elseClause(n.ElseClause), statements(n.Statements))
+	case *parser.ElseNode:
+		b.expr(statements(n.Statements))
+	case *parser.AndNode:
+		b.shortCircuit(n.Left, n.Right, True)
+	case *parser.OrNode:
+		b.shortCircuit(n.Left, n.Right, False)
+	case *parser.WhileNode:
+		b.loop(n.Predicate, statements(n.Statements), n.IsBEGIN_MODIFIER(), false)
+	case *parser.UntilNode:
+		b.loop(n.Predicate, statements(n.Statements), n.IsBEGIN_MODIFIER(), true)
+	case *parser.ForNode:
+		b.forLoop(n)
+	case *parser.CaseNode:
+		b.caseWhen(n)
+	case *parser.CaseMatchNode:
+		b.caseIn(n)
+	case *parser.BeginNode:
+		b.begin(n)
+	case *parser.RescueModifierNode:
+		b.rescueModifier(n)
+	case *parser.ReturnNode:
+		b.node(n)
+		b.jump(b.g.Exit, 0)
+	case *parser.BreakNode:
+		b.node(n)
+		if l, ok := b.innermost(); ok {
+			b.jump(l.brk, l.ensures)
+		} else {
+			b.jump(b.g.Exit, 0)
+		}
+	case *parser.NextNode:
+		b.node(n)
+		if l, ok := b.innermost(); ok {
+			b.jump(l.next, l.ensures)
+		} else {
+			b.jump(b.g.Exit, 0)
+		}
+	case *parser.RedoNode:
+		b.add(n)
+		if l, ok := b.innermost(); ok {
+			b.jump(l.redo, l.ensures)
+		}
+	case *parser.RetryNode:
+		b.add(n)
+		if len(b.retries) > 0 {
+			r := b.retries[len(b.retries)-1]
+			b.jump(r.to, r.ensures)
+		}
+	default:
+		b.node(n)
+		if isRaise(n) {
+			b.raise(b.current)
+			b.current = b.block()
+		}
+	}
+}
+
+// node adds a node that does not change the flow of control itself,
+// splitting it if it contains a node that does.
+func (b *builder) node(node parser.Node) {
+	if b.flow(node) {
+		for _, child := range node.CompactChildNodes() {
+			// A block is a graph of its own; its call is added as a node.
+			if _, ok := child.(*parser.BlockNode); !ok {
+				b.expr(child)
+			}
+		}
+	}
+	b.add(node)
+}
+
+// flow reports whether a node changes the flow of control or contains a
+// node that does, within the body the graph is of.
+func (b *builder) flow(node parser.Node) bool {
+	if node == nil {
+		return false
+	}
+	if flows, ok := b.flows[node]; ok {
+		return flows
+	}
+	flows := false
+	switch node.(type) {
+	case *parser.IfNode, *parser.UnlessNode, *parser.AndNode, *parser.OrNode,
+		*parser.WhileNode, *parser.UntilNode, *parser.ForNode,
+		*parser.CaseNode, *parser.CaseMatchNode, *parser.BeginNode, *parser.RescueModifierNode,
+		*parser.ReturnNode, *parser.BreakNode, *parser.NextNode, *parser.RedoNode, *parser.RetryNode:
+		flows = true
+	case *parser.DefNode, *parser.BlockNode, *parser.LambdaNode,
+		*parser.ClassNode, *parser.ModuleNode, *parser.SingletonClassNode:
+	default:
+		flows = isRaise(node)
+		for _, child := range node.CompactChildNodes() {
+			flows = b.flow(child) || flows
+		}
+	}
+	b.flows[node] = flows
+	return flows
+}
+
+// condition evaluates a condition in the current block and leads to t if
+// it is truthy and to f otherwise, short-circuiting and, or and not.
+func (b *builder) condition(node parser.Node, t, f *Block) {
+	switch n := node.(type) {
+	case *parser.AndNode:
+		right := b.block()
+		b.condition(n.Left, right, f)
+		b.current = right
+		b.condition(n.Right, t, f)
+		return
+	case *parser.OrNode:
+		right := b.block()
+		b.condition(n.Left, t, right)
+		b.current = right
+		b.condition(n.Right, t, f)
+		return
+	case *parser.CallNode:
+		if n.Name == "!" && n.Receiver != nil && n.Arguments == nil {
+			b.condition(n.Receiver, f, t)
+			return
+		}
+	case *parser.ParenthesesNode:
+		if body, ok := n.Body.(*parser.StatementsNode); ok && len(body.Body) > 0 {
+			for _, statement := range body.Body[:len(body.Body)-1] {
+				b.expr(statement)
+			}
+			b.condition(body.Body[len(body.Body)-1], t, f)
+			return
+		}
+	}
+	b.expr(node)
+	b.edge(b.current, t, True)
+	b.edge(b.current, f, False)
+}
+
+// branch adds an if or unless, evaluating then when the predicate is
+// truthy and otherwise when it is not.
+func (b *builder) branch(predicate, then, otherwise parser.Node) {
+	thenBlock := b.block()
+	join := b.block()
+	elseBlock := join
+	if otherwise != nil {
+		elseBlock = b.block()
+	}
+	b.condition(predicate, thenBlock, elseBlock)
+	b.current = thenBlock
+	b.expr(then)
+	b.edge(b.current, join, Normal)
+	if otherwise != nil {
+		b.current = elseBlock
+		b.expr(otherwise)
+		b.edge(b.current, join, Normal)
+	}
+	b.current = join
+}
+
+// shortCircuit adds an and or an or used for its value, which evaluates
+// right only when left leads to the edge of kind.
+func (b *builder) shortCircuit(left, right parser.Node, kind Kind) {
+	b.expr(left)
+	rightBlock := b.block()
+	join := b.block()
+	if kind == True {
+		b.edge(b.current, rightBlock, True)
+		b.edge(b.current, join, False)
+	} else {
+		b.edge(b.current, join, True)
+		b.edge(b.current, rightBlock, False)
+	}
+	b.current = rightBlock
+	b.expr(right)
+	b.edge(b.current, join, Normal)
+	b.current = join
+}
+
+// loop adds a while loop, or an until loop, whose body runs once before
+// the predicate is evaluated if it is written begin ... end while.
+func (b *builder) loop(predicate, body parser.Node, bodyFirst, until bool) {
+	header := b.block()
+	bodyBlock := b.block()
+	after := b.block()
+	if bodyFirst {
+		b.edge(b.current, bodyBlock, Normal)
+	} else {
+		b.edge(b.current, header, Normal)
+	}
+	b.current = header
+	if until {
+		b.condition(predicate, after, bodyBlock)
+	} else {
+		b.condition(predicate, bodyBlock, after)
+	}
+	b.loops = append(b.loops, loop{next: header, brk: after, redo: bodyBlock, ensures: len(b.ensures)})
+	b.current = bodyBlock
+	b.expr(body)
+	b.edge(b.current, header, Normal)
+	b.loops = b.loops[:len(b.loops)-1]
+	b.current = after
+}
+
+// forLoop adds a for loop, whose header leads to the body while there are
+// elements left.
+func (b *builder) forLoop(n *parser.ForNode) {
+	b.expr(n.Collection)
+	header := b.block()
+	bodyBlock := b.block()
+	after := b.block()
+	b.edge(b.current, header, Normal)
+	b.edge(header, bodyBlock, True)
+	b.edge(header, after, False)
+	b.loops = append(b.loops, loop{next: header, brk: after, redo: bodyBlock, ensures: len(b.ensures)})
+	b.current = bodyBlock
+	b.add(n.Index)
+	b.expr(statements(n.Statements))
+	b.edge(b.current, header, Normal)
+	b.loops = b.loops[:len(b.loops)-1]
+	b.current = after
+}
+
+// innermost returns the innermost loop, if any.
+func (b *builder) innermost() (loop, bool) {
+	if len(b.loops) == 0 {
+		return loop{}, false
+	}
+	return b.loops[len(b.loops)-1], true
+}
+
+// caseWhen adds a case with when clauses, whose conditions are tested in
+// turn.
+func (b *builder) caseWhen(n *parser.CaseNode) {
+	b.expr(n.Predicate)
+	join := b.block()
+	for _, condition := range n.Conditions {
+		when, ok := condition.(*parser.WhenNode)
+		if !ok {
+			continue
+		}
+		body := b.block()
+		for _, test := range when.Conditions {
+			next := b.block()
+			b.expr(test)
+			b.edge(b.current, body, True)
+			b.edge(b.current, next, False)
+			b.current = next
+		}
+		miss := b.current
+		b.current = body
+		b.expr(statements(when.Statements))
+		b.edge(b.current, join, Normal)
+		b.current = miss
+	}
+	b.expr(elseClause(n.ElseClause))
+	b.edge(b.current, join, Normal)
+	b.current = join
+}
+
+// caseIn adds a case with in clauses, whose patterns are matched in turn.
+// Without an else clause, a value no pattern matches raises
+// NoMatchingPatternError.
+func (b *builder) caseIn(n *parser.CaseMatchNode) {
+	b.expr(n.Predicate)
+	join := b.block()
+	for _, condition := range n.Conditions {
+		in, ok := condition.(*parser.InNode)
+		if !ok {
+			continue
+		}
+		body := b.block()
+		next := b.block()
+		b.add(in.Pattern)
+		b.edge(b.current, body, True)
+		b.edge(b.current, next, False)
+		b.current = body
+		b.expr(statements(in.Statements))
+		b.edge(b.current, join, Normal)
+		b.current = next
+	}
+	if n.ElseClause != nil {
+		b.expr(n.ElseClause)
+		b.edge(b.current, join, Normal)
+	} else {
+		b.raise(b.current)
+	}
+	b.current = join
+}
+
+// begin adds a begin, or a body, with rescue, else and ensure clauses.
+// Every block of the body may raise, and leads to the rescue clauses,
+// which test the exception classes in turn and raise it again if none
+// matches. Every block of the else and rescue clauses leads to the ensure
+// clause, which the body, else and rescue clauses also go through when
+// they complete or jump out of the begin.
+func (b *builder) begin(n *parser.BeginNode) {
+	if n.RescueClause == nil && n.EnsureClause == nil {
+		b.expr(statements(n.Statements))
+		b.expr(elseClause(n.ElseClause))
+		return
+	}
+	var e *ensure
+	if n.EnsureClause != nil {
+		e = &ensure{entry: b.block()}
+		b.ensures = append(b.ensures, e)
+	}
+	var dispatch *Block
+	if n.RescueClause != nil {
+		dispatch = b.block()
+		b.handlers = append(b.handlers, handler{block: dispatch, ensures: len(b.ensures)})
+	}
+	body := b.block()
+	b.edge(b.current, body, Normal)
+	b.current = body
+	b.expr(statements(n.Statements))
+	if dispatch != nil {
+		for _, block := range b.g.Blocks[body.ID:] {
+			if len(block.Nodes) > 0 {
+				b.raise(block)
+			}
+		}
+		b.handlers = b.handlers[:len(b.handlers)-1]
+	}
+	protected := len(b.g.Blocks)
+	if n.ElseClause != nil {
+		elseBlock := b.block()
+		b.edge(b.current, elseBlock, Normal)
+		b.current = elseBlock
+		b.expr(n.ElseClause)
+	}
+	ends := []*Block{b.current}
+	if dispatch != nil {
+		b.current = dispatch
+		for r := n.RescueClause; r != nil; r = r.Subsequent {
+			for _, exception := range r.Exceptions {
+				b.expr(exception)
+			}
+			rescue := b.block()
+			next := b.block()
+			b.edge(b.current, rescue, True)
+			b.edge(b.current, next, False)
+			b.current = rescue
+			if r.Reference != nil {
+				b.add(r.Reference)
+			}
+			b.retries = append(b.retries, jump{body, len(b.ensures)})
+			b.expr(statements(r.Statements))
+			b.retries = b.retries[:len(b.retries)-1]
+			ends = append(ends, b.current)
+			b.current = next
+		}
+		b.raise(b.current)
+	}
+	join := b.block()
+	if e == nil {
+		for _, end := range ends {
+			b.edge(end, join, Normal)
+		}
+		b.current = join
+		return
+	}
+	b.ensures = b.ensures[:len(b.ensures)-1]
+	for _, block := range b.g.Blocks[protected:] {
+		if len(block.Nodes) > 0 && block != join {
+			b.edge(block, e.entry, Exception)
+			e.raised = true
+		}
+	}
+	if dispatch == nil {
+		for _, block := range b.g.Blocks[body.ID:protected] {
+			if len(block.Nodes) > 0 {
+				b.edge(block, e.entry, Exception)
+				e.raised = true
+			}
+		}
+	}
+	completes := false
+	reached := reach(b.g.Entry)
+	for _, end := range ends {
+		if reached[end] {
+			b.edge(end, e.entry, Normal)
+			completes = true
+		}
+	}
+	b.current = e.entry
+	b.expr(statements(n.EnsureClause.Statements))
+	exit := b.current
+	if completes {
+		b.edge(exit, join, Normal)
+	}
+	for _, j := range e.pending {
+		b.route(exit, j.to, j.ensures)
+	}
+	if e.raised {
+		b.raise(exit)
+	}
+	b.current = join
+}
+
+// rescueModifier adds expression rescue fallback.
+func (b *builder) rescueModifier(n *parser.RescueModifierNode) {
+	start := len(b.g.Blocks)
+	from := b.current
+	b.expr(n.Expression)
+	fallback := b.block()
+	join := b.block()
+	b.edge(b.current, join, Normal)
+	for _, block := range append([]*Block{from}, b.g.Blocks[start:len(b.g.Blocks)-2]...) {
+		if len(block.Nodes) > 0 {
+			b.edge(block, fallback, Exception)
+		}
+	}
+	b.current = fallback
+	b.expr(n.RescueExpression)
+	b.edge(b.current, join, Normal)
+	b.current = join
+}
+
+// simplify removes the empty blocks nothing leads to, and those that only
+// lead to another block, and numbers the blocks left.
+func (b *builder) simplify() {
+	g := b.g
+	for changed := true; changed; {
+		changed = false
+		for _, block := range g.Blocks {
+			if block == g.Entry || block == g.Exit || len(block.Nodes) > 0 {
+				continue
+			}
+			switch {
+			case len(block.Predecessors) == 0:
+			case len(block.Successors) == 1 && block.Successors[0].Kind == Normal && block.Successors[0].To != block:
+			default:
+				continue
+			}
+			for _, e := range block.Successors {
+				e.To.Predecessors = slices.DeleteFunc(e.To.Predecessors, func(p *Edge) bool { return p == e })
+			}
+			for _, e := range block.Predecessors {
+				e.From.Successors = slices.DeleteFunc(e.From.Successors, func(s *Edge) bool { return s == e })
+				if len(block.Successors) == 1 {
+					b.edge(e.From, block.Successors[0].To, e.Kind)
+				}
+			}
+			g.Blocks = slices.DeleteFunc(g.Blocks, func(other *Block) bool { return other == block })
+			changed = true
+			break
+		}
+	}
+	for index, block := range g.Blocks {
+		block.ID = index
+	}
+}
+
+// isRaise reports whether a node is a call to raise or fail without a
+// receiver.
+func isRaise(node parser.Node) bool {
+	call, ok := node.(*parser.CallNode)
+	return ok && call.Receiver == nil && (call.Name == "raise" || call.Name == "fail")
+}
+
+func statements(n *parser.StatementsNode) parser.Node {
+	if n == nil {
+		return nil
+	}
+	return n
+}
+
+func elseClause(n *parser.ElseNode) parser.Node {
+	if n == nil {
+		return nil
+	}
+	return n
+}
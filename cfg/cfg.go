@@ -0,0 +1,204 @@
+// Package cfg builds the control-flow graph of a method, block, lambda or
+// program body, for dataflow analyses.
+//
+// A graph is made of basic blocks: straight runs of nodes, in the order
+// they are evaluated, linked by edges where control may pass from one to
+// another. Conditions end their block with a true and a false edge, and
+// the code that may raise is linked to the rescue clauses or ensure clause
+// that would handle it by exception edges. The graph has an entry and an
+// exit block, which return, break and next in a block, and exceptions
+// that escape, lead to:
+//
+//	graph, _ := cfg.Build(def, result.Source.Bytes)
+//	for _, block := range graph.Unreachable() {
+//		fmt.Println("unreachable:", block.Nodes[0].GetLocation())
+//	}
+//	os.WriteFile("cfg.dot", []byte(graph.DOT()), 0o644)
+//
+// Nodes that change the flow of control, such as if, while, and, or and
+// return, are split into the blocks their parts run in. Other nodes are
+// kept whole, unless they contain such a node, as in x = (a || b), in
+// which case their parts are split and the node follows them. Nested
+// methods, blocks, lambdas, classes and modules are kept whole: they have
+// graphs of their own.
+package cfg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the kind of an edge.
+type Kind int
+
+const (
+	// Normal is an unconditional edge.
+	Normal Kind = iota
+	// True is taken when the condition ending the block is truthy, or a
+	// when or in clause matches.
+	True
+	// False is taken when the condition ending the block is falsy, or a
+	// when or in clause does not match.
+	False
+	// Exception is taken when an exception is raised.
+	Exception
+)
+
+var kindNames = enum.Names[Kind]{
+	Normal:    "normal",
+	True:      "true",
+	False:     "false",
+	Exception: "exception",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Edge is a transfer of control from one block to another.
+type Edge struct {
+	From, To *Block
+	Kind     Kind
+}
+
+// Block is a basic block.
+type Block struct {
+	// ID is the index of the block in the graph.
+	ID int
+	// Nodes are the nodes of the block in evaluation order.
+	Nodes        []parser.Node
+	Successors   []*Edge
+	Predecessors []*Edge
+}
+
+// Graph is a control-flow graph.
+type Graph struct {
+	// Node is the method, block, lambda or program the graph is of.
+	Node parser.Node
+	// Entry and Exit are the empty blocks control enters and leaves the
+	// body through.
+	Entry, Exit *Block
+	Blocks      []*Block
+	blocks      map[parser.Node]*Block
+	source      []byte
+}
+
+// Block returns the block a node is in, or nil if the node is not one of
+// the nodes of a block.
+func (g *Graph) Block(node parser.Node) *Block {
+	return g.blocks[node]
+}
+
+// Reachable returns the blocks reachable from the entry, in order.
+func (g *Graph) Reachable() []*Block {
+	reached := reach(g.Entry)
+	var blocks []*Block
+	for _, block := range g.Blocks {
+		if reached[block] {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// Unreachable returns the blocks with nodes that cannot be reached from
+// the entry, such as the code after a return.
+func (g *Graph) Unreachable() []*Block {
+	reached := reach(g.Entry)
+	var blocks []*Block
+	for _, block := range g.Blocks {
+		if !reached[block] && len(block.Nodes) > 0 {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// DOT returns the graph in the Graphviz DOT language. Blocks are labelled
+// with the first line of the source of their nodes, or their types if the
+// graph was built without the source; exception edges are dashed.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph cfg {\n")
+	b.WriteString("  node [shape=box, fontname=monospace];\n")
+	for _, block := range g.Blocks {
+		lines := []string{fmt.Sprintf("B%d", block.ID)}
+		switch block {
+		case g.Entry:
+			lines[0] += " (entry)"
+		case g.Exit:
+			lines[0] += " (exit)"
+		}
+		for _, node := range block.Nodes {
+			lines = append(lines, g.label(node))
+		}
+		fmt.Fprintf(&b, "  B%d [label=%s];\n", block.ID, quote(lines))
+	}
+	for _, block := range g.Blocks {
+		for _, e := range block.Successors {
+			var attributes []string
+			switch e.Kind {
+			case True, False:
+				attributes = append(attributes, fmt.Sprintf("label=%q", e.Kind))
+			case Exception:
+				attributes = append(attributes, "style=dashed")
+			}
+			edge := fmt.Sprintf("B%d -> B%d", e.From.ID, e.To.ID)
+			if len(attributes) > 0 {
+				edge += " [" + strings.Join(attributes, ", ") + "]"
+			}
+			fmt.Fprintf(&b, "  %s;\n", edge)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// reach returns the blocks reachable from a block.
+func reach(from *Block) map[*Block]bool {
+	reached := map[*Block]bool{from: true}
+	work := []*Block{from}
+	for len(work) > 0 {
+		block := work[len(work)-1]
+		work = work[:len(work)-1]
+		for _, e := range block.Successors {
+			if !reached[e.To] {
+				reached[e.To] = true
+				work = append(work, e.To)
+			}
+		}
+	}
+	return reached
+}
+
+// quote returns a DOT label of left-justified lines.
+func quote(lines []string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, line := range lines {
+		line = strings.ReplaceAll(line, `\`, `\\`)
+		line = strings.ReplaceAll(line, `"`, `\"`)
+		b.WriteString(line + `\l`)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// label returns the first line of the source of a node, shortened.
+func (g *Graph) label(node parser.Node) string {
+	location := node.GetLocation()
+	if g.source == nil || location.EndOffset() > len(g.source) {
+		return node.Type().String()
+	}
+	text := string(g.source[location.StartOffset:location.EndOffset()])
+	if line, _, more := strings.Cut(text, "\n"); more {
+		text = line + " ..."
+	}
+	if runes := []rune(text); len(runes) > 60 {
+		text = string(runes[:57]) + "..."
+	}
+	return text
+}
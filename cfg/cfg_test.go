@@ -0,0 +1,175 @@
+package cfg_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/cfg"
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// build returns the graph of the first statement of a source.
+func build(t *testing.T, source string) (*cfg.Graph, *parser.ParseResult) {
+	t.Helper()
+	result := parsetest.Parse(t, source)
+	g, ok := cfg.Build(result.Value.Statements.Body[0], result.Source.Bytes)
+	if !ok {
+		t.Fatal("Build() = false")
+	}
+	return g, result
+}
+
+// describe lists the reachable blocks but for the entry and the exit, each
+// as the source of its nodes followed by the blocks it leads to. Blocks are
+// named by their first node, or by their ID when they have none, and the
+// exit by exit; T and F mark true and false edges, and ! exception edges.
+func describe(g *cfg.Graph, result *parser.ParseResult) string {
+	name := func(block *cfg.Block) string {
+		if block == g.Exit {
+			return "exit"
+		}
+		if len(block.Nodes) == 0 {
+			return fmt.Sprintf("B%d", block.ID)
+		}
+		return string(result.Source.Slice(block.Nodes[0].GetLocation()))
+	}
+	var lines []string
+	for _, block := range g.Reachable() {
+		if block == g.Entry || block == g.Exit {
+			continue
+		}
+		nodes := []string{name(block)}
+		for _, node := range block.Nodes[min(1, len(block.Nodes)):] {
+			nodes = append(nodes, string(result.Source.Slice(node.GetLocation())))
+		}
+		var successors []string
+		for _, e := range block.Successors {
+			prefix := map[cfg.Kind]string{cfg.Normal: "", cfg.True: "T:", cfg.False: "F:", cfg.Exception: "!"}[e.Kind]
+			successors = append(successors, prefix+name(e.To))
+		}
+		lines = append(lines, strings.Join(nodes, "; ")+" -> "+strings.Join(successors, " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			"def f(a)\n  if a\n    b\n  else\n    c\n  end\n  d\nend",
+			"a -> T:b F:c\nb -> d\nd -> exit\nc -> d",
+		},
+		{
+			"def f\n  return 1 unless a\n  b\nend",
+			"a -> F:1 T:b\nb -> exit\n1; return 1 -> exit",
+		},
+		{
+			"def f\n  while a && b\n    next if c\n    break\n  end\nend",
+			"a -> T:b F:exit\nc -> T:next F:break\nb -> T:c F:exit\nnext -> a\nbreak -> exit",
+		},
+		{
+			"def f\n  x = (a || b)\n  list.each { |i| return i }\nend",
+			"a -> T:x = (a || b) F:b\nb -> x = (a || b)\nx = (a || b); list.each { |i| return i } -> exit",
+		},
+		{
+			"def f\n  begin\n    raise x\n  rescue E\n    retry\n  ensure\n    y\n  end\nend",
+			"y -> !exit\nE -> T:retry F:B6\nx; raise x -> !E\nretry -> x !y\nB6 -> !y",
+		},
+		{
+			"def f\n  case x\n  when 1 then a\n  else b\n  end\nend",
+			"x; 1 -> T:a F:b\na -> exit\nb -> exit",
+		},
+		{
+			"-> { next 1; 2 }",
+			"1; next 1 -> exit",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			g, result := build(t, test.source)
+			if got := describe(g, result); got != test.want {
+				t.Errorf("blocks =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"def f\n  return 1\n  dead\nend", "dead"},
+		{"def f\n  raise E\n  dead\nend", "dead"},
+		{"def f\n  loop { break }\n  live\nend", ""},
+		{"def f\n  while true\n    break\n    dead\n  end\nend", "dead"},
+		{"def f = a ? b : c", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			g, result := build(t, test.source)
+			var unreachable []string
+			for _, block := range g.Unreachable() {
+				for _, node := range block.Nodes {
+					unreachable = append(unreachable, string(result.Source.Slice(node.GetLocation())))
+				}
+			}
+			if got := strings.Join(unreachable, "; "); got != test.want {
+				t.Errorf("Unreachable() holds %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBlockAndDOT(t *testing.T) {
+	g, result := build(t, "def f(a)\n  return \"x\" if a\nend")
+	def := result.Value.Statements.Body[0].(*parser.DefNode)
+	condition := def.Body.(*parser.StatementsNode).Body[0].(*parser.IfNode).Predicate
+	if block := g.Block(condition); block == nil || block.Nodes[0] != condition {
+		t.Errorf("Block(a) = %v", block)
+	}
+	if g.Block(def) != nil {
+		t.Error("Block(def) != nil")
+	}
+	want := `digraph cfg {
+  node [shape=box, fontname=monospace];
+  B0 [label="B0 (entry)\l"];
+  B1 [label="B1 (exit)\l"];
+  B2 [label="B2\la\l"];
+  B3 [label="B3\l\"x\"\lreturn \"x\"\l"];
+  B0 -> B2;
+  B2 -> B3 [label="true"];
+  B2 -> B1 [label="false"];
+  B3 -> B1;
+}
+`
+	if got := g.DOT(); got != want {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, want)
+	}
+
+	g, _ = cfg.Build(def, nil)
+	if !strings.Contains(g.DOT(), `B2 [label="B2\lLocalVariableReadNode\l"];`) {
+		t.Errorf("DOT() without the source =\n%s", g.DOT())
+	}
+}
+
+func TestBuildNodes(t *testing.T) {
+	result := parsetest.Parse(t, "f { 1 }\nx = 1")
+	if _, ok := cfg.Build(result.Value, nil); !ok {
+		t.Error("Build(program) = false")
+	}
+	block := result.Value.Statements.Body[0].(*parser.CallNode).Block
+	if g, ok := cfg.Build(block, nil); !ok || g.Node != block {
+		t.Error("Build(block) failed")
+	}
+	if _, ok := cfg.Build(result.Value.Statements, nil); ok {
+		t.Error("Build(statements) = true")
+	}
+	if got := cfg.Kind(4).String(); got != "cfg.Kind(4)" {
+		t.Errorf("Kind(4).String() = %q", got)
+	}
+}
@@ -0,0 +1,26 @@
+package constants
+
+// coreConstants are the top-level constants of Ruby's core library.
+var coreConstants = []string{
+	"ARGF", "ARGV", "ArgumentError", "Array", "BasicObject", "Binding",
+	"Class", "ClosedQueueError", "Comparable", "Complex", "ConditionVariable",
+	"DATA", "Data", "Dir", "ENV", "EOFError", "Encoding", "EncodingError",
+	"Enumerable", "Enumerator", "Errno", "Exception", "FalseClass", "Fiber",
+	"FiberError", "File", "FileTest", "Float", "FloatDomainError",
+	"FrozenError", "GC", "Hash", "IO", "IOError", "IndexError", "Integer",
+	"Interrupt", "Kernel", "KeyError", "LoadError", "LocalJumpError",
+	"Marshal", "MatchData", "Math", "Method", "Module", "Mutex", "NameError",
+	"NilClass", "NoMatchingPatternError", "NoMatchingPatternKeyError",
+	"NoMemoryError", "NoMethodError", "NotImplementedError", "Numeric",
+	"Object", "ObjectSpace", "Proc", "Process", "Queue", "RUBY_COPYRIGHT",
+	"RUBY_DESCRIPTION", "RUBY_ENGINE", "RUBY_ENGINE_VERSION",
+	"RUBY_PATCHLEVEL", "RUBY_PLATFORM", "RUBY_RELEASE_DATE", "RUBY_REVISION",
+	"RUBY_VERSION", "Ractor", "Random", "Range", "RangeError", "Rational",
+	"Refinement", "Regexp", "RegexpError", "RuntimeError", "STDERR", "STDIN",
+	"STDOUT", "ScriptError", "SecurityError", "Set", "Signal",
+	"SignalException", "SizedQueue", "StandardError", "StopIteration",
+	"String", "Struct", "Symbol", "SyntaxError", "SystemCallError",
+	"SystemExit", "SystemStackError", "Thread", "ThreadError", "ThreadGroup",
+	"Time", "TOPLEVEL_BINDING", "TracePoint", "TrueClass", "TypeError",
+	"UnboundMethod", "UncaughtThrowError", "Warning", "ZeroDivisionError",
+}
@@ -0,0 +1,192 @@
+package constants
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// lookup resolves a bare constant name used within nesting.
+func (r *Resolver) lookup(name string, nesting []string) string {
+	for _, scope := range nesting {
+		if r.defined(scope + "::" + name) {
+			return scope + "::" + name
+		}
+	}
+	if len(nesting) > 0 {
+		for _, ancestor := range r.ancestorsOf(nesting[0])[1:] {
+			if r.defined(ancestor + "::" + name) {
+				return ancestor + "::" + name
+			}
+		}
+	}
+	if r.defined(name) {
+		return name
+	}
+	return ""
+}
+
+// lookupIn resolves a constant name within a class or module, as in
+// Scope::Name.
+func (r *Resolver) lookupIn(scope, name string) string {
+	if r.isExternal(scope) {
+		return scope + "::" + name
+	}
+	for _, ancestor := range r.ancestorsOf(scope) {
+		if r.defined(ancestor + "::" + name) {
+			return ancestor + "::" + name
+		}
+	}
+	return ""
+}
+
+// resolve resolves a constant expression used within nesting, or returns
+// an empty string.
+func (r *Resolver) resolve(node parser.Node, nesting []string) string {
+	switch n := node.(type) {
+	case *parser.ConstantReadNode:
+		return r.lookup(n.Name, nesting)
+	case *parser.ConstantPathNode:
+		if n.Name == nil {
+			return ""
+		}
+		if n.Parent == nil {
+			if r.defined(*n.Name) {
+				return *n.Name
+			}
+			return ""
+		}
+		if scope := r.resolve(n.Parent, nesting); scope != "" {
+			return r.lookupIn(scope, *n.Name)
+		}
+	}
+	return ""
+}
+
+// defined reports whether a qualified name is defined in the project or is
+// a known external constant.
+func (r *Resolver) defined(qualifiedName string) bool {
+	return len(r.definitions[qualifiedName]) > 0 || r.external[qualifiedName]
+}
+
+// isExternal reports whether a qualified name is defined outside of the
+// project: an external constant or a constant nested in one, not defined
+// in the project.
+func (r *Resolver) isExternal(qualifiedName string) bool {
+	if len(r.definitions[qualifiedName]) > 0 {
+		return false
+	}
+	for name := qualifiedName; ; {
+		if r.external[name] {
+			return true
+		}
+		index := strings.LastIndex(name, "::")
+		if index < 0 {
+			return false
+		}
+		name = name[:index]
+	}
+}
+
+// ancestorsOf returns the ancestors of a class or module, as Ancestors
+// does, without copying them.
+func (r *Resolver) ancestorsOf(qualifiedName string) []string {
+	if ancestors, ok := r.ancestors[qualifiedName]; ok {
+		return ancestors
+	}
+	// Guard against cycles while the ancestors are worked out.
+	r.ancestors[qualifiedName] = []string{qualifiedName}
+	var prepended, included []string
+	superclass := ""
+	for _, s := range r.definitions[qualifiedName] {
+		outer := outerNesting(s)
+		inner := append([]string{qualifiedName}, outer...)
+		var body parser.Node
+		switch n := s.Node.(type) {
+		case *parser.ClassNode:
+			if n.Superclass != nil && superclass == "" {
+				superclass = r.resolve(n.Superclass, outer)
+			}
+			body = n.Body
+		case *parser.ModuleNode:
+			body = n.Body
+		}
+		for _, call := range bodyCalls(body) {
+			if call.Arguments == nil {
+				continue
+			}
+			for _, argument := range call.Arguments.Arguments {
+				module := r.resolve(argument, inner)
+				if module == "" {
+					continue
+				}
+				if call.Name == "prepend" {
+					prepended = append([]string{module}, prepended...)
+				} else {
+					included = append([]string{module}, included...)
+				}
+			}
+		}
+	}
+	var inherited []string
+	if superclass != "" {
+		inherited = r.ancestorsOf(superclass)
+	}
+	ancestors := append(prepended, qualifiedName)
+	// Like Ruby's include, modules already in the ancestors of the
+	// superclass are skipped.
+	for _, module := range included {
+		for _, ancestor := range r.ancestorsOf(module) {
+			if !slices.Contains(inherited, ancestor) {
+				ancestors = append(ancestors, ancestor)
+			}
+		}
+	}
+	ancestors = append(ancestors, inherited...)
+	ancestors = unique(ancestors)
+	r.ancestors[qualifiedName] = ancestors
+	return ancestors
+}
+
+// bodyCalls returns the include and prepend calls made directly in the
+// body of a class or module.
+func bodyCalls(body parser.Node) []*parser.CallNode {
+	if begin, ok := body.(*parser.BeginNode); ok && begin.Statements != nil {
+		body = begin.Statements
+	}
+	statements, ok := body.(*parser.StatementsNode)
+	if !ok {
+		return nil
+	}
+	var calls []*parser.CallNode
+	for _, statement := range statements.Body {
+		call, ok := statement.(*parser.CallNode)
+		if ok && call.Receiver == nil && (call.Name == "include" || call.Name == "prepend") {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// outerNesting returns the nesting a definition is made in.
+func outerNesting(s *symbols.Symbol) []string {
+	var nesting []string
+	for p := s.Parent; p != nil; p = p.Parent {
+		nesting = append(nesting, p.QualifiedName)
+	}
+	return nesting
+}
+
+func unique(names []string) []string {
+	seen := map[string]bool{}
+	result := names[:0]
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,287 @@
+// Package constants resolves constant references across the files of a
+// project to their definitions, following Ruby's lookup rules:
+//
+//   - a bare name such as User is looked up in the lexical scopes it is
+//     used in, innermost first, as Module.nesting lists them; then in the
+//     ancestors of the innermost class or module; then at the top level;
+//   - a path such as Admin::User looks up User in Admin and its
+//     ancestors;
+//   - a path starting with ::, such as ::User, is looked up at the top
+//     level only.
+//
+// Definitions come from the definition indexes of the files built by the
+// symbols package. Ancestors are the superclasses of classes and the
+// modules included or prepended in their bodies. Since files are taken
+// together, a constant can be used in a file before it is defined in
+// another, as with autoloading:
+//
+//	resolver := constants.NewResolver(tables)
+//	result := resolver.Resolve(table, root)
+//	for _, ref := range result.Unresolved() {
+//		fmt.Printf("%s: uninitialized constant %s\n", ref.Node.GetLocation(), ref.Name)
+//	}
+//
+// Constants of Ruby's core library are known to be defined. Others defined
+// outside of the project, such as those of gems, can be declared with
+// WithExternal.
+package constants
+
+import (
+	"slices"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+type config struct {
+	external []string
+}
+
+// Option configures a Resolver.
+type Option func(*config)
+
+// WithExternal declares constants defined outside of the project, by
+// their qualified names, such as "ActiveRecord::Base". The constants
+// nested in them are taken to be defined too.
+func WithExternal(names ...string) Option {
+	return func(c *config) {
+		c.external = append(c.external, names...)
+	}
+}
+
+// Resolver resolves constant references against the definitions of a set
+// of files. It caches the ancestors it works out and is not safe for
+// concurrent use.
+type Resolver struct {
+	// definitions are the classes, modules and constants by qualified name.
+	definitions map[string][]*symbols.Symbol
+	external    map[string]bool
+	ancestors   map[string][]string
+}
+
+// NewResolver returns a resolver for the definitions of the given files.
+func NewResolver(tables []*symbols.Table, options ...Option) *Resolver {
+	c := &config{}
+	for _, option := range options {
+		option(c)
+	}
+	r := &Resolver{
+		definitions: map[string][]*symbols.Symbol{},
+		external:    map[string]bool{},
+		ancestors:   map[string][]string{},
+	}
+	for _, name := range coreConstants {
+		r.external[name] = true
+	}
+	for _, name := range c.external {
+		r.external[name] = true
+	}
+	for _, table := range tables {
+		for _, s := range table.Symbols {
+			switch s.Kind {
+			case symbols.Class, symbols.Module, symbols.Constant:
+				r.definitions[s.QualifiedName] = append(r.definitions[s.QualifiedName], s)
+			}
+		}
+	}
+	return r
+}
+
+// Ancestors returns a class or module followed by its ancestors, as far as
+// the project defines them: the modules it prepends, itself, the modules
+// it includes, last included first, and the ancestors of its superclass.
+func (r *Resolver) Ancestors(qualifiedName string) []string {
+	return slices.Clone(r.ancestorsOf(qualifiedName))
+}
+
+// Reference is a constant used in a file.
+type Reference struct {
+	// Node is a *parser.ConstantReadNode or a *parser.ConstantPathNode.
+	Node parser.Node
+	// Name is the constant as written, such as Admin::User. A parent that
+	// is not a constant is written ?, as in ?::Name.
+	Name string
+	// Nesting is the lexical scopes the constant is used in, innermost
+	// first, as Module.nesting lists them.
+	Nesting []string
+	// QualifiedName is the qualified name of the constant the reference
+	// resolves to, or empty if it is unresolved.
+	QualifiedName string
+	// Definitions are the definitions of the constant in the project, in
+	// the order of the files given to NewResolver.
+	Definitions []*symbols.Symbol
+	// External reports whether the constant is defined outside of the
+	// project, in Ruby's core library or with WithExternal.
+	External bool
+	// dynamic is set for a path whose parent is not a constant, as in
+	// object::Name, which cannot be resolved statically.
+	dynamic bool
+}
+
+// Resolved reports whether the reference resolves to a constant.
+func (r *Reference) Resolved() bool {
+	return r.QualifiedName != ""
+}
+
+// Result holds the constant references of a file.
+type Result struct {
+	File string
+	// References are the constant references in source order, each path
+	// before its parent.
+	References []*Reference
+	nodes      map[parser.Node]*Reference
+}
+
+// Reference returns the reference of a *parser.ConstantReadNode or a
+// *parser.ConstantPathNode, or nil if the node is not a reference.
+func (r *Result) Reference(node parser.Node) *Reference {
+	return r.nodes[node]
+}
+
+// Unresolved returns the references that resolve to no constant. For a
+// path, only the first segment that cannot be resolved is reported: in
+// A::B::C with A undefined, only A is. Paths with a parent that is not a
+// constant are not reported.
+func (r *Result) Unresolved() []*Reference {
+	var unresolved []*Reference
+	for _, ref := range r.References {
+		if ref.Resolved() || ref.dynamic {
+			continue
+		}
+		if path, ok := ref.Node.(*parser.ConstantPathNode); ok && path.Parent != nil {
+			if parent := r.nodes[path.Parent]; parent == nil || !parent.Resolved() {
+				continue
+			}
+		}
+		unresolved = append(unresolved, ref)
+	}
+	return unresolved
+}
+
+// Resolve resolves the constant references of the tree rooted at root,
+// whose definition index is table. References in defined? are left out.
+func (r *Resolver) Resolve(table *symbols.Table, root parser.Node) *Result {
+	w := &walker{
+		resolver: r,
+		result:   &Result{File: table.File, nodes: map[parser.Node]*Reference{}},
+		symbols:  map[parser.Node]*symbols.Symbol{},
+	}
+	for _, s := range table.Symbols {
+		w.symbols[s.Node] = s
+	}
+	w.visit(root, nil)
+	return w.result
+}
+
+type walker struct {
+	resolver *Resolver
+	result   *Result
+	// symbols are the definitions of the file by node.
+	symbols map[parser.Node]*symbols.Symbol
+}
+
+func (w *walker) visit(node parser.Node, nesting []string) {
+	if node == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ClassNode:
+		w.definitionPath(n.ConstantPath, nesting)
+		w.visit(n.Superclass, nesting)
+		w.visit(n.Body, w.nest(n, nesting))
+		return
+	case *parser.ModuleNode:
+		w.definitionPath(n.ConstantPath, nesting)
+		w.visit(n.Body, w.nest(n, nesting))
+		return
+	case *parser.SingletonClassNode:
+		w.visit(n.Expression, nesting)
+		w.visit(n.Body, w.nest(n, nesting))
+		return
+	case *parser.ConstantReadNode, *parser.ConstantPathNode:
+		w.reference(n, nesting)
+		return
+	case *parser.ConstantPathWriteNode:
+		w.definitionPath(n.Target, nesting)
+		w.visit(n.Value, nesting)
+		return
+	case *parser.ConstantPathOrWriteNode:
+		w.definitionPath(n.Target, nesting)
+		w.visit(n.Value, nesting)
+		return
+	case *parser.ConstantPathAndWriteNode:
+		w.definitionPath(n.Target, nesting)
+		w.visit(n.Value, nesting)
+		return
+	case *parser.ConstantPathOperatorWriteNode:
+		w.definitionPath(n.Target, nesting)
+		w.visit(n.Value, nesting)
+		return
+	case *parser.ConstantPathTargetNode:
+		w.visit(n.Parent, nesting)
+		return
+	case *parser.DefinedNode:
+		return
+	}
+	for _, child := range node.CompactChildNodes() {
+		w.visit(child, nesting)
+	}
+}
+
+// definitionPath visits the constant path a definition is written with,
+// whose parent is a reference.
+func (w *walker) definitionPath(node parser.Node, nesting []string) {
+	if path, ok := node.(*parser.ConstantPathNode); ok {
+		w.visit(path.Parent, nesting)
+	}
+}
+
+// nest returns the nesting within a class, module or singleton class
+// definition.
+func (w *walker) nest(node parser.Node, nesting []string) []string {
+	s, ok := w.symbols[node]
+	if !ok {
+		return nesting
+	}
+	return append([]string{s.QualifiedName}, nesting...)
+}
+
+// reference resolves a constant node and the parents of a path.
+func (w *walker) reference(node parser.Node, nesting []string) *Reference {
+	ref := &Reference{Node: node, Nesting: nesting}
+	w.result.References = append(w.result.References, ref)
+	w.result.nodes[node] = ref
+	r := w.resolver
+	switch n := node.(type) {
+	case *parser.ConstantReadNode:
+		ref.Name = n.Name
+		ref.QualifiedName = r.lookup(n.Name, nesting)
+	case *parser.ConstantPathNode:
+		name := "?"
+		if n.Name != nil {
+			name = *n.Name
+		}
+		switch parent := n.Parent.(type) {
+		case nil:
+			ref.Name = "::" + name
+			if r.defined(name) {
+				ref.QualifiedName = name
+			}
+		case *parser.ConstantReadNode, *parser.ConstantPathNode:
+			scope := w.reference(parent, nesting)
+			ref.Name = scope.Name + "::" + name
+			if scope.Resolved() {
+				ref.QualifiedName = r.lookupIn(scope.QualifiedName, name)
+			}
+		default:
+			ref.Name = "?::" + name
+			ref.dynamic = true
+			w.visit(parent, nesting)
+		}
+	}
+	if ref.Resolved() {
+		ref.Definitions = r.definitions[ref.QualifiedName]
+		ref.External = len(ref.Definitions) == 0
+	}
+	return ref
+}
@@ -0,0 +1,155 @@
+package constants_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/constants"
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+type file struct {
+	table *symbols.Table
+	root  parser.Node
+}
+
+// project parses and indexes files given as name and source pairs.
+func project(t *testing.T, sources ...string) []file {
+	t.Helper()
+	var files []file
+	for index := 0; index < len(sources); index += 2 {
+		root := parsetest.Parse(t, sources[index+1]).Value
+		files = append(files, file{table: symbols.Collect(sources[index], root), root: root})
+	}
+	return files
+}
+
+func newResolver(files []file, options ...constants.Option) *constants.Resolver {
+	tables := make([]*symbols.Table, len(files))
+	for index, f := range files {
+		tables[index] = f.table
+	}
+	return constants.NewResolver(tables, options...)
+}
+
+// describe lists the references of a result as name=qualified-name, with
+// ! for unresolved references and * for external ones.
+func describe(result *constants.Result) string {
+	parts := make([]string, len(result.References))
+	for index, ref := range result.References {
+		switch {
+		case !ref.Resolved():
+			parts[index] = ref.Name + "=!"
+		case ref.External:
+			parts[index] = ref.Name + "=*" + ref.QualifiedName
+		default:
+			parts[index] = ref.Name + "=" + ref.QualifiedName
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestResolve(t *testing.T) {
+	library := "module Admin\n  class Base\n    LIMIT = 1\n  end\n  module Helpers\n    FORMAT = 2\n  end\nend\nclass User\nend"
+	tests := []struct {
+		source string
+		want   string
+	}{
+		// Lexical scopes, innermost first, then the top level.
+		{"module Admin\n  class Users < Base\n    User\n  end\nend", "Base=Admin::Base User=User"},
+		{"class Admin::Users\n  Base\nend", "Admin=Admin Base=!"},
+		{"module Admin\n  User = 1\n  class Users\n    User\n  end\nend", "User=Admin::User"},
+		// Ancestors of the innermost class or module.
+		{"module Admin\n  class Users < Base\n    LIMIT\n  end\nend", "Base=Admin::Base LIMIT=Admin::Base::LIMIT"},
+		{"class Page\n  include Admin::Helpers\n  FORMAT\nend", "Admin::Helpers=Admin::Helpers Admin=Admin FORMAT=Admin::Helpers::FORMAT"},
+		{"class Page < Admin::Base\nend\nPage::LIMIT", "Admin::Base=Admin::Base Admin=Admin Page::LIMIT=Admin::Base::LIMIT Page=Page"},
+		// Paths, rooted paths and core constants.
+		{"Admin::Base::LIMIT", "Admin::Base::LIMIT=Admin::Base::LIMIT Admin::Base=Admin::Base Admin=Admin"},
+		{"module Admin\n  ::Base\n  ::User\nend", "::Base=! ::User=User"},
+		{"String\nFile::SEPARATOR\nMissing::Thing", "String=*String File::SEPARATOR=*File::SEPARATOR File=*File Missing::Thing=! Missing=!"},
+		{"foo::Bar", "?::Bar=!"},
+		{"defined?(Missing)", ""},
+		{"class << User\n  Admin\nend", "User=User Admin=Admin"},
+		{"Admin::Created = 1\nAdmin::Created", "Admin=Admin Admin::Created=Admin::Created Admin=Admin"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			files := project(t, "library.rb", library, "app.rb", test.source)
+			result := newResolver(files).Resolve(files[1].table, files[1].root)
+			if got := describe(result); got != test.want {
+				t.Errorf("references = %s\nwant         %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUnresolved(t *testing.T) {
+	files := project(t, "app.rb", "module App\n  Missing::Deep::Name\n  Rails::Engine\n  object::Name\n  App::Nope\nend")
+	result := newResolver(files).Resolve(files[0].table, files[0].root)
+	var names []string
+	for _, ref := range result.Unresolved() {
+		names = append(names, ref.Name+" in "+strings.Join(ref.Nesting, ","))
+	}
+	if got, want := strings.Join(names, "; "), "Missing in App; Rails in App; App::Nope in App"; got != want {
+		t.Errorf("Unresolved() = %s, want %s", got, want)
+	}
+
+	result = newResolver(files, constants.WithExternal("Rails")).Resolve(files[0].table, files[0].root)
+	for _, ref := range result.References {
+		if ref.Name == "Rails::Engine" && (!ref.External || ref.QualifiedName != "Rails::Engine") {
+			t.Errorf("Rails::Engine with Rails external = %+v", ref)
+		}
+	}
+}
+
+func TestReferenceDefinitions(t *testing.T) {
+	files := project(t, "a.rb", "class A\nend", "b.rb", "class A\n  X = 1\nend\nA::X")
+	result := newResolver(files).Resolve(files[1].table, files[1].root)
+	statements := files[1].root.(*parser.ProgramNode).Statements.Body
+	ref := result.Reference(statements[1])
+	if ref == nil || ref.QualifiedName != "A::X" || ref.External {
+		t.Fatalf("Reference(A::X) = %+v", ref)
+	}
+	parent := result.Reference(statements[1].(*parser.ConstantPathNode).Parent)
+	if parent == nil || len(parent.Definitions) != 2 || parent.Definitions[0].File != "a.rb" || parent.Definitions[1].File != "b.rb" {
+		t.Errorf("definitions of A = %v", parent)
+	}
+	if result.Reference(statements[0]) != nil {
+		t.Error("Reference(class A) != nil")
+	}
+}
+
+func TestAncestors(t *testing.T) {
+	files := project(t, "a.rb", `module M; end
+module N; end
+module P; end
+class Base
+  include M
+end
+class Child < Base
+  include M
+  include N
+  prepend P
+end
+class Grand < Child
+  include P
+  include N
+end
+class Loop < Loop
+end`)
+	r := newResolver(files)
+	tests := map[string]string{
+		"Child":   "P Child N Base M",
+		"Grand":   "Grand P Child N Base M",
+		"Base":    "Base M",
+		"Loop":    "Loop",
+		"Unknown": "Unknown",
+	}
+	for name, want := range tests {
+		if got := strings.Join(r.Ancestors(name), " "); got != want {
+			t.Errorf("Ancestors(%s) = %s, want %s", name, got, want)
+		}
+	}
+}
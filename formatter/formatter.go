@@ -0,0 +1,198 @@
+// Package formatter prints Ruby source in a canonical layout. It rebuilds
+// the source from the AST, two spaces per indentation level, and keeps what
+// the tree does not hold: comments, blank lines between statements, the
+// spelling of literals and heredocs, and the __END__ data section. Argument
+// lists, collections, parameters and blocks that do not fit in the line
+// width are broken over several lines.
+//
+// Every result is verified: the output is parsed again, must translate into
+// the same AST as the input, and must format into itself.
+package formatter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgatis/go-ruby-prism/internal/printer"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/translation/whitequark"
+)
+
+// DefaultLineWidth is the line width used without WithLineWidth.
+const DefaultLineWidth = 80
+
+var (
+	// ErrSyntax is returned for a parse result with errors, which cannot be
+	// formatted.
+	ErrSyntax = errors.New("formatter: the source has syntax errors")
+	// ErrChanged is returned when the output does not parse into the same
+	// AST as the source.
+	ErrChanged = errors.New("formatter: the output changes the AST")
+	// ErrUnstable is returned when formatting the output changes it again.
+	ErrUnstable = errors.New("formatter: the output is not stable")
+)
+
+type config struct {
+	lineWidth     int
+	parserOptions []parser.ParserOption
+	parser        *parser.Parser
+}
+
+// Option configures Format.
+type Option func(*config)
+
+// WithLineWidth sets the width lines are kept within where the layout
+// allows it. Zero keeps every construct on a single line.
+func WithLineWidth(width int) Option {
+	return func(c *config) {
+		c.lineWidth = width
+	}
+}
+
+// WithParserOptions sets the options used to parse the output when
+// verifying it, such as the syntax version of the source.
+func WithParserOptions(options ...parser.ParserOption) Option {
+	return func(c *config) {
+		c.parserOptions = options
+	}
+}
+
+// WithParser sets the parser used to parse the source and the output, so
+// that several calls share it instead of each creating a parser of its
+// own. The options of the parser are used rather than those given with
+// WithParserOptions, and the parser is not closed.
+func WithParser(p *parser.Parser) Option {
+	return func(c *config) {
+		c.parser = p
+	}
+}
+
+func newConfig(options []Option) *config {
+	c := &config{lineWidth: DefaultLineWidth}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Format returns the formatted source of a parse result.
+func Format(ctx context.Context, result *parser.ParseResult, options ...Option) ([]byte, error) {
+	c := newConfig(options)
+	p, err := c.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.close(ctx, p)
+	return c.verified(ctx, p, result)
+}
+
+// FormatSource parses source and returns it formatted.
+func FormatSource(ctx context.Context, source []byte, options ...Option) ([]byte, error) {
+	c := newConfig(options)
+	p, err := c.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.close(ctx, p)
+	result, err := p.Parse(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	return c.verified(ctx, p, result)
+}
+
+// open returns the parser set with WithParser, or a new one.
+func (c *config) open(ctx context.Context) (*parser.Parser, error) {
+	if c.parser != nil {
+		return c.parser, nil
+	}
+	return parser.NewParser(ctx, c.parserOptions...)
+}
+
+// close closes p if open created it.
+func (c *config) close(ctx context.Context, p *parser.Parser) {
+	if p != c.parser {
+		p.Close(ctx)
+	}
+}
+
+// verified formats a parse result, and checks that the output parses with
+// p into the same AST and formats into itself.
+func (c *config) verified(ctx context.Context, p *parser.Parser, result *parser.ParseResult) ([]byte, error) {
+	output, err := format(result, c)
+	if err != nil {
+		return nil, err
+	}
+
+	reparsed, err := p.Parse(ctx, output)
+	if err != nil {
+		return nil, err
+	}
+	if len(reparsed.Errors) > 0 || sexp(reparsed) != sexp(result) {
+		return nil, ErrChanged
+	}
+	again, err := format(reparsed, c)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(again, output) {
+		return nil, ErrUnstable
+	}
+	return output, nil
+}
+
+func format(result *parser.ParseResult, c *config) ([]byte, error) {
+	if len(result.Errors) > 0 {
+		return nil, ErrSyntax
+	}
+	if result.Source == nil {
+		return nil, fmt.Errorf("formatter: the parse result has no source")
+	}
+	source := result.Source.Bytes
+	printed, err := printer.Print(result.Value, printer.Options{
+		Source:    source,
+		Comments:  result.Comments,
+		LineWidth: c.lineWidth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("formatter: %w", err)
+	}
+
+	var output bytes.Buffer
+	if shebang := shebangLine(source, result.Comments); shebang != "" {
+		output.WriteString(shebang)
+	}
+	output.WriteString(printed)
+	if output.Len() > 0 && !bytes.HasSuffix(output.Bytes(), []byte("\n")) {
+		output.WriteByte('\n')
+	}
+	if result.DataLoc != nil {
+		location := result.DataLoc
+		output.Write(source[location.StartOffset:location.EndOffset()])
+	}
+	return output.Bytes(), nil
+}
+
+// shebangLine returns the #! line starting the source, unless it is one of
+// the comments.
+func shebangLine(source []byte, comments []parser.Comment) string {
+	if !bytes.HasPrefix(source, []byte("#!")) {
+		return ""
+	}
+	for _, comment := range comments {
+		if comment.Location.StartOffset == 0 {
+			return ""
+		}
+	}
+	line, _, _ := bytes.Cut(source, []byte("\n"))
+	return string(line) + "\n"
+}
+
+func sexp(result *parser.ParseResult) string {
+	if node := whitequark.Translate(result); node != nil {
+		return node.String()
+	}
+	return ""
+}
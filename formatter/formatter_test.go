@@ -0,0 +1,172 @@
+package formatter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    string
+		options []Option
+	}{
+		{
+			name: "layout and comments",
+			source: `# frozen_string_literal: true
+
+# A greeter.
+class Greeter   # trailing
+  def initialize( name )
+    @name=name # keep me
+
+
+    # before hi
+  end
+
+  def hi
+    puts( "hi #{@name}" )
+  end
+end
+`,
+			want: `# frozen_string_literal: true
+
+# A greeter.
+class Greeter # trailing
+  def initialize(name)
+    @name = name # keep me
+
+    # before hi
+  end
+
+  def hi
+    puts("hi #{@name}")
+  end
+end
+`,
+		},
+		{
+			name:   "comment only body",
+			source: "def foo\n    # only a comment\nend\n",
+			want:   "def foo\n  # only a comment\nend\n",
+		},
+		{
+			name:   "block comments",
+			source: "a = 1 # one\nb=2\n=begin\nblock comment\n=end\nc = 3\n",
+			want:   "a = 1 # one\nb = 2\n=begin\nblock comment\n=end\nc = 3\n",
+		},
+		{
+			name: "heredocs and shebang",
+			source: `#!/usr/bin/env ruby
+x = <<~SQL
+  SELECT *
+    FROM t
+SQL
+y = <<-EOS.strip
+  raw
+  EOS
+foo(<<~A,1)
+  a
+A
+`,
+			want: `#!/usr/bin/env ruby
+x = <<~SQL
+  SELECT *
+    FROM t
+SQL
+y = <<-EOS.strip
+  raw
+  EOS
+foo(<<~A, 1)
+  a
+A
+`,
+		},
+		{
+			name:   "data section",
+			source: "puts  1\n__END__\ndata  here\n more",
+			want:   "puts 1\n__END__\ndata  here\n more",
+		},
+		{
+			name:   "literals and blocks",
+			source: "x = {a: 1,  \"b\"=>2}\nif x then y end\n[1,2,3].map { |v|  v*2 }\n",
+			want:   "x = { a: 1, \"b\" => 2 }\nif x\n  y\nend\n[1, 2, 3].map { |v| v * 2 }\n",
+		},
+		{
+			name:   "long argument list",
+			source: "foo(aaaaaaaaaaaaaaaaaaaa, bbbbbbbbbbbbbbbbbbbbbbbbbbb, cccccccccccccccccccccccccc, ddddddddddddddd)\n",
+			want:   "foo(\n  aaaaaaaaaaaaaaaaaaaa,\n  bbbbbbbbbbbbbbbbbbbbbbbbbbb,\n  cccccccccccccccccccccccccc,\n  ddddddddddddddd\n)\n",
+		},
+		{
+			name:    "line width",
+			source:  "foo(aaaa, bbbb)\n",
+			want:    "foo(\n  aaaa,\n  bbbb\n)\n",
+			options: []Option{WithLineWidth(10)},
+		},
+	}
+	p, err := parser.NewParser(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close(context.Background())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := append(test.options, WithParser(p))
+			got, err := Format(context.Background(), parsetest.Parse(t, test.source), options...)
+			if err != nil {
+				t.Fatalf("Format() failed: %v", err)
+			}
+			if string(got) != test.want {
+				t.Fatalf("Format() =\n%s\nwant\n%s", got, test.want)
+			}
+			again, err := Format(context.Background(), parsetest.Parse(t, string(got)), options...)
+			if err != nil {
+				t.Fatalf("Format() of the output failed: %v", err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("Format() of the output =\n%s\nwant it unchanged", again)
+			}
+		})
+	}
+}
+
+func TestFormatSyntaxError(t *testing.T) {
+	result := parsetest.ParseWithErrors(t, "def foo(\n")
+	if _, err := Format(context.Background(), result); !errors.Is(err, ErrSyntax) {
+		t.Errorf("Format() = %v, want ErrSyntax", err)
+	}
+}
+
+// TestFormatSource checks that sources are formatted with a parser of their
+// own, or with one shared by several calls, which is left open.
+func TestFormatSource(t *testing.T) {
+	ctx := context.Background()
+	p, err := parser.NewParser(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close(ctx)
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"x=1\n", "x = 1\n"},
+		{"def f( a ) a end\n", "def f(a)\n  a\nend\n"},
+	}
+	for _, options := range [][]Option{nil, {WithParser(p)}} {
+		for _, test := range tests {
+			got, err := FormatSource(ctx, []byte(test.source), options...)
+			if err != nil || string(got) != test.want {
+				t.Errorf("FormatSource(%q) = %q, %v, want %q", test.source, got, err, test.want)
+			}
+		}
+	}
+	if _, err := FormatSource(ctx, []byte("def foo(\n"), WithParser(p)); !errors.Is(err, ErrSyntax) {
+		t.Errorf("FormatSource() = %v, want ErrSyntax", err)
+	}
+}
@@ -0,0 +1,34 @@
+// Package enum names the values of the enumerations of the analysis
+// packages, such as the kinds of their results.
+package enum
+
+import (
+	"fmt"
+)
+
+// Names are the names of the values of an enumeration, indexed by value:
+//
+//	var kindNames = enum.Names[Kind]{
+//		Insert: "insert",
+//		Delete: "delete",
+//	}
+type Names[T ~int] []string
+
+// String returns the name of a value, or the type and number of a value
+// with no name, such as treediff.Kind(9).
+func (n Names[T]) String(value T) string {
+	if value >= 0 && int(value) < len(n) && n[value] != "" {
+		return n[value]
+	}
+	return fmt.Sprintf("%T(%d)", value, int(value))
+}
+
+// Parse returns the value with a name.
+func (n Names[T]) Parse(name string) (T, bool) {
+	for value, candidate := range n {
+		if candidate == name && name != "" {
+			return T(value), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,43 @@
+package enum
+
+import (
+	"testing"
+)
+
+type color int
+
+const (
+	red color = iota
+	green
+	blue
+)
+
+var colorNames = Names[color]{
+	red:   "red",
+	green: "green",
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		value color
+		want  string
+	}{
+		{red, "red"},
+		{green, "green"},
+		{blue, "enum.color(2)"},
+		{-1, "enum.color(-1)"},
+	}
+	for _, test := range tests {
+		if got := colorNames.String(test.value); got != test.want {
+			t.Errorf("String(%d) = %q, want %q", test.value, got, test.want)
+		}
+	}
+	if value, ok := colorNames.Parse("green"); !ok || value != green {
+		t.Errorf("Parse(green) = %v, %v", value, ok)
+	}
+	for _, name := range []string{"blue", ""} {
+		if value, ok := colorNames.Parse(name); ok {
+			t.Errorf("Parse(%q) = %v", name, value)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Package parsetest parses Ruby sources for tests. Creating a parser is
+// slow, so the tests of a package share one.
+package parsetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+var (
+	shared     *parser.Parser
+	sharedErr  error
+	sharedOnce sync.Once
+)
+
+// Parse parses source, and fails the test if it has syntax errors.
+func Parse(t testing.TB, source string) *parser.ParseResult {
+	t.Helper()
+	result := ParseWithErrors(t, source)
+	if len(result.Errors) > 0 {
+		t.Fatalf("parse %q: %s", source, result.Errors[0].Message)
+	}
+	return result
+}
+
+// ParseWithErrors parses source, which may have syntax errors.
+func ParseWithErrors(t testing.TB, source string) *parser.ParseResult {
+	t.Helper()
+	sharedOnce.Do(func() {
+		shared, sharedErr = parser.NewParser(context.Background())
+	})
+	if sharedErr != nil {
+		t.Fatalf("create parser: %v", sharedErr)
+	}
+	result, err := shared.Parse(context.Background(), []byte(source))
+	if err != nil {
+		t.Fatalf("parse %q: %v", source, err)
+	}
+	return result
+}
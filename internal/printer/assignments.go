@@ -0,0 +1,182 @@
+package printer
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// assignment writes a variable, constant, attribute or index assignment,
+// including the abbreviated ones such as a ||= b.
+func (g *generator) assignment(node parser.Node) {
+	switch n := node.(type) {
+	case *parser.LocalVariableWriteNode:
+		g.assign(n.Name, "=", n.Value)
+	case *parser.LocalVariableAndWriteNode:
+		g.assign(n.Name, "&&=", n.Value)
+	case *parser.LocalVariableOrWriteNode:
+		g.assign(n.Name, "||=", n.Value)
+	case *parser.LocalVariableOperatorWriteNode:
+		g.assign(n.Name, n.BinaryOperator+"=", n.Value)
+	case *parser.InstanceVariableWriteNode:
+		g.assign(n.Name, "=", n.Value)
+	case *parser.InstanceVariableAndWriteNode:
+		g.assign(n.Name, "&&=", n.Value)
+	case *parser.InstanceVariableOrWriteNode:
+		g.assign(n.Name, "||=", n.Value)
+	case *parser.InstanceVariableOperatorWriteNode:
+		g.assign(n.Name, n.BinaryOperator+"=", n.Value)
+	case *parser.ClassVariableWriteNode:
+		g.assign(n.Name, "=", n.Value)
+	case *parser.ClassVariableAndWriteNode:
+		g.assign(n.Name, "&&=", n.Value)
+	case *parser.ClassVariableOrWriteNode:
+		g.assign(n.Name, "||=", n.Value)
+	case *parser.ClassVariableOperatorWriteNode:
+		g.assign(n.Name, n.BinaryOperator+"=", n.Value)
+	case *parser.GlobalVariableWriteNode:
+		g.assign(n.Name, "=", n.Value)
+	case *parser.GlobalVariableAndWriteNode:
+		g.assign(n.Name, "&&=", n.Value)
+	case *parser.GlobalVariableOrWriteNode:
+		g.assign(n.Name, "||=", n.Value)
+	case *parser.GlobalVariableOperatorWriteNode:
+		g.assign(n.Name, n.BinaryOperator+"=", n.Value)
+	case *parser.ConstantWriteNode:
+		g.assign(n.Name, "=", n.Value)
+	case *parser.ConstantAndWriteNode:
+		g.assign(n.Name, "&&=", n.Value)
+	case *parser.ConstantOrWriteNode:
+		g.assign(n.Name, "||=", n.Value)
+	case *parser.ConstantOperatorWriteNode:
+		g.assign(n.Name, n.BinaryOperator+"=", n.Value)
+	case *parser.ConstantPathWriteNode:
+		g.constantPath(n.Target.Parent, n.Target.Name)
+		g.assign("", "=", n.Value)
+	case *parser.ConstantPathAndWriteNode:
+		g.constantPath(n.Target.Parent, n.Target.Name)
+		g.assign("", "&&=", n.Value)
+	case *parser.ConstantPathOrWriteNode:
+		g.constantPath(n.Target.Parent, n.Target.Name)
+		g.assign("", "||=", n.Value)
+	case *parser.ConstantPathOperatorWriteNode:
+		g.constantPath(n.Target.Parent, n.Target.Name)
+		g.assign("", n.BinaryOperator+"=", n.Value)
+	case *parser.CallAndWriteNode:
+		g.attribute(n.Receiver, n.CallOperatorLoc, n.IsSAFE_NAVIGATION(), n.ReadName)
+		g.assign("", "&&=", n.Value)
+	case *parser.CallOrWriteNode:
+		g.attribute(n.Receiver, n.CallOperatorLoc, n.IsSAFE_NAVIGATION(), n.ReadName)
+		g.assign("", "||=", n.Value)
+	case *parser.CallOperatorWriteNode:
+		g.attribute(n.Receiver, n.CallOperatorLoc, n.IsSAFE_NAVIGATION(), n.ReadName)
+		g.assign("", n.BinaryOperator+"=", n.Value)
+	case *parser.IndexAndWriteNode:
+		g.index(n.Receiver, n.Arguments, n.Block)
+		g.assign("", "&&=", n.Value)
+	case *parser.IndexOrWriteNode:
+		g.index(n.Receiver, n.Arguments, n.Block)
+		g.assign("", "||=", n.Value)
+	case *parser.IndexOperatorWriteNode:
+		g.index(n.Receiver, n.Arguments, n.Block)
+		g.assign("", n.BinaryOperator+"=", n.Value)
+	}
+}
+
+// assign writes the name of the assigned variable, if any, followed by
+// the operator and the value.
+func (g *generator) assign(name, operator string, value parser.Node) {
+	g.write(name, " ", operator, " ")
+	g.rhs(value)
+}
+
+// attribute writes the receiver and name of an attribute, as in a.b.
+func (g *generator) attribute(receiver parser.Node, operatorLoc *parser.Location, safeNavigation bool, name string) {
+	if receiver != nil {
+		g.expression(receiver, precPrimary)
+		g.write(callOperator(operatorLoc, safeNavigation))
+	}
+	g.write(name)
+}
+
+// index writes the receiver and arguments of an element reference, as in
+// a[b].
+func (g *generator) index(receiver parser.Node, arguments *parser.ArgumentsNode, block *parser.BlockArgumentNode) {
+	g.expression(receiver, precPrimary)
+	g.write("[")
+	g.list(callArguments(arguments, block), g.argument)
+	g.write("]")
+}
+
+// rhs writes the value of an assignment. Arrays written without brackets,
+// as in a = 1, 2, keep that form.
+func (g *generator) rhs(value parser.Node) {
+	if array, ok := value.(*parser.ArrayNode); ok && parsed(array) && array.OpeningLoc == nil && len(array.Elements) > 0 {
+		g.commandArguments(array.Elements)
+		return
+	}
+	g.tail(value, precCommand)
+}
+
+// target writes the target of a multiple assignment, a for loop or a
+// rescue clause.
+func (g *generator) target(node parser.Node) {
+	defer g.visit(node)()
+	switch n := node.(type) {
+	case *parser.LocalVariableTargetNode:
+		g.write(n.Name)
+	case *parser.InstanceVariableTargetNode:
+		g.write(n.Name)
+	case *parser.ClassVariableTargetNode:
+		g.write(n.Name)
+	case *parser.GlobalVariableTargetNode:
+		g.write(n.Name)
+	case *parser.ConstantTargetNode:
+		g.write(n.Name)
+	case *parser.ConstantPathTargetNode:
+		g.constantPath(n.Parent, n.Name)
+	case *parser.CallTargetNode:
+		g.attribute(n.Receiver, &n.CallOperatorLoc, n.IsSAFE_NAVIGATION(), strings.TrimSuffix(n.Name, "="))
+	case *parser.IndexTargetNode:
+		g.index(n.Receiver, n.Arguments, n.Block)
+	case *parser.MultiTargetNode:
+		g.write("(")
+		g.targets(n.Lefts, n.Rest, n.Rights)
+		g.write(")")
+	case *parser.SplatNode:
+		g.write("*")
+		if n.Expression != nil {
+			g.target(n.Expression)
+		}
+	case *parser.RequiredParameterNode:
+		g.write(n.Name)
+	default:
+		g.node(node, precPrimary)
+	}
+}
+
+// targets writes the targets of a multiple assignment, separated by
+// commas.
+func (g *generator) targets(lefts []parser.Node, rest parser.Node, rights []parser.Node) {
+	nodes := append([]parser.Node(nil), lefts...)
+	_, implicitRest := rest.(*parser.ImplicitRestNode)
+	if rest != nil && !implicitRest {
+		nodes = append(nodes, rest)
+	}
+	nodes = append(nodes, rights...)
+	g.list(nodes, g.target)
+	if implicitRest {
+		// a, = b assigns the first element of b.
+		g.write(",")
+	}
+}
+
+func (g *generator) multiWrite(n *parser.MultiWriteNode) {
+	if n.LparenLoc != nil && parsed(n) {
+		g.target(&parser.MultiTargetNode{Lefts: n.Lefts, Rest: n.Rest, Rights: n.Rights})
+	} else {
+		g.targets(n.Lefts, n.Rest, n.Rights)
+	}
+	g.write(" = ")
+	g.rhs(n.Value)
+}
@@ -0,0 +1,629 @@
+package printer
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+type callForm int
+
+const (
+	formCall callForm = iota
+	formCommand
+	formBinary
+	formUnary
+	formNot
+	formIndex
+	formIndexWrite
+	formAttributeWrite
+)
+
+func (g *generator) callForm(n *parser.CallNode, min int) callForm {
+	arguments := argumentList(n.Arguments)
+	block := n.Block != nil
+	// Operators called with a dot, as in a.+(b), are written as calls.
+	dotted := n.CallOperatorLoc != nil
+	if n.Receiver != nil && !dotted && !block {
+		if _, ok := binaryOperators[n.Name]; ok && len(arguments) == 1 && plainArgument(arguments[0]) {
+			return formBinary
+		}
+		if len(arguments) == 0 {
+			switch n.Name {
+			case "!":
+				if n.MessageLoc != nil && n.MessageLoc.Length == len("not") {
+					return formNot
+				}
+				if !parsed(n) && min <= precNot && g.precedence(n.Receiver, precUnary) < precUnary {
+					// not a == b needs no parentheses.
+					return formNot
+				}
+				return formUnary
+			case "-@", "+@", "~":
+				return formUnary
+			}
+		}
+	}
+	if n.Receiver != nil && !dotted && !n.IsSAFE_NAVIGATION() {
+		switch {
+		case n.Name == "[]=" && len(arguments) > 0 && n.Block == nil:
+			return formIndexWrite
+		case n.Name == "[]":
+			return formIndex
+		}
+	}
+	if n.Receiver != nil && isSetter(n.Name) && len(arguments) == 1 && plainArgument(arguments[0]) && n.Block == nil &&
+		(n.IsATTRIBUTE_WRITE() || !parsed(n)) {
+		return formAttributeWrite
+	}
+	// Built calls are only written as commands without a receiver, as in
+	// puts x.
+	if (parsed(n) || n.Receiver == nil) && g.commandFits(n.OpeningLoc, callArguments(n.Arguments, n.Block), n.Block, min) {
+		return formCommand
+	}
+	return formCall
+}
+
+func (g *generator) callPrecedence(n *parser.CallNode, min int) int {
+	switch g.callForm(n, min) {
+	case formCommand:
+		return precCommand
+	case formBinary:
+		return binaryOperators[n.Name].precedence
+	case formUnary:
+		if n.Name == "-@" {
+			return precUnaryMinus
+		}
+		return precUnary
+	case formNot:
+		return precNot
+	case formIndexWrite, formAttributeWrite:
+		return precAssignment
+	}
+	return precPrimary
+}
+
+// commandFits reports whether a call can be written as a command, with
+// its arguments not in parentheses.
+func (g *generator) commandFits(opening *parser.Location, arguments []parser.Node, block parser.Node, min int) bool {
+	if len(arguments) == 0 || opening != nil || min > precCommand {
+		return false
+	}
+	if _, ok := block.(*parser.BlockNode); ok && g.context.condition {
+		return false
+	}
+	// Avoid first arguments that would read as a block, a modifier or a
+	// binary operator.
+	switch first := arguments[0].(type) {
+	case *parser.HashNode, *parser.ForwardingArgumentsNode,
+		*parser.IfNode, *parser.UnlessNode, *parser.WhileNode, *parser.UntilNode,
+		*parser.SplatNode, *parser.AssocSplatNode, *parser.BlockArgumentNode:
+		return false
+	case *parser.KeywordHashNode:
+		if len(first.Elements) > 0 {
+			if _, ok := first.Elements[0].(*parser.AssocSplatNode); ok {
+				return false
+			}
+		}
+	case *parser.CallNode:
+		if first.Receiver != nil && first.Arguments == nil && (first.Name == "-@" || first.Name == "+@") {
+			return false
+		}
+	default:
+		if negativeNumber(first) {
+			return false
+		}
+	}
+	return true
+}
+
+// doAllowed reports whether a block at a position requiring min can be
+// written with do and end without binding to another call.
+func (g *generator) doAllowed(min int) bool {
+	return min <= precAssignment && !g.context.command && !g.context.condition
+}
+
+func (g *generator) call(n *parser.CallNode, min int) {
+	arguments := argumentList(n.Arguments)
+	form := g.callForm(n, min)
+	switch form {
+	case formBinary:
+		operator := binaryOperators[n.Name]
+		left := operator.left
+		if n.Name == "**" && negativeNumber(n.Receiver) {
+			// -2 ** 2 is read as -(2 ** 2).
+			left = precPrimary + 1
+		}
+		g.expression(n.Receiver, left)
+		g.write(" ", n.Name, " ")
+		g.tail(arguments[0], operator.right)
+		return
+	case formNot:
+		g.write("not ")
+		g.tail(n.Receiver, precAssignment)
+		return
+	case formUnary:
+		operator := strings.TrimSuffix(n.Name, "@")
+		g.write(operator)
+		if operator != "!" && operator != "~" && startsWithNumber(n.Receiver) {
+			// -1 is a literal, - 1 a call.
+			g.write(" ")
+		}
+		if n.Name == "-@" {
+			g.expression(n.Receiver, precUnaryMinus)
+		} else {
+			g.expression(n.Receiver, precUnary)
+		}
+		return
+	case formIndex:
+		g.expression(n.Receiver, precPrimary)
+		g.write("[")
+		g.list(callArguments(n.Arguments, n.Block), g.argument)
+		g.write("]")
+		g.attachBlock(n.Block, min)
+		return
+	case formIndexWrite:
+		last := len(arguments) - 1
+		g.expression(n.Receiver, precPrimary)
+		g.write("[")
+		g.list(arguments[:last], g.argument)
+		g.write("] = ")
+		g.rhs(arguments[last])
+		return
+	case formAttributeWrite:
+		g.expression(n.Receiver, precPrimary)
+		g.write(callOperator(n.CallOperatorLoc, n.IsSAFE_NAVIGATION()), strings.TrimSuffix(n.Name, "="), " = ")
+		g.rhs(arguments[0])
+		return
+	}
+
+	if n.Receiver != nil {
+		g.expression(n.Receiver, precPrimary)
+		g.write(callOperator(n.CallOperatorLoc, n.IsSAFE_NAVIGATION()))
+		// a.() is a call to a.call.
+		if n.MessageLoc != nil || !parsed(n) || n.Name != "call" {
+			g.write(n.Name)
+		}
+	} else {
+		g.write(n.Name)
+	}
+
+	all := callArguments(n.Arguments, n.Block)
+	switch {
+	case form == formCommand:
+		g.command(all)
+		if block, ok := n.Block.(*parser.BlockNode); ok {
+			g.doBlock(block)
+		}
+		return
+	case len(all) > 0:
+		g.arguments(all)
+	case n.OpeningLoc != nil && parsed(n),
+		n.Receiver != nil && n.MessageLoc == nil && parsed(n),
+		// Without arguments, foo reads as a local variable if there is one.
+		n.Receiver == nil && !n.IsVARIABLE_CALL() && n.Block == nil && !parsed(n) && ruby.IsIdentifier(n.Name),
+		n.Receiver == nil && startsUpper(n.Name):
+		g.write("()")
+	}
+	g.attachBlock(n.Block, min)
+}
+
+// attachBlock writes the block of a call, if it has one.
+func (g *generator) attachBlock(block parser.Node, min int) {
+	if block, ok := block.(*parser.BlockNode); ok && block != nil {
+		g.block(block, g.doAllowed(min))
+	}
+}
+
+// arguments writes the arguments of a call in parentheses. Broken over
+// several lines, each keyword argument goes on its own line.
+func (g *generator) arguments(arguments []parser.Node) {
+	var items []parser.Node
+	for _, argument := range arguments {
+		if keywords, ok := argument.(*parser.KeywordHashNode); ok {
+			items = append(items, keywords.Elements...)
+		} else {
+			items = append(items, argument)
+		}
+	}
+	g.delimited("(", ")", "", items, g.argument)
+}
+
+// command writes the arguments of a command, or when they do not fit on
+// the line, in parentheses over several lines.
+func (g *generator) command(arguments []parser.Node) {
+	g.group(func() {
+		g.write(" ")
+		g.commandArguments(arguments)
+	}, func() {
+		g.arguments(arguments)
+	})
+}
+
+// commandArguments writes the arguments of a command. The last one is
+// followed by whatever follows the command.
+func (g *generator) commandArguments(arguments []parser.Node) {
+	saved := g.context
+	g.context.command = true
+	for index, argument := range arguments {
+		if index > 0 {
+			g.write(", ")
+		}
+		g.context.delimited = index < len(arguments)-1 || saved.delimited
+		g.argument(argument)
+	}
+	g.context = saved
+}
+
+func (g *generator) super(n *parser.SuperNode, min int) {
+	g.write("super")
+	all := callArguments(n.Arguments, n.Block)
+	if g.superCommand(n, min) {
+		g.command(all)
+		if block, ok := n.Block.(*parser.BlockNode); ok {
+			g.doBlock(block)
+		}
+		return
+	}
+	// super without parentheses forwards the arguments of the method.
+	g.arguments(all)
+	g.attachBlock(n.Block, min)
+}
+
+func (g *generator) superCommand(n *parser.SuperNode, min int) bool {
+	return g.commandFits(n.LparenLoc, callArguments(n.Arguments, n.Block), n.Block, min)
+}
+
+func (g *generator) yield(n *parser.YieldNode, min int) {
+	g.write("yield")
+	arguments := argumentList(n.Arguments)
+	switch {
+	case g.yieldCommand(n, min):
+		g.command(arguments)
+	case len(arguments) > 0 || n.LparenLoc != nil:
+		g.arguments(arguments)
+	}
+}
+
+func (g *generator) yieldCommand(n *parser.YieldNode, min int) bool {
+	return g.commandFits(n.LparenLoc, argumentList(n.Arguments), nil, min)
+}
+
+// jump writes break, next or return with its arguments.
+func (g *generator) jump(keyword string, arguments *parser.ArgumentsNode) {
+	g.write(keyword)
+	if list := argumentList(arguments); len(list) > 0 {
+		g.write(" ")
+		g.commandArguments(list)
+	}
+}
+
+func argumentList(arguments *parser.ArgumentsNode) []parser.Node {
+	if arguments == nil {
+		return nil
+	}
+	return arguments.Arguments
+}
+
+// callArguments returns the arguments of a call followed by its block
+// argument, if it has one.
+func callArguments(arguments *parser.ArgumentsNode, block parser.Node) []parser.Node {
+	list := argumentList(arguments)
+	if block, ok := block.(*parser.BlockArgumentNode); ok && block != nil {
+		list = append(append([]parser.Node(nil), list...), block)
+	}
+	return list
+}
+
+// plainArgument reports whether the argument can be the operand of an
+// operator.
+func plainArgument(node parser.Node) bool {
+	switch node.(type) {
+	case *parser.SplatNode, *parser.BlockArgumentNode, *parser.KeywordHashNode, *parser.ForwardingArgumentsNode:
+		return false
+	}
+	return true
+}
+
+func callOperator(location *parser.Location, safeNavigation bool) string {
+	switch {
+	case safeNavigation:
+		return "&."
+	case location != nil && location.Length == len("::"):
+		return "::"
+	}
+	return "."
+}
+
+func isSetter(name string) bool {
+	switch name {
+	case "==", "!=", "<=", ">=", "===", "[]=":
+		return false
+	}
+	return strings.HasSuffix(name, "=") && ruby.IsIdentifier(name[:len(name)-1])
+}
+
+func startsUpper(name string) bool {
+	return name != "" && name[0] >= 'A' && name[0] <= 'Z'
+}
+
+// startsWithNumber reports whether the source of node starts with a
+// numeric literal.
+func startsWithNumber(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.IntegerNode, *parser.FloatNode, *parser.RationalNode, *parser.ImaginaryNode:
+		return true
+	case *parser.CallNode:
+		if n.Receiver != nil && !(n.CallOperatorLoc == nil && n.Arguments == nil && strings.HasSuffix(n.Name, "@")) {
+			return startsWithNumber(n.Receiver)
+		}
+	}
+	return false
+}
+
+// negativeNumber reports whether node is a negative numeric literal.
+func negativeNumber(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.IntegerNode:
+		return n.Value < 0
+	case *parser.FloatNode:
+		return n.Value < 0
+	case *parser.RationalNode:
+		return n.Numerator < 0
+	case *parser.ImaginaryNode:
+		return negativeNumber(n.Numeric)
+	}
+	return false
+}
+
+// block writes a block with braces, or with do and end when allowed and
+// the block was written so or spans several statements.
+func (g *generator) block(n *parser.BlockNode, allowDo bool) {
+	useDo := false
+	switch {
+	case !allowDo:
+	case hasClauses(n.Body):
+		useDo = true
+	case parsed(n):
+		useDo = n.OpeningLoc.Length == len("do")
+	default:
+		useDo = len(statementList(n.Body)) > 1
+	}
+	if useDo {
+		g.doBlock(n)
+	} else {
+		g.braceBlock(n)
+	}
+}
+
+func (g *generator) doBlock(n *parser.BlockNode) {
+	defer g.visit(n)()
+	g.write(" do")
+	if parameters, ok := n.Parameters.(*parser.BlockParametersNode); ok && parameters != nil {
+		g.write(" ")
+		g.blockParameters(parameters)
+	}
+	g.bodyStatements(n.Body)
+	g.write("end")
+}
+
+func (g *generator) braceBlock(n *parser.BlockNode) {
+	defer g.visit(n)()
+	g.write(" {")
+	if parameters, ok := n.Parameters.(*parser.BlockParametersNode); ok && parameters != nil {
+		g.write(" ")
+		g.blockParameters(parameters)
+	}
+	g.braceBody(n.Body)
+	g.write("}")
+}
+
+// braceBody writes the body of a brace block or lambda, on the same line
+// when it is a single simple statement.
+func (g *generator) braceBody(body parser.Node) {
+	statements := statementList(body)
+	switch {
+	case len(statements) == 0:
+		g.write(" ")
+	case len(statements) == 1 && !multiline(statements[0]):
+		g.group(func() {
+			saved := g.context
+			g.context = context{delimited: true}
+			g.write(" ")
+			g.statement(statements[0])
+			g.write(" ")
+			g.context = saved
+		}, func() {
+			g.bodyStatements(body)
+		})
+	default:
+		g.bodyStatements(body)
+	}
+}
+
+// multiline reports whether the node is written over several lines.
+func multiline(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.IfNode:
+		return ifForm(n, precStatement) == formFull
+	case *parser.UnlessNode:
+		return !unlessModifier(n, precStatement)
+	case *parser.WhileNode:
+		return !loopModifier(n, n.Statements, n.ClosingLoc, n.IsBEGIN_MODIFIER(), precStatement)
+	case *parser.UntilNode:
+		return !loopModifier(n, n.Statements, n.ClosingLoc, n.IsBEGIN_MODIFIER(), precStatement)
+	case *parser.DefNode:
+		return !endless(n)
+	case *parser.CaseNode, *parser.CaseMatchNode, *parser.BeginNode, *parser.ForNode,
+		*parser.ClassNode, *parser.ModuleNode, *parser.SingletonClassNode:
+		return true
+	}
+	return false
+}
+
+func (g *generator) blockParameters(n *parser.BlockParametersNode) {
+	g.write("|")
+	if n.Parameters != nil {
+		g.parameters(n.Parameters)
+	}
+	g.blockLocals(n.Locals)
+	g.write("|")
+}
+
+func (g *generator) blockLocals(locals []parser.Node) {
+	if len(locals) > 0 {
+		g.write("; ")
+		g.list(locals, g.parameter)
+	}
+}
+
+func (g *generator) lambda(n *parser.LambdaNode) {
+	g.write("->")
+	if parameters, ok := n.Parameters.(*parser.BlockParametersNode); ok && parameters != nil {
+		g.write("(")
+		if parameters.Parameters != nil {
+			g.parameters(parameters.Parameters)
+		}
+		g.blockLocals(parameters.Locals)
+		g.write(")")
+	}
+	useDo := !g.context.command && (hasClauses(n.Body) || parsed(n) && n.OpeningLoc.Length == len("do"))
+	if useDo {
+		g.write(" do")
+		g.bodyStatements(n.Body)
+		g.write("end")
+		return
+	}
+	g.write(" {")
+	g.braceBody(n.Body)
+	g.write("}")
+}
+
+func (g *generator) parameters(n *parser.ParametersNode) {
+	nodes, implicitRest := parameterList(n)
+	g.list(nodes, g.parameter)
+	if implicitRest {
+		// |a,| ignores the elements after the first one.
+		g.write(",")
+	}
+}
+
+// parameterList returns the parameters in order, and whether they end with
+// an implicit rest parameter.
+func parameterList(n *parser.ParametersNode) ([]parser.Node, bool) {
+	var nodes []parser.Node
+	nodes = append(nodes, n.Requireds...)
+	nodes = append(nodes, n.Optionals...)
+	_, implicitRest := n.Rest.(*parser.ImplicitRestNode)
+	if n.Rest != nil && !implicitRest {
+		nodes = append(nodes, n.Rest)
+	}
+	nodes = append(nodes, n.Posts...)
+	nodes = append(nodes, n.Keywords...)
+	if n.KeywordRest != nil {
+		nodes = append(nodes, n.KeywordRest)
+	}
+	if n.Block != nil {
+		nodes = append(nodes, n.Block)
+	}
+	return nodes, implicitRest
+}
+
+func (g *generator) parameter(node parser.Node) {
+	defer g.visit(node)()
+	switch n := node.(type) {
+	case *parser.RequiredParameterNode:
+		g.write(n.Name)
+	case *parser.MultiTargetNode:
+		g.target(n)
+	case *parser.OptionalParameterNode:
+		g.write(n.Name, " = ")
+		g.argument(n.Value)
+	case *parser.RestParameterNode:
+		g.write("*", optional(n.Name))
+	case *parser.RequiredKeywordParameterNode:
+		g.write(n.Name, ":")
+	case *parser.OptionalKeywordParameterNode:
+		g.write(n.Name, ": ")
+		g.argument(n.Value)
+	case *parser.KeywordRestParameterNode:
+		g.write("**", optional(n.Name))
+	case *parser.NoKeywordsParameterNode:
+		g.write("**nil")
+	case *parser.ForwardingParameterNode:
+		g.write("...")
+	case *parser.BlockParameterNode:
+		g.write("&", optional(n.Name))
+	case *parser.BlockLocalVariableNode:
+		g.write(n.Name)
+	default:
+		g.node(node, precPrimary)
+	}
+}
+
+func (g *generator) def(n *parser.DefNode) {
+	g.write("def ")
+	switch receiver := n.Receiver.(type) {
+	case nil:
+	case *parser.SelfNode, *parser.LocalVariableReadNode, *parser.InstanceVariableReadNode, *parser.ClassVariableReadNode,
+		*parser.GlobalVariableReadNode, *parser.ConstantReadNode:
+		g.node(receiver, precPrimary)
+		g.write(".")
+	default:
+		g.write("(")
+		g.statement(receiver)
+		g.write(").")
+	}
+	g.write(n.Name)
+	switch {
+	case n.Parameters != nil:
+		nodes, _ := parameterList(n.Parameters)
+		g.delimited("(", ")", "", nodes, g.parameter)
+	case n.LparenLoc != nil && parsed(n):
+		g.write("()")
+	}
+
+	if endless(n) {
+		g.write(" = ")
+		statements := statementList(n.Body)
+		if len(statements) == 1 {
+			g.tail(statements[0], precAssignment)
+		} else {
+			g.node(n.Body, precPrimary)
+		}
+		return
+	}
+	g.bodyStatements(n.Body)
+	g.write("end")
+}
+
+// endless reports whether the method was defined as def name = body.
+func endless(n *parser.DefNode) bool {
+	return n.EqualLoc != nil && !isSetter(n.Name) && len(statementList(n.Body)) == 1 && !hasClauses(n.Body)
+}
+
+// hasClauses reports whether a body has rescue, else or ensure clauses.
+func hasClauses(body parser.Node) bool {
+	begin, ok := body.(*parser.BeginNode)
+	return ok && begin != nil && begin.BeginKeywordLoc == nil
+}
+
+// bodyStatements writes the body of a method, class, module or block,
+// including its rescue, else and ensure clauses.
+func (g *generator) bodyStatements(body parser.Node) {
+	if hasClauses(body) {
+		begin := body.(*parser.BeginNode)
+		g.indented(begin.Statements)
+		g.clauses(begin)
+		return
+	}
+	g.indented(body)
+}
+
+func optional(name *string) string {
+	if name == nil {
+		return ""
+	}
+	return *name
+}
@@ -0,0 +1,376 @@
+package printer
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+const commentEmbDoc = 1
+
+type placement int
+
+const (
+	// A leading comment is written on its own line before the node that
+	// follows it.
+	leading placement = iota
+	// A trailing comment is written at the end of the line of the node
+	// that precedes it.
+	trailing
+	// A dangling comment is written at the end of the body of the node
+	// that encloses it, when it has no node before or after it.
+	dangling
+)
+
+// comment is a comment of the source, placed next to a node of the tree.
+type comment struct {
+	start, end int
+	text       string
+	embDoc     bool
+	placement  placement
+	// anchor is the start of the following node of a leading comment, or
+	// the end of the preceding node of a trailing one.
+	anchor int
+	owner  parser.Node
+}
+
+// comments holds the state of the comments while printing. Comments are
+// written once the nodes around them are: leading comments when entering
+// the node that follows them, and trailing comments, which are queued until
+// the end of the line, when leaving the node that precedes them.
+type comments struct {
+	placed  []comment
+	printed []bool
+	// journal lists the printed comments in order, so that a group can
+	// undo the ones it printed.
+	journal []int
+	// next is the first comment that may not be printed yet.
+	next int
+	// line holds the comments queued for the end of the current line.
+	line       []int
+	stack      []parser.Node
+	lineStarts []int
+	// bodyStart is set at the start of a body, where blank lines are not
+	// kept.
+	bodyStart bool
+	// emitted counts the comments written or queued, so that a group laid
+	// out on one line can tell it moved one.
+	emitted int
+}
+
+// attach places each comment next to a node of the tree. Comments inside
+// literals written from the source are part of them.
+func (g *generator) attach(root parser.Node, list []parser.Comment) {
+	g.lineStarts = []int{0}
+	for index, c := range g.source {
+		if c == '\n' {
+			g.lineStarts = append(g.lineStarts, index+1)
+		}
+	}
+	var literals [][2]int
+	g.literalRanges(root, &literals)
+
+	sorted := append([]parser.Comment(nil), list...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Location.StartOffset < sorted[j].Location.StartOffset
+	})
+	for _, c := range sorted {
+		start, end := c.Location.StartOffset, c.Location.EndOffset()
+		if within(literals, start) {
+			continue
+		}
+		entry := comment{
+			start:  start,
+			end:    end,
+			text:   string(g.source[start:end]),
+			embDoc: c.Type == commentEmbDoc,
+		}
+		g.place(root, &entry)
+		g.placed = append(g.placed, entry)
+	}
+	g.printed = make([]bool, len(g.placed))
+}
+
+func within(ranges [][2]int, offset int) bool {
+	for _, r := range ranges {
+		if offset >= r[0] && offset < r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// literalRanges collects the ranges of the source written as they are,
+// including the bodies of heredocs, which follow the line of their opening.
+func (g *generator) literalRanges(node parser.Node, ranges *[][2]int) {
+	if g.verbatim(node) {
+		location := node.GetLocation()
+		*ranges = append(*ranges, [2]int{location.StartOffset, location.EndOffset()})
+		if start, end, ok := g.heredocBody(node); ok {
+			*ranges = append(*ranges, [2]int{start, end})
+		}
+		return
+	}
+	for _, child := range children(node) {
+		g.literalRanges(child, ranges)
+	}
+}
+
+// place finds the smallest node enclosing the comment and the nodes before
+// and after it within that node.
+func (g *generator) place(node parser.Node, c *comment) {
+	var preceding, following parser.Node
+	for _, child := range children(node) {
+		location := child.GetLocation()
+		switch {
+		case location.StartOffset <= c.start && c.end <= location.EndOffset():
+			g.place(child, c)
+			return
+		case location.EndOffset() <= c.start:
+			preceding = child
+		case following == nil && location.StartOffset >= c.end:
+			following = child
+		}
+	}
+	switch {
+	case preceding != nil && !c.embDoc && g.lineOf(preceding.GetLocation().EndOffset()) == g.lineOf(c.start):
+		c.placement, c.anchor = trailing, preceding.GetLocation().EndOffset()
+	case following != nil && clause(node, following) && g.column(c.start) > g.column(following.GetLocation().StartOffset):
+		// A comment indented within the body before an else, rescue or
+		// similar clause ends that body.
+		c.placement, c.owner = dangling, node
+		if preceding != nil && clause(node, preceding) {
+			c.owner = preceding
+		}
+	case following == nil && preceding != nil && clause(node, preceding) &&
+		g.column(c.start) > g.column(preceding.GetLocation().StartOffset):
+		c.placement, c.owner = dangling, preceding
+	case following != nil:
+		c.placement, c.anchor = leading, following.GetLocation().StartOffset
+	default:
+		c.placement, c.owner = dangling, node
+	}
+}
+
+// clause reports whether node is a clause of parent starting with a
+// keyword, whose body is written indented.
+func clause(parent, node parser.Node) bool {
+	switch node.(type) {
+	case *parser.ElseNode, *parser.EnsureNode, *parser.RescueNode, *parser.WhenNode, *parser.InNode:
+		return true
+	case *parser.IfNode:
+		// elsif
+		_, ok := parent.(*parser.IfNode)
+		return ok
+	}
+	return false
+}
+
+// children returns the parsed children of node, ordered by position.
+func children(node parser.Node) []parser.Node {
+	var nodes []parser.Node
+	for _, child := range node.CompactChildNodes() {
+		if isNil(child) || !parsed(child) {
+			continue
+		}
+		nodes = append(nodes, child)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].GetLocation().StartOffset < nodes[j].GetLocation().StartOffset
+	})
+	return nodes
+}
+
+// isNil reports whether node is nil, including a nil pointer held by the
+// interface.
+func isNil(node parser.Node) bool {
+	return node == nil || reflect.ValueOf(node).IsNil()
+}
+
+func (g *generator) lineOf(offset int) int {
+	return sort.SearchInts(g.lineStarts, offset+1) - 1
+}
+
+func (g *generator) column(offset int) int {
+	return offset - g.lineStarts[g.lineOf(offset)]
+}
+
+func (g *generator) lineEnd(offset int) int {
+	line := g.lineOf(offset)
+	if line+1 < len(g.lineStarts) {
+		return g.lineStarts[line+1]
+	}
+	return len(g.source)
+}
+
+// blankBefore reports whether the line before the one at offset is blank.
+func (g *generator) blankBefore(offset int) bool {
+	line := g.lineOf(offset)
+	if line == 0 {
+		return false
+	}
+	return strings.TrimSpace(string(g.source[g.lineStarts[line-1]:g.lineStarts[line]])) == ""
+}
+
+// visit enters node and returns the function that leaves it.
+func (g *generator) visit(node parser.Node) func() {
+	g.enter(node)
+	return func() { g.leave(node) }
+}
+
+// enter writes the comments leading to node.
+func (g *generator) enter(node parser.Node) {
+	if g.placed == nil || isNil(node) {
+		return
+	}
+	g.stack = append(g.stack, node)
+	if parsed(node) {
+		start := node.GetLocation().StartOffset
+		g.trailingComments(start)
+		g.leadingComments(start)
+	}
+}
+
+// leave queues the comments trailing node and the ones left in it.
+func (g *generator) leave(node parser.Node) {
+	if g.placed == nil || isNil(node) {
+		return
+	}
+	g.stack = g.stack[:len(g.stack)-1]
+	if !parsed(node) {
+		return
+	}
+	end := node.GetLocation().EndOffset()
+	g.trailingComments(end)
+	if _, ok := node.(*parser.ProgramNode); ok {
+		// Comments after the last statement are written by finish.
+		return
+	}
+	for index := g.next; index < len(g.placed) && g.placed[index].start < end; index++ {
+		if c := g.placed[index]; !g.printed[index] && c.placement == dangling && c.owner == node {
+			g.queue(index)
+		}
+	}
+}
+
+// trailingComments queues the comments trailing the nodes that end by
+// offset.
+func (g *generator) trailingComments(offset int) {
+	if g.placed == nil {
+		return
+	}
+	limit := g.lineEnd(offset)
+	for index := g.next; index < len(g.placed) && g.placed[index].start < limit; index++ {
+		if c := g.placed[index]; !g.printed[index] && c.placement == trailing && c.anchor <= offset {
+			g.queue(index)
+		}
+	}
+}
+
+// leadingComments writes the comments leading to the node at start, on
+// their own lines if the node starts a line.
+func (g *generator) leadingComments(start int) {
+	for index := g.next; index < len(g.placed) && g.placed[index].start < start; index++ {
+		if c := g.placed[index]; !g.printed[index] && c.placement == leading && c.anchor <= start {
+			if g.pendingIndent {
+				g.ownLine(index)
+			} else {
+				g.queue(index)
+			}
+		}
+	}
+}
+
+// danglingComments writes the comments left at the end of the body of the
+// node being written, on their own lines.
+func (g *generator) danglingComments() {
+	if len(g.stack) == 0 {
+		return
+	}
+	owner := g.stack[len(g.stack)-1]
+	for index := g.next; index < len(g.placed); index++ {
+		if c := g.placed[index]; !g.printed[index] && c.placement == dangling && c.owner == owner {
+			if !g.pendingIndent {
+				g.newline()
+			}
+			g.ownLine(index)
+		}
+	}
+}
+
+// separate keeps a blank line before the statement or comment at offset,
+// if the source has one.
+func (g *generator) separate(offset int) {
+	if g.source != nil && !g.bodyStart && g.blankBefore(offset) {
+		g.builder.WriteByte('\n')
+	}
+	g.bodyStart = false
+}
+
+// ownLine writes a comment on its own line. Embedded documents must start
+// at the beginning of the line.
+func (g *generator) ownLine(index int) {
+	c := g.placed[index]
+	g.separate(c.start)
+	g.mark(index)
+	if c.embDoc {
+		g.pendingIndent = false
+		g.builder.WriteString(strings.TrimSuffix(c.text, "\n"))
+	} else {
+		g.write(c.text)
+	}
+	g.newline()
+}
+
+func (g *generator) queue(index int) {
+	g.mark(index)
+	g.line = append(g.line, index)
+}
+
+func (g *generator) mark(index int) {
+	g.printed[index] = true
+	g.journal = append(g.journal, index)
+	g.emitted++
+	for g.next < len(g.placed) && g.printed[g.next] {
+		g.next++
+	}
+}
+
+// lineComments writes the comments queued for the end of the line, and
+// returns the embedded documents among them, which go on the lines after.
+func (g *generator) lineComments() []string {
+	var texts, embDocs []string
+	for _, index := range g.line {
+		if c := g.placed[index]; c.embDoc {
+			embDocs = append(embDocs, c.text)
+		} else {
+			texts = append(texts, c.text)
+		}
+	}
+	g.line = nil
+	if len(texts) > 0 {
+		if !g.pendingIndent {
+			g.write(" ")
+		}
+		g.write(strings.Join(texts, " "))
+	}
+	return embDocs
+}
+
+// finish ends the output with the comments and heredoc bodies that are
+// left.
+func (g *generator) finish() {
+	if len(g.line) > 0 || len(g.heredocs) > 0 {
+		g.newline()
+	}
+	for index := range g.placed {
+		if !g.printed[index] {
+			if !g.pendingIndent {
+				g.newline()
+			}
+			g.ownLine(index)
+		}
+	}
+}
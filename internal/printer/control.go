@@ -0,0 +1,439 @@
+package printer
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// logical writes a && b or a || b, or their keyword forms and and or when
+// the position allows them and the operands need them.
+func (g *generator) logical(left, right parser.Node, precedence int, symbolic, keyword string, operatorLoc parser.Location, min int) {
+	if g.keywordForm(left, right, precedence, keyword, operatorLoc, min) {
+		g.expression(left, precAndOr)
+		g.write(" ", keyword, " ")
+		g.tail(right, precAndOr+1)
+		return
+	}
+	g.expression(left, precedence)
+	g.write(" ", symbolic, " ")
+	g.tail(right, precedence+1)
+}
+
+func (g *generator) logicalPrecedence(left, right parser.Node, precedence int, keyword string, operatorLoc parser.Location, min int) int {
+	if g.keywordForm(left, right, precedence, keyword, operatorLoc, min) {
+		return precAndOr
+	}
+	return precedence
+}
+
+// keywordForm reports whether a logical operator is written as a keyword:
+// when it was parsed so, or when an operand binds looser than the symbolic
+// form allows. and is told apart from && by its length, or from || is not.
+func (g *generator) keywordForm(left, right parser.Node, precedence int, keyword string, operatorLoc parser.Location, min int) bool {
+	if min > precAndOr {
+		return false
+	}
+	if keyword == "and" && operatorLoc.Length == len(keyword) {
+		return true
+	}
+	return g.precedence(left, precedence) < precedence || g.precedence(right, precedence+1) < precedence+1
+}
+
+type conditionalForm int
+
+const (
+	formFull conditionalForm = iota
+	formTernary
+	formModifier
+)
+
+// ifForm returns the form of an if: parsed ternaries and modifiers keep
+// their form where it fits, anything else is written in full.
+func ifForm(n *parser.IfNode, min int) conditionalForm {
+	if !parsed(n) {
+		// Built conditionals are written as ternaries within expressions.
+		if elseClause, ok := n.Subsequent.(*parser.ElseNode); ok && elseClause != nil && min > precStatement &&
+			simple(statementList(n.Statements)) && simple(statementList(elseClause.Statements)) {
+			return formTernary
+		}
+		return formFull
+	}
+	if n.IfKeywordLoc == nil {
+		if _, ok := n.Subsequent.(*parser.ElseNode); ok && len(statementList(n.Statements)) == 1 {
+			return formTernary
+		}
+		return formFull
+	}
+	if n.EndKeywordLoc == nil && n.Subsequent == nil && len(statementList(n.Statements)) == 1 && min <= precModifier {
+		return formModifier
+	}
+	return formFull
+}
+
+// simple reports whether the statements are a single expression written on
+// one line.
+func simple(statements []parser.Node) bool {
+	return len(statements) == 1 && !multiline(statements[0])
+}
+
+func (g *generator) ifNode(n *parser.IfNode, min int) {
+	switch ifForm(n, min) {
+	case formTernary:
+		elseClause := n.Subsequent.(*parser.ElseNode)
+		otherwise := statementList(elseClause.Statements)
+		if len(otherwise) != 1 {
+			g.fail("ternary without a single else statement")
+			return
+		}
+		g.expression(n.Predicate, precRange)
+		g.write(" ? ")
+		g.argumentAt(n.Statements.Body[0], false)
+		g.write(" : ")
+		g.argument(otherwise[0])
+	case formModifier:
+		g.modifier("if", n.Statements.Body[0], n.Predicate)
+	default:
+		g.write("if ")
+		g.conditional(n)
+		g.write("end")
+	}
+}
+
+// conditional writes the condition and the branches of a full if, where
+// elsif branches are nested ifs.
+func (g *generator) conditional(n *parser.IfNode) {
+	g.predicate(n.Predicate)
+	g.indented(n.Statements)
+	switch subsequent := n.Subsequent.(type) {
+	case *parser.IfNode:
+		if subsequent != nil {
+			leave := g.visit(subsequent)
+			g.write("elsif ")
+			g.conditional(subsequent)
+			leave()
+		}
+	case *parser.ElseNode:
+		if subsequent != nil {
+			g.node(subsequent, precStatement)
+		}
+	}
+}
+
+// predicate writes the condition of a full conditional or loop, which is
+// ended by a newline.
+func (g *generator) predicate(node parser.Node) {
+	saved := g.context
+	g.context = context{condition: true}
+	g.tail(node, precAndOr)
+	g.context = saved
+}
+
+// modifier writes statement followed by a modifier keyword and condition.
+func (g *generator) modifier(keyword string, statement, condition parser.Node) {
+	g.expression(statement, precModifier)
+	g.write(" ", keyword, " ")
+	if _, ok := condition.(*parser.RescueModifierNode); ok {
+		// A rescue modifier would apply to the whole statement.
+		g.expression(condition, precPrimary)
+		return
+	}
+	g.tail(condition, precAndOr)
+}
+
+func unlessModifier(n *parser.UnlessNode, min int) bool {
+	return parsed(n) && n.EndKeywordLoc == nil && n.ElseClause == nil && len(statementList(n.Statements)) == 1 && min <= precModifier
+}
+
+func (g *generator) unless(n *parser.UnlessNode, min int) {
+	if unlessModifier(n, min) {
+		g.modifier("unless", n.Statements.Body[0], n.Predicate)
+		return
+	}
+	g.write("unless ")
+	g.predicate(n.Predicate)
+	g.indented(n.Statements)
+	if n.ElseClause != nil {
+		g.node(n.ElseClause, precStatement)
+	}
+	g.write("end")
+}
+
+// loopModifier reports whether a while or until loop is written as a
+// modifier. begin ... end while runs its body before the condition, which
+// only the modifier form can express.
+func loopModifier(node parser.Node, statements *parser.StatementsNode, closing *parser.Location, beginModifier bool, min int) bool {
+	if beginModifier {
+		return true
+	}
+	return parsed(node) && closing == nil && len(statementList(statements)) == 1 && min <= precModifier
+}
+
+func (g *generator) loop(node parser.Node, keyword string, predicate parser.Node, statements *parser.StatementsNode, closing *parser.Location, beginModifier bool, min int) {
+	if loopModifier(node, statements, closing, beginModifier, min) {
+		body := statementList(statements)
+		if len(body) != 1 {
+			g.fail("%s modifier without a single statement", keyword)
+			return
+		}
+		g.modifier(keyword, body[0], predicate)
+		return
+	}
+	g.write(keyword, " ")
+	g.predicate(predicate)
+	g.indented(statements)
+	g.write("end")
+}
+
+func (g *generator) forNode(n *parser.ForNode) {
+	g.write("for ")
+	if index, ok := n.Index.(*parser.MultiTargetNode); ok && index != nil {
+		g.targets(index.Lefts, index.Rest, index.Rights)
+	} else {
+		g.target(n.Index)
+	}
+	g.write(" in ")
+	g.predicate(n.Collection)
+	g.indented(n.Statements)
+	g.write("end")
+}
+
+func (g *generator) caseNode(predicate parser.Node, conditions []parser.Node, elseClause *parser.ElseNode) {
+	saved := g.context
+	g.context = context{}
+	g.write("case")
+	if predicate != nil {
+		g.write(" ")
+		g.predicate(predicate)
+	}
+	g.newline()
+	for _, condition := range conditions {
+		g.node(condition, precStatement)
+	}
+	if elseClause != nil {
+		g.node(elseClause, precStatement)
+	}
+	g.danglingComments()
+	g.write("end")
+	g.context = saved
+}
+
+func (g *generator) when(n *parser.WhenNode) {
+	g.write("when ")
+	g.commandArguments(n.Conditions)
+	g.indented(n.Statements)
+}
+
+// in writes a pattern matching branch. A guard is parsed as a modifier
+// conditional around the pattern.
+func (g *generator) in(n *parser.InNode) {
+	g.write("in ")
+	switch pattern := n.Pattern.(type) {
+	case *parser.IfNode:
+		if body := statementList(pattern.Statements); len(body) == 1 && pattern.Subsequent == nil {
+			g.pattern(body[0], patternTop)
+			g.write(" if ")
+			g.tail(pattern.Predicate, precAndOr)
+			break
+		}
+		g.pattern(pattern, patternTop)
+	case *parser.UnlessNode:
+		if body := statementList(pattern.Statements); len(body) == 1 && pattern.ElseClause == nil {
+			g.pattern(body[0], patternTop)
+			g.write(" unless ")
+			g.tail(pattern.Predicate, precAndOr)
+			break
+		}
+		g.pattern(pattern, patternTop)
+	case *parser.RangeNode:
+		if pattern.Right == nil {
+			// then keeps an endless range from extending over the body.
+			g.rangeNode(pattern.Left, nil, pattern.IsEXCLUDE_END())
+			g.write(" then")
+			break
+		}
+		g.pattern(pattern, patternTop)
+	default:
+		g.pattern(pattern, patternTop)
+	}
+	g.indented(n.Statements)
+}
+
+func (g *generator) rescue(n *parser.RescueNode) {
+	defer g.visit(n)()
+	g.write("rescue")
+	if len(n.Exceptions) > 0 {
+		g.write(" ")
+		g.commandArguments(n.Exceptions)
+	}
+	if n.Reference != nil {
+		g.write(" => ")
+		g.target(n.Reference)
+	}
+	g.indented(n.Statements)
+	if n.Subsequent != nil {
+		g.rescue(n.Subsequent)
+	}
+}
+
+// clauses writes the rescue, else and ensure clauses of a body.
+func (g *generator) clauses(n *parser.BeginNode) {
+	if n.RescueClause != nil {
+		g.rescue(n.RescueClause)
+	}
+	if n.ElseClause != nil {
+		g.node(n.ElseClause, precStatement)
+	}
+	if n.EnsureClause != nil {
+		g.node(n.EnsureClause, precStatement)
+	}
+}
+
+func (g *generator) begin(n *parser.BeginNode) {
+	g.write("begin")
+	g.indented(n.Statements)
+	g.clauses(n)
+	g.write("end")
+}
+
+// Precedences of patterns: a capture binds looser than an alternation,
+// which binds looser than anything else.
+const (
+	patternTop = iota
+	patternCapture
+	patternAlternation
+	patternPrimary
+)
+
+func patternPrecedence(node parser.Node) int {
+	switch node.(type) {
+	case *parser.CapturePatternNode:
+		return patternCapture
+	case *parser.AlternationPatternNode:
+		return patternAlternation
+	}
+	return patternPrimary
+}
+
+// pattern writes a pattern of case ... in, =>, or in, wrapped in
+// parentheses if it binds looser than min.
+func (g *generator) pattern(node parser.Node, min int) {
+	defer g.visit(node)()
+	if patternPrecedence(node) < min {
+		g.write("(")
+		g.pattern(node, patternTop)
+		g.write(")")
+		return
+	}
+	switch n := node.(type) {
+	case *parser.CapturePatternNode:
+		g.pattern(n.Value, patternAlternation)
+		g.write(" => ")
+		g.target(n.Target)
+	case *parser.AlternationPatternNode:
+		g.pattern(n.Left, patternAlternation)
+		g.write(" | ")
+		g.pattern(n.Right, patternPrimary)
+	case *parser.ArrayPatternNode:
+		g.patternConstant(n.Constant)
+		g.write("[")
+		g.patternList(n.Requireds, n.Rest, n.Posts)
+		g.write("]")
+	case *parser.FindPatternNode:
+		g.patternConstant(n.Constant)
+		g.write("[")
+		g.patternList([]parser.Node{n.Left}, nil, append(append([]parser.Node(nil), n.Requireds...), n.Right))
+		g.write("]")
+	case *parser.HashPatternNode:
+		g.hashPattern(n)
+	case *parser.SplatNode:
+		g.write("*")
+		if n.Expression != nil {
+			g.target(n.Expression)
+		}
+	case *parser.AssocSplatNode:
+		g.write("**")
+		if n.Value != nil {
+			g.target(n.Value)
+		}
+	case *parser.NoKeywordsParameterNode:
+		g.write("**nil")
+	case *parser.PinnedVariableNode:
+		g.write("^")
+		g.node(n.Variable, precPrimary)
+	case *parser.PinnedExpressionNode:
+		g.write("^(")
+		g.tail(n.Expression, precStatement)
+		g.write(")")
+	case *parser.LocalVariableTargetNode:
+		g.target(n)
+	case *parser.ImplicitNode:
+		g.pattern(n.Value, min)
+	case *parser.RangeNode:
+		// Parentheses would be kept in the pattern, and ranges end where
+		// the pattern does.
+		g.rangeNode(n.Left, n.Right, n.IsEXCLUDE_END())
+	default:
+		g.expression(node, precRange)
+	}
+}
+
+// patternConstant writes the constant of an array or find pattern.
+func (g *generator) patternConstant(constant parser.Node) {
+	if constant != nil {
+		g.expression(constant, precPrimary)
+	}
+}
+
+func (g *generator) patternList(requireds []parser.Node, rest parser.Node, posts []parser.Node) {
+	nodes := append([]parser.Node(nil), requireds...)
+	_, implicitRest := rest.(*parser.ImplicitRestNode)
+	if rest != nil && !implicitRest {
+		nodes = append(nodes, rest)
+	}
+	nodes = append(nodes, posts...)
+	g.list(nodes, func(node parser.Node) { g.pattern(node, patternTop) })
+	if implicitRest {
+		// [a,] matches arrays of any length starting with a.
+		g.write(",")
+	}
+}
+
+func (g *generator) hashPattern(n *parser.HashPatternNode) {
+	nodes := append([]parser.Node(nil), n.Elements...)
+	if n.Rest != nil {
+		nodes = append(nodes, n.Rest)
+	}
+	item := func(node parser.Node) {
+		assoc, ok := node.(*parser.AssocNode)
+		if !ok {
+			g.pattern(node, patternTop)
+			return
+		}
+		key, ok := assoc.Key.(*parser.SymbolNode)
+		if !ok {
+			g.fail("unexpected hash pattern key %T", assoc.Key)
+			return
+		}
+		if isLabel(key.Unescaped.Value) {
+			g.write(key.Unescaped.Value, ":")
+		} else {
+			g.write(`"`, escape(key.Unescaped, '"'), `":`)
+		}
+		if _, implicit := assoc.Value.(*parser.ImplicitNode); !implicit && assoc.Value != nil {
+			g.write(" ")
+			g.pattern(assoc.Value, patternTop)
+		}
+	}
+	switch {
+	case n.Constant != nil:
+		g.expression(n.Constant, precPrimary)
+		g.write("(")
+		g.list(nodes, item)
+		g.write(")")
+	case len(nodes) == 0:
+		g.write("{}")
+	default:
+		g.write("{ ")
+		g.list(nodes, item)
+		g.write(" }")
+	}
+}
@@ -0,0 +1,129 @@
+package printer
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// snapshot is the state of the generator at the start of a group, restored
+// when the group is written again broken over several lines.
+type snapshot struct {
+	length        int
+	indent        int
+	pendingIndent bool
+	heredocs      []string
+	context       context
+	err           error
+	line          []int
+	journal       int
+	emitted       int
+	bodyStart     bool
+	stack         int
+}
+
+func (g *generator) save() snapshot {
+	return snapshot{
+		length:        g.builder.Len(),
+		indent:        g.indent,
+		pendingIndent: g.pendingIndent,
+		heredocs:      append([]string(nil), g.heredocs...),
+		context:       g.context,
+		err:           g.err,
+		line:          append([]int(nil), g.line...),
+		journal:       len(g.journal),
+		emitted:       g.emitted,
+		bodyStart:     g.bodyStart,
+		stack:         len(g.stack),
+	}
+}
+
+func (g *generator) restore(s snapshot) {
+	g.builder.Truncate(s.length)
+	g.indent = s.indent
+	g.pendingIndent = s.pendingIndent
+	g.heredocs = s.heredocs
+	g.context = s.context
+	g.err = s.err
+	g.line = s.line
+	for _, index := range g.journal[s.journal:] {
+		g.printed[index] = false
+		if index < g.next {
+			g.next = index
+		}
+	}
+	g.journal = g.journal[:s.journal]
+	g.emitted = s.emitted
+	g.bodyStart = s.bodyStart
+	g.stack = g.stack[:s.stack]
+}
+
+// group writes a construct on a single line with flat, unless its first
+// line would be longer than the line width or a comment had to be written
+// within it, in which case it is written with broken instead. Breaking a
+// line that stays too long anyway is not worth it. Groups within a flat
+// group are flat as well.
+func (g *generator) group(flat, broken func()) {
+	if g.width == 0 || g.flat {
+		flat()
+		return
+	}
+	s := g.save()
+	g.flat = true
+	flat()
+	g.flat = false
+	comments := g.emitted != s.emitted
+	if !comments && g.fits(s.length) {
+		return
+	}
+	g.restore(s)
+	broken()
+	if comments || g.fits(s.length) {
+		return
+	}
+	g.restore(s)
+	g.flat = true
+	flat()
+	g.flat = false
+}
+
+// fits reports whether the line holding the output written from start is
+// within the line width.
+func (g *generator) fits(start int) bool {
+	output := g.builder.Bytes()
+	lineStart := bytes.LastIndexByte(output[:start], '\n') + 1
+	lineEnd := len(output)
+	if end := bytes.IndexByte(output[start:], '\n'); end >= 0 {
+		lineEnd = start + end
+	}
+	return utf8.RuneCount(output[lineStart:lineEnd]) <= g.width
+}
+
+// delimited writes comma-separated items between open and close, on one
+// line if they fit, or else one per line. padding separates the items from
+// the delimiters on a single line, as in { a: 1 }.
+func (g *generator) delimited(open, close, padding string, nodes []parser.Node, item func(parser.Node)) {
+	g.group(func() {
+		g.write(open, padding)
+		g.list(nodes, item)
+		g.write(padding, close)
+	}, func() {
+		saved := g.context
+		g.write(open)
+		g.indent++
+		for index, node := range nodes {
+			g.newline()
+			g.bodyStart = index == 0
+			g.context.delimited = true
+			item(node)
+			if index < len(nodes)-1 {
+				g.write(",")
+			}
+		}
+		g.indent--
+		g.newline()
+		g.write(close)
+		g.context = saved
+	})
+}
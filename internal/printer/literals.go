@@ -0,0 +1,487 @@
+package printer
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+func integer(n *parser.IntegerNode) string {
+	sign := ""
+	magnitude := uint64(n.Value)
+	if n.Value < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+	switch {
+	case n.IsHEXADECIMAL():
+		return sign + "0x" + strconv.FormatUint(magnitude, 16)
+	case n.IsBINARY():
+		return sign + "0b" + strconv.FormatUint(magnitude, 2)
+	case n.IsOCTAL():
+		return sign + "0o" + strconv.FormatUint(magnitude, 8)
+	}
+	return sign + strconv.FormatUint(magnitude, 10)
+}
+
+func float(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "Float::INFINITY"
+	case math.IsInf(value, -1):
+		return "-Float::INFINITY"
+	case math.IsNaN(value):
+		return "Float::NAN"
+	}
+	return ruby.InspectFloat(value)
+}
+
+// rational writes the rational as a decimal literal, which is only possible
+// when the denominator divides a power of ten.
+func rational(n *parser.RationalNode) string {
+	if n.Denominator == 0 {
+		return fmt.Sprintf("Rational(%d, %d)", n.Numerator, n.Denominator)
+	}
+	numerator := big.NewInt(n.Numerator)
+	denominator := big.NewInt(n.Denominator)
+	if denominator.Sign() < 0 {
+		numerator.Neg(numerator)
+		denominator.Neg(denominator)
+	}
+	scale := big.NewInt(1)
+	for places := 0; places <= 64; places++ {
+		if new(big.Int).Mod(scale, denominator).Sign() == 0 {
+			digits := new(big.Int).Mul(numerator, new(big.Int).Div(scale, denominator)).String()
+			if places == 0 {
+				return digits + "r"
+			}
+			sign := ""
+			if strings.HasPrefix(digits, "-") {
+				sign, digits = "-", digits[1:]
+			}
+			if len(digits) <= places {
+				digits = strings.Repeat("0", places-len(digits)+1) + digits
+			}
+			return sign + digits[:len(digits)-places] + "." + digits[len(digits)-places:] + "r"
+		}
+		scale.Mul(scale, big.NewInt(10))
+	}
+	return fmt.Sprintf("Rational(%d, %d)", n.Numerator, n.Denominator)
+}
+
+func utf8Encoded(value parser.RubyString) bool {
+	return value.Encoding == "" || strings.EqualFold(value.Encoding, "utf-8")
+}
+
+// escape returns the contents of a double-quoted literal closed by
+// delimiter.
+func escape(value parser.RubyString, delimiter byte) string {
+	return ruby.EscapeString(value.Value, utf8Encoded(value), delimiter)
+}
+
+// escapeRegexp returns the contents of a regular expression literal. The
+// escapes of the source are kept in the value, except for the delimiter.
+func escapeRegexp(value string) string {
+	var builder strings.Builder
+	backslashes := 0
+	for index := 0; index < len(value); index++ {
+		c := value[index]
+		if backslashes%2 == 0 {
+			switch {
+			case c == '/':
+				builder.WriteByte('\\')
+			case c == '#' && index+1 < len(value) && strings.IndexByte("{$@", value[index+1]) >= 0:
+				builder.WriteByte('\\')
+			}
+		}
+		if c == '\\' {
+			backslashes++
+		} else {
+			backslashes = 0
+		}
+		builder.WriteByte(c)
+	}
+	return builder.String()
+}
+
+type regexpFlags interface {
+	IsIGNORE_CASE() bool
+	IsEXTENDED() bool
+	IsMULTI_LINE() bool
+	IsONCE() bool
+	IsEUC_JP() bool
+	IsASCII_8BIT() bool
+	IsWINDOWS_31J() bool
+	IsUTF_8() bool
+}
+
+func regexpOptions(flags regexpFlags) string {
+	options := ""
+	for _, option := range []struct {
+		set  bool
+		name string
+	}{
+		{flags.IsIGNORE_CASE(), "i"},
+		{flags.IsMULTI_LINE(), "m"},
+		{flags.IsEXTENDED(), "x"},
+		{flags.IsONCE(), "o"},
+		{flags.IsASCII_8BIT(), "n"},
+		{flags.IsEUC_JP(), "e"},
+		{flags.IsWINDOWS_31J(), "s"},
+		{flags.IsUTF_8(), "u"},
+	} {
+		if option.set {
+			options += option.name
+		}
+	}
+	return options
+}
+
+func symbol(value parser.RubyString) string {
+	return ruby.InspectSymbol(value.Value)
+}
+
+// isLabel reports whether a symbol can be written as a hash key label,
+// as in { name: value }.
+func isLabel(name string) bool {
+	name = strings.TrimRight(name, "?!")
+	return ruby.IsIdentifier(name)
+}
+
+func (g *generator) string(n *parser.StringNode) {
+	if !g.interpolated && n.OpeningLoc != nil && isHeredoc(*n.OpeningLoc, n.ContentLoc.StartOffset) {
+		g.heredoc([]parser.Node{n})
+		return
+	}
+	g.write(`"`, escape(n.Unescaped, '"'), `"`)
+}
+
+func (g *generator) interpolatedString(n *parser.InterpolatedStringNode) {
+	if !g.interpolated && n.OpeningLoc != nil && len(n.Parts) > 0 && isHeredoc(*n.OpeningLoc, n.Parts[0].GetLocation().StartOffset) {
+		g.heredoc(n.Parts)
+		return
+	}
+	if concatenated(n.Parts) {
+		// "a" "b" is a single string.
+		for index, part := range n.Parts {
+			if index > 0 {
+				g.write(" ")
+			}
+			g.node(part, precPrimary)
+		}
+		return
+	}
+	g.write(`"`, g.parts(n.Parts, '"'), `"`)
+}
+
+// isHeredoc reports whether a parsed string literal was written as a heredoc,
+// whose contents start on the line after its opening.
+func isHeredoc(opening parser.Location, contentStart int) bool {
+	return opening.Length > len("<<") && contentStart > opening.EndOffset()
+}
+
+func concatenated(parts []parser.Node) bool {
+	for _, part := range parts {
+		switch part := part.(type) {
+		case *parser.StringNode:
+			if part.OpeningLoc != nil {
+				return true
+			}
+		case *parser.InterpolatedStringNode:
+			return true
+		}
+	}
+	return false
+}
+
+// parts returns the contents of a literal with interpolation closed by
+// delimiter.
+func (g *generator) parts(parts []parser.Node, delimiter byte) string {
+	var builder strings.Builder
+	for index, part := range parts {
+		builder.WriteString(g.part(part, following(parts, index), delimiter))
+	}
+	return builder.String()
+}
+
+// following returns the value of the part after index when it is a string.
+func following(parts []parser.Node, index int) string {
+	if index+1 < len(parts) {
+		if next, ok := parts[index+1].(*parser.StringNode); ok {
+			return next.Unescaped.Value
+		}
+	}
+	return ""
+}
+
+// part returns the source of a part of a literal, given the value of the
+// string part that follows it.
+func (g *generator) part(part parser.Node, next string, delimiter byte) string {
+	switch part := part.(type) {
+	case *parser.StringNode:
+		var text string
+		if delimiter == '/' {
+			text = escapeRegexp(part.Unescaped.Value)
+		} else {
+			text = escape(part.Unescaped, delimiter)
+		}
+		if strings.HasSuffix(text, "#") && next != "" && strings.IndexByte("{$@", next[0]) >= 0 {
+			text = text[:len(text)-1] + `\#`
+		}
+		return text
+	case *parser.EmbeddedVariableNode:
+		name := g.render(func(sub *generator) { sub.node(part.Variable, precPrimary) })
+		if next != "" && isIdentifierChar(next[0]) {
+			return "#{" + name + "}"
+		}
+		return "#" + name
+	case *parser.EmbeddedStatementsNode:
+		return "#{" + g.interpolation(part.Statements) + "}"
+	}
+	return "#{" + g.interpolation(part) + "}"
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// interpolation returns the source of the statements of an interpolation.
+func (g *generator) interpolation(node parser.Node) string {
+	return g.render(func(sub *generator) {
+		sub.context.delimited = true
+		statements := statementList(node)
+		if len(statements) == 1 {
+			sub.tail(statements[0], precStatement)
+			return
+		}
+		sub.inlineStatements(node)
+	})
+}
+
+// heredoc writes the opening of a heredoc and queues its body for the end
+// of the line. Bodies split in lines are written as <<~ heredocs, which
+// parse into one part per line; other bodies as <<- heredocs.
+func (g *generator) heredoc(parts []parser.Node) {
+	squiggly := squigglyFits(parts)
+	prefix := ""
+	if squiggly {
+		prefix = strings.Repeat("  ", g.indent+1)
+	}
+
+	var body strings.Builder
+	lineStart := true
+	for index, part := range parts {
+		text, ok := part.(*parser.StringNode)
+		if !ok {
+			if lineStart {
+				body.WriteString(prefix)
+			}
+			body.WriteString(g.part(part, following(parts, index), 0))
+			lineStart = false
+			continue
+		}
+		for _, line := range strings.SplitAfter(text.Unescaped.Value, "\n") {
+			if line == "" {
+				continue
+			}
+			content := strings.TrimSuffix(line, "\n")
+			if lineStart && content != "" {
+				body.WriteString(prefix)
+			}
+			body.WriteString(escape(parser.RubyString{Value: content, Encoding: text.Unescaped.Encoding}, 0))
+			lineStart = content != line
+			if lineStart {
+				body.WriteByte('\n')
+			}
+		}
+	}
+	if !lineStart {
+		body.WriteByte('\n')
+	}
+
+	terminator := "HEREDOC"
+	for suffix := 1; strings.Contains("\n"+body.String(), "\n"+terminator+"\n") ||
+		strings.Contains(body.String(), " "+terminator+"\n"); suffix++ {
+		terminator = fmt.Sprintf("HEREDOC%d", suffix)
+	}
+	if squiggly {
+		g.write("<<~", terminator)
+	} else {
+		g.write("<<-", terminator)
+	}
+	g.heredocs = append(g.heredocs, body.String()+strings.Repeat("  ", g.indent)+terminator+"\n")
+}
+
+// squigglyFits reports whether a heredoc body can be written as a <<~
+// heredoc: it must be split in lines like one, and the indentation added to
+// its lines must be all that gets removed.
+func squigglyFits(parts []parser.Node) bool {
+	unindented := false
+	lineStart := true
+	for _, part := range parts {
+		text, ok := part.(*parser.StringNode)
+		if !ok {
+			unindented = unindented || lineStart
+			lineStart = false
+			continue
+		}
+		value := text.Unescaped.Value
+		if newline := strings.IndexByte(value, '\n'); newline >= 0 && newline != len(value)-1 {
+			return false
+		}
+		content := strings.TrimSuffix(value, "\n")
+		if lineStart && strings.TrimLeft(content, " \t") != "" && content[0] != ' ' && content[0] != '\t' {
+			unindented = true
+		}
+		lineStart = strings.HasSuffix(value, "\n")
+	}
+	return unindented
+}
+
+func (g *generator) array(n *parser.ArrayNode) {
+	g.delimited("[", "]", "", n.Elements, g.argument)
+}
+
+func (g *generator) hash(n *parser.HashNode) {
+	if len(n.Elements) == 0 {
+		g.write("{}")
+		return
+	}
+	g.delimited("{", "}", " ", n.Elements, g.argument)
+}
+
+func (g *generator) assoc(n *parser.AssocNode) {
+	_, implicit := n.Value.(*parser.ImplicitNode)
+	if key, ok := n.Key.(*parser.SymbolNode); ok && g.verbatim(key) && strings.HasSuffix(g.text(key.Location), ":") {
+		// A quoted label, as in "a b": 1.
+		g.write(g.text(key.Location))
+		if !implicit {
+			g.write(" ")
+			g.argument(n.Value)
+		}
+		return
+	}
+	if key, ok := n.Key.(*parser.SymbolNode); ok && isLabel(key.Unescaped.Value) {
+		g.write(key.Unescaped.Value, ":")
+		if !implicit {
+			g.write(" ")
+			g.argument(n.Value)
+		}
+		return
+	}
+	g.expression(n.Key, precTernary)
+	g.write(" => ")
+	g.argument(n.Value)
+}
+
+func (g *generator) rangeNode(left, right parser.Node, excludeEnd bool) {
+	if left != nil {
+		g.expression(left, precOrOp)
+	}
+	if excludeEnd {
+		g.write("...")
+	} else {
+		g.write("..")
+	}
+	if right != nil {
+		g.tail(right, precOrOp)
+	}
+}
+
+func (g *generator) constantPath(parent parser.Node, name *string) {
+	if parent != nil {
+		g.expression(parent, precPrimary)
+	}
+	g.write("::", optional(name))
+}
+
+// verbatim reports whether node is a parsed literal written as it appears in
+// the source.
+func (g *generator) verbatim(node parser.Node) bool {
+	if g.source == nil || isNil(node) || !parsed(node) {
+		return false
+	}
+	switch n := node.(type) {
+	case *parser.IntegerNode, *parser.FloatNode, *parser.RationalNode, *parser.ImaginaryNode,
+		*parser.StringNode, *parser.XStringNode, *parser.SymbolNode,
+		*parser.RegularExpressionNode, *parser.MatchLastLineNode:
+		return true
+	case *parser.InterpolatedStringNode, *parser.InterpolatedXStringNode, *parser.InterpolatedSymbolNode,
+		*parser.InterpolatedRegularExpressionNode, *parser.InterpolatedMatchLastLineNode:
+		// The body of a heredoc started within an interpolation is outside
+		// the literal.
+		return !g.nestedHeredoc(n)
+	case *parser.ArrayNode:
+		// %w and %i arrays.
+		return n.OpeningLoc != nil && strings.HasPrefix(g.text(*n.OpeningLoc), "%")
+	}
+	return false
+}
+
+// nestedHeredoc reports whether a heredoc starts within node.
+func (g *generator) nestedHeredoc(node parser.Node) bool {
+	for _, child := range children(node) {
+		if _, _, ok := g.heredocBody(child); ok || g.nestedHeredoc(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// literal writes a literal as it appears in the source, queueing the body
+// of a heredoc for the end of the line.
+func (g *generator) literal(node parser.Node) {
+	g.write(g.text(node.GetLocation()))
+	if start, end, ok := g.heredocBody(node); ok {
+		body := string(g.source[start:end])
+		if !strings.HasSuffix(body, "\n") {
+			body += "\n"
+		}
+		g.heredocs = append(g.heredocs, body)
+	}
+}
+
+// heredocBody returns the range of the lines holding the body and the
+// terminator of a parsed heredoc.
+func (g *generator) heredocBody(node parser.Node) (int, int, bool) {
+	var opening, closing *parser.Location
+	var content int
+	switch n := node.(type) {
+	case *parser.StringNode:
+		opening, closing, content = n.OpeningLoc, n.ClosingLoc, n.ContentLoc.StartOffset
+	case *parser.XStringNode:
+		opening, closing, content = &n.OpeningLoc, &n.ClosingLoc, n.ContentLoc.StartOffset
+	case *parser.InterpolatedStringNode:
+		opening, closing = n.OpeningLoc, n.ClosingLoc
+		content = firstPart(n.Parts, closing)
+	case *parser.InterpolatedXStringNode:
+		opening, closing = &n.OpeningLoc, &n.ClosingLoc
+		content = firstPart(n.Parts, closing)
+	default:
+		return 0, 0, false
+	}
+	if opening == nil || closing == nil || !strings.HasPrefix(g.text(*opening), "<<") {
+		return 0, 0, false
+	}
+	content = min(content, closing.StartOffset)
+	return g.lineStarts[g.lineOf(content)], closing.EndOffset(), true
+}
+
+func firstPart(parts []parser.Node, closing *parser.Location) int {
+	if len(parts) > 0 {
+		return parts[0].GetLocation().StartOffset
+	}
+	if closing != nil {
+		return closing.StartOffset
+	}
+	return 0
+}
+
+func (g *generator) text(location parser.Location) string {
+	return string(g.source[location.StartOffset:location.EndOffset()])
+}
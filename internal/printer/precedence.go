@@ -0,0 +1,160 @@
+package printer
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Precedence levels, from the loosest to the tightest binding. An expression
+// is wrapped in parentheses when it binds looser than its position allows.
+const (
+	precStatement = iota
+	precModifier
+	precAndOr
+	precNot
+	precCommand
+	precAssignment
+	precRescue
+	precTernary
+	precRange
+	precOrOp
+	precAndOp
+	precEquality
+	precComparison
+	precBitOr
+	precBitAnd
+	precShift
+	precAdditive
+	precMultiplicative
+	precUnaryMinus
+	precPower
+	precUnary
+	precPrimary
+)
+
+type operator struct {
+	precedence int
+	// left and right are the minimum precedences of the operands.
+	left, right int
+}
+
+func leftAssociative(precedence int) operator {
+	return operator{precedence, precedence, precedence + 1}
+}
+
+var binaryOperators = map[string]operator{
+	"**":  {precPower, precPower + 1, precUnaryMinus},
+	"*":   leftAssociative(precMultiplicative),
+	"/":   leftAssociative(precMultiplicative),
+	"%":   leftAssociative(precMultiplicative),
+	"+":   leftAssociative(precAdditive),
+	"-":   leftAssociative(precAdditive),
+	"<<":  leftAssociative(precShift),
+	">>":  leftAssociative(precShift),
+	"&":   leftAssociative(precBitAnd),
+	"|":   leftAssociative(precBitOr),
+	"^":   leftAssociative(precBitOr),
+	"<":   leftAssociative(precComparison),
+	"<=":  leftAssociative(precComparison),
+	">":   leftAssociative(precComparison),
+	">=":  leftAssociative(precComparison),
+	"<=>": {precEquality, precEquality + 1, precEquality + 1},
+	"==":  {precEquality, precEquality + 1, precEquality + 1},
+	"===": {precEquality, precEquality + 1, precEquality + 1},
+	"!=":  {precEquality, precEquality + 1, precEquality + 1},
+	"=~":  {precEquality, precEquality + 1, precEquality + 1},
+	"!~":  {precEquality, precEquality + 1, precEquality + 1},
+}
+
+// precedence returns how tightly node binds when written at a position
+// requiring min. Nodes with several forms, such as calls, pick the form
+// that fits the position when they can.
+func (g *generator) precedence(node parser.Node, min int) int {
+	switch n := node.(type) {
+	case *parser.CallNode:
+		return g.callPrecedence(n, min)
+	case *parser.SuperNode:
+		if g.superCommand(n, min) {
+			return precCommand
+		}
+	case *parser.YieldNode:
+		if g.yieldCommand(n, min) {
+			return precCommand
+		}
+	case *parser.AndNode:
+		return g.logicalPrecedence(n.Left, n.Right, precAndOp, "and", n.OperatorLoc, min)
+	case *parser.OrNode:
+		return g.logicalPrecedence(n.Left, n.Right, precOrOp, "or", n.OperatorLoc, min)
+	case *parser.IfNode:
+		switch ifForm(n, min) {
+		case formTernary:
+			return precTernary
+		case formModifier:
+			return precModifier
+		}
+	case *parser.UnlessNode:
+		if unlessModifier(n, min) {
+			return precModifier
+		}
+	case *parser.WhileNode:
+		if loopModifier(n, n.Statements, n.ClosingLoc, n.IsBEGIN_MODIFIER(), min) {
+			return precModifier
+		}
+	case *parser.UntilNode:
+		if loopModifier(n, n.Statements, n.ClosingLoc, n.IsBEGIN_MODIFIER(), min) {
+			return precModifier
+		}
+	case *parser.RescueModifierNode:
+		return precRescue
+	case *parser.RangeNode:
+		return g.rangePrecedence(n.Right)
+	case *parser.FlipFlopNode:
+		return g.rangePrecedence(n.Right)
+	case *parser.MatchPredicateNode, *parser.MatchRequiredNode:
+		return precNot
+	case *parser.MultiWriteNode, *parser.AliasMethodNode, *parser.AliasGlobalVariableNode, *parser.UndefNode,
+		*parser.PreExecutionNode, *parser.PostExecutionNode:
+		return precModifier
+	case *parser.BreakNode:
+		return jumpPrecedence(n.Arguments)
+	case *parser.NextNode:
+		return jumpPrecedence(n.Arguments)
+	case *parser.ReturnNode:
+		return jumpPrecedence(n.Arguments)
+	case *parser.DefNode:
+		if endless(n) {
+			return precAssignment
+		}
+	case *parser.ShareableConstantNode:
+		return precAssignment
+	case *parser.MatchWriteNode:
+		if n.Call != nil {
+			return g.callPrecedence(n.Call, min)
+		}
+	case *parser.LocalVariableWriteNode, *parser.LocalVariableAndWriteNode, *parser.LocalVariableOrWriteNode, *parser.LocalVariableOperatorWriteNode,
+		*parser.InstanceVariableWriteNode, *parser.InstanceVariableAndWriteNode, *parser.InstanceVariableOrWriteNode, *parser.InstanceVariableOperatorWriteNode,
+		*parser.ClassVariableWriteNode, *parser.ClassVariableAndWriteNode, *parser.ClassVariableOrWriteNode, *parser.ClassVariableOperatorWriteNode,
+		*parser.GlobalVariableWriteNode, *parser.GlobalVariableAndWriteNode, *parser.GlobalVariableOrWriteNode, *parser.GlobalVariableOperatorWriteNode,
+		*parser.ConstantWriteNode, *parser.ConstantAndWriteNode, *parser.ConstantOrWriteNode, *parser.ConstantOperatorWriteNode,
+		*parser.ConstantPathWriteNode, *parser.ConstantPathAndWriteNode, *parser.ConstantPathOrWriteNode, *parser.ConstantPathOperatorWriteNode,
+		*parser.CallAndWriteNode, *parser.CallOrWriteNode, *parser.CallOperatorWriteNode,
+		*parser.IndexAndWriteNode, *parser.IndexOrWriteNode, *parser.IndexOperatorWriteNode:
+		return precAssignment
+	}
+	return precPrimary
+}
+
+// rangePrecedence returns the precedence of a range. An endless range must
+// be followed by a delimiter, or it would extend over what comes next.
+func (g *generator) rangePrecedence(right parser.Node) int {
+	if right == nil && !g.context.delimited {
+		return -1
+	}
+	return precRange
+}
+
+func jumpPrecedence(arguments *parser.ArgumentsNode) int {
+	if arguments != nil && len(arguments.Arguments) > 0 {
+		return precCommand
+	}
+	return precPrimary
+}
@@ -0,0 +1,553 @@
+// Package printer generates Ruby source from a syntax tree. It is shared by
+// the unparser, which prints any tree, and the formatter, which also keeps
+// the comments and literals of the parsed source and breaks long lines.
+//
+// The output re-parses into an equivalent tree: parentheses are only added
+// where the shape of the tree requires them, and the locations of parsed
+// nodes are used as hints to keep their layout, such as heredocs, modifier
+// conditionals and brace blocks.
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Options configures Print. The zero value prints the tree alone.
+type Options struct {
+	// Source is the source the tree was parsed from. When set, literals
+	// and heredocs are written as they appear in it.
+	Source []byte
+	// Comments are the comments of the source, placed next to the nodes
+	// they belong to. They require Source.
+	Comments []parser.Comment
+	// LineWidth is the width lines are kept within by breaking argument
+	// lists, collections and blocks over several lines. Zero disables it.
+	LineWidth int
+}
+
+// Print returns the Ruby source of node.
+func Print(node parser.Node, options Options) (string, error) {
+	g := &generator{
+		source:        options.Source,
+		width:         options.LineWidth,
+		pendingIndent: true,
+		comments:      comments{bodyStart: true},
+	}
+	if options.Source != nil {
+		g.attach(node, options.Comments)
+	}
+	g.statement(node)
+	g.finish()
+	if g.err != nil {
+		return "", g.err
+	}
+	return g.builder.String(), nil
+}
+
+type generator struct {
+	builder       bytes.Buffer
+	indent        int
+	pendingIndent bool
+	// heredocs holds the bodies of the heredocs started on the current line.
+	heredocs []string
+	// interpolated is set while writing the contents of a string, where
+	// heredocs cannot start.
+	interpolated bool
+	context      context
+	err          error
+
+	source []byte
+	width  int
+	// flat is set while trying a group on a single line.
+	flat bool
+	comments
+}
+
+// context describes the position of the expression being written.
+type context struct {
+	// command is set within the arguments of a command, where a do block
+	// would bind to the command.
+	command bool
+	// condition is set within a loop condition, where do starts the body.
+	condition bool
+	// delimited is set when the expression is followed by a comma or a
+	// closing delimiter, so that it may end with an endless range.
+	delimited bool
+}
+
+func (g *generator) fail(format string, args ...any) {
+	if g.err == nil {
+		g.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (g *generator) write(texts ...string) {
+	for _, text := range texts {
+		if text == "" {
+			continue
+		}
+		if g.pendingIndent {
+			g.builder.WriteString(strings.Repeat("  ", g.indent))
+			g.pendingIndent = false
+		}
+		g.builder.WriteString(text)
+	}
+}
+
+// newline ends the current line with the comments queued for it, followed
+// by the bodies of the heredocs started on it.
+func (g *generator) newline() {
+	embDocs := g.lineComments()
+	g.builder.WriteByte('\n')
+	for _, heredoc := range g.heredocs {
+		g.builder.WriteString(heredoc)
+	}
+	g.heredocs = nil
+	for _, embDoc := range embDocs {
+		g.builder.WriteString(embDoc)
+	}
+	g.pendingIndent = true
+}
+
+// render returns the source written by write in a separate generator, used
+// for the contents of strings.
+func (g *generator) render(write func(*generator)) string {
+	sub := &generator{indent: g.indent, interpolated: true}
+	write(sub)
+	if sub.err != nil {
+		g.fail("%w", sub.err)
+	}
+	return sub.builder.String()
+}
+
+func (g *generator) statement(node parser.Node) {
+	g.expression(node, precStatement)
+}
+
+// statements writes each statement on its own line. The last one is
+// followed by a closing keyword or the end of the source, so it may end
+// with an endless range.
+func (g *generator) statements(node parser.Node) {
+	saved := g.context
+	statements := statementList(node)
+	for index, statement := range statements {
+		if index > 0 {
+			g.newline()
+		}
+		if parsed(statement) {
+			g.leadingComments(statement.GetLocation().StartOffset)
+			g.separate(statement.GetLocation().StartOffset)
+		}
+		g.context.delimited = index == len(statements)-1
+		g.tail(statement, precStatement)
+	}
+	g.context = saved
+}
+
+// inlineStatements writes the statements on a single line, separated by
+// semicolons.
+func (g *generator) inlineStatements(node parser.Node) {
+	for index, statement := range statementList(node) {
+		if index > 0 {
+			g.write("; ")
+		}
+		g.statement(statement)
+	}
+}
+
+func statementList(node parser.Node) []parser.Node {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *parser.StatementsNode:
+		if n == nil {
+			return nil
+		}
+		return n.Body
+	}
+	return []parser.Node{node}
+}
+
+// indented writes the statements one level deeper on the following lines,
+// followed by the comments left in the body, and starts a new line for the
+// closing keyword.
+func (g *generator) indented(node parser.Node) {
+	saved := g.context
+	g.context = context{}
+	g.indent++
+	g.bodyStart = true
+	if statements := statementList(node); len(statements) > 0 {
+		if parsed(statements[0]) {
+			// Comments trailing the line that starts the body.
+			g.trailingComments(statements[0].GetLocation().StartOffset)
+		}
+		g.newline()
+		g.statements(node)
+	}
+	g.danglingComments()
+	g.indent--
+	if !g.pendingIndent {
+		g.newline()
+	}
+	g.bodyStart = false
+	g.context = saved
+}
+
+// expression writes node, wrapped in parentheses if it binds looser than
+// min.
+func (g *generator) expression(node parser.Node, min int) {
+	g.expressionAt(node, min, false)
+}
+
+// tail writes an expression that ends the enclosing one, so that it is
+// followed by whatever follows the enclosing expression.
+func (g *generator) tail(node parser.Node, min int) {
+	g.expressionAt(node, min, true)
+}
+
+func (g *generator) expressionAt(node parser.Node, min int, tail bool) {
+	saved := g.context
+	if !tail {
+		g.context.delimited = false
+	}
+	if g.precedence(node, min) < min {
+		g.context = context{delimited: true}
+		g.write("(")
+		g.node(node, precStatement)
+		g.write(")")
+	} else {
+		g.node(node, min)
+	}
+	g.context = saved
+}
+
+// list writes comma-separated expressions between delimiters, such as
+// arguments in parentheses or array elements.
+func (g *generator) list(nodes []parser.Node, item func(parser.Node)) {
+	saved := g.context
+	for index, node := range nodes {
+		if index > 0 {
+			g.write(", ")
+		}
+		g.context.delimited = true
+		item(node)
+	}
+	g.context = saved
+}
+
+// argument writes an expression in an argument position, where modifiers
+// and commands are not allowed.
+func (g *generator) argument(node parser.Node) {
+	g.argumentAt(node, true)
+}
+
+func (g *generator) argumentAt(node parser.Node, tail bool) {
+	if _, ok := node.(*parser.RescueModifierNode); ok {
+		g.expression(node, precPrimary)
+		return
+	}
+	g.expressionAt(node, precAssignment, tail)
+}
+
+func (g *generator) node(node parser.Node, min int) {
+	if node == nil {
+		g.fail("unexpected nil node")
+		return
+	}
+	defer g.visit(node)()
+	if g.verbatim(node) {
+		g.literal(node)
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ProgramNode:
+		g.statements(n.Statements)
+	case *parser.StatementsNode:
+		g.statements(n)
+	case *parser.ParenthesesNode:
+		g.parentheses(n)
+	case *parser.BeginNode:
+		g.begin(n)
+	case *parser.MissingNode:
+		g.fail("cannot unparse a missing node")
+
+	case *parser.NilNode:
+		g.write("nil")
+	case *parser.TrueNode:
+		g.write("true")
+	case *parser.FalseNode:
+		g.write("false")
+	case *parser.SelfNode:
+		g.write("self")
+	case *parser.SourceFileNode:
+		g.write("__FILE__")
+	case *parser.SourceLineNode:
+		g.write("__LINE__")
+	case *parser.SourceEncodingNode:
+		g.write("__ENCODING__")
+	case *parser.RedoNode:
+		g.write("redo")
+	case *parser.RetryNode:
+		g.write("retry")
+	case *parser.IntegerNode:
+		g.write(integer(n))
+	case *parser.FloatNode:
+		g.write(float(n.Value))
+	case *parser.RationalNode:
+		g.write(rational(n))
+	case *parser.ImaginaryNode:
+		g.node(n.Numeric, precPrimary)
+		g.write("i")
+	case *parser.StringNode:
+		g.string(n)
+	case *parser.InterpolatedStringNode:
+		g.interpolatedString(n)
+	case *parser.XStringNode:
+		g.write("`", escape(n.Unescaped, '`'), "`")
+	case *parser.InterpolatedXStringNode:
+		g.write("`", g.parts(n.Parts, '`'), "`")
+	case *parser.SymbolNode:
+		g.write(symbol(n.Unescaped))
+	case *parser.InterpolatedSymbolNode:
+		g.write(`:"`, g.parts(n.Parts, '"'), `"`)
+	case *parser.RegularExpressionNode:
+		g.write("/", escapeRegexp(n.Unescaped.Value), "/", regexpOptions(n))
+	case *parser.MatchLastLineNode:
+		g.write("/", escapeRegexp(n.Unescaped.Value), "/", regexpOptions(n))
+	case *parser.InterpolatedRegularExpressionNode:
+		g.write("/", g.parts(n.Parts, '/'), "/", regexpOptions(n))
+	case *parser.InterpolatedMatchLastLineNode:
+		g.write("/", g.parts(n.Parts, '/'), "/", regexpOptions(n))
+	case *parser.EmbeddedStatementsNode, *parser.EmbeddedVariableNode:
+		g.write(`"`, g.parts([]parser.Node{n}, '"'), `"`)
+	case *parser.ArrayNode:
+		g.array(n)
+	case *parser.HashNode:
+		g.hash(n)
+	case *parser.KeywordHashNode:
+		g.list(n.Elements, g.argument)
+	case *parser.AssocNode:
+		g.assoc(n)
+	case *parser.AssocSplatNode:
+		g.prefixed("**", n.Value)
+	case *parser.SplatNode:
+		g.prefixed("*", n.Expression)
+	case *parser.BlockArgumentNode:
+		g.prefixed("&", n.Expression)
+	case *parser.ForwardingArgumentsNode:
+		g.write("...")
+	case *parser.RangeNode:
+		g.rangeNode(n.Left, n.Right, n.IsEXCLUDE_END())
+	case *parser.FlipFlopNode:
+		g.rangeNode(n.Left, n.Right, n.IsEXCLUDE_END())
+	case *parser.ImplicitNode:
+		g.node(n.Value, min)
+	case *parser.ImplicitRestNode, *parser.NumberedParametersNode, *parser.ItParametersNode:
+		// These are implied by the surrounding syntax.
+
+	case *parser.LocalVariableReadNode:
+		g.write(n.Name)
+	case *parser.ItLocalVariableReadNode:
+		g.write("it")
+	case *parser.InstanceVariableReadNode:
+		g.write(n.Name)
+	case *parser.ClassVariableReadNode:
+		g.write(n.Name)
+	case *parser.GlobalVariableReadNode:
+		g.write(n.Name)
+	case *parser.BackReferenceReadNode:
+		g.write(n.Name)
+	case *parser.NumberedReferenceReadNode:
+		g.write(fmt.Sprintf("$%d", n.Number))
+	case *parser.ConstantReadNode:
+		g.write(n.Name)
+	case *parser.ConstantPathNode:
+		g.constantPath(n.Parent, n.Name)
+
+	case *parser.LocalVariableTargetNode, *parser.InstanceVariableTargetNode, *parser.ClassVariableTargetNode,
+		*parser.GlobalVariableTargetNode, *parser.ConstantTargetNode, *parser.ConstantPathTargetNode,
+		*parser.CallTargetNode, *parser.IndexTargetNode, *parser.MultiTargetNode:
+		g.target(n)
+	case *parser.LocalVariableWriteNode, *parser.LocalVariableAndWriteNode, *parser.LocalVariableOrWriteNode, *parser.LocalVariableOperatorWriteNode,
+		*parser.InstanceVariableWriteNode, *parser.InstanceVariableAndWriteNode, *parser.InstanceVariableOrWriteNode, *parser.InstanceVariableOperatorWriteNode,
+		*parser.ClassVariableWriteNode, *parser.ClassVariableAndWriteNode, *parser.ClassVariableOrWriteNode, *parser.ClassVariableOperatorWriteNode,
+		*parser.GlobalVariableWriteNode, *parser.GlobalVariableAndWriteNode, *parser.GlobalVariableOrWriteNode, *parser.GlobalVariableOperatorWriteNode,
+		*parser.ConstantWriteNode, *parser.ConstantAndWriteNode, *parser.ConstantOrWriteNode, *parser.ConstantOperatorWriteNode,
+		*parser.ConstantPathWriteNode, *parser.ConstantPathAndWriteNode, *parser.ConstantPathOrWriteNode, *parser.ConstantPathOperatorWriteNode,
+		*parser.CallAndWriteNode, *parser.CallOrWriteNode, *parser.CallOperatorWriteNode,
+		*parser.IndexAndWriteNode, *parser.IndexOrWriteNode, *parser.IndexOperatorWriteNode:
+		g.assignment(n)
+	case *parser.MultiWriteNode:
+		g.multiWrite(n)
+	case *parser.ShareableConstantNode:
+		g.node(n.Write, min)
+
+	case *parser.CallNode:
+		g.call(n, min)
+	case *parser.SuperNode:
+		g.super(n, min)
+	case *parser.ForwardingSuperNode:
+		g.write("super")
+		if n.Block != nil {
+			g.block(n.Block, g.doAllowed(min))
+		}
+	case *parser.YieldNode:
+		g.yield(n, min)
+	case *parser.BlockNode:
+		g.block(n, false)
+	case *parser.LambdaNode:
+		g.lambda(n)
+	case *parser.BlockParametersNode:
+		g.blockParameters(n)
+	case *parser.ParametersNode:
+		g.parameters(n)
+	case *parser.RequiredParameterNode, *parser.OptionalParameterNode, *parser.RestParameterNode,
+		*parser.RequiredKeywordParameterNode, *parser.OptionalKeywordParameterNode, *parser.KeywordRestParameterNode,
+		*parser.NoKeywordsParameterNode, *parser.ForwardingParameterNode, *parser.BlockParameterNode, *parser.BlockLocalVariableNode:
+		g.parameter(n)
+	case *parser.ArgumentsNode:
+		g.list(n.Arguments, g.argument)
+
+	case *parser.DefNode:
+		g.def(n)
+	case *parser.ClassNode:
+		g.write("class ")
+		g.expression(n.ConstantPath, precPrimary)
+		if n.Superclass != nil {
+			g.write(" < ")
+			g.expression(n.Superclass, precPrimary)
+		}
+		g.bodyStatements(n.Body)
+		g.write("end")
+	case *parser.ModuleNode:
+		g.write("module ")
+		g.expression(n.ConstantPath, precPrimary)
+		g.bodyStatements(n.Body)
+		g.write("end")
+	case *parser.SingletonClassNode:
+		g.write("class << ")
+		g.expression(n.Expression, precPrimary)
+		g.bodyStatements(n.Body)
+		g.write("end")
+
+	case *parser.AndNode:
+		g.logical(n.Left, n.Right, precAndOp, "&&", "and", n.OperatorLoc, min)
+	case *parser.OrNode:
+		g.logical(n.Left, n.Right, precOrOp, "||", "or", n.OperatorLoc, min)
+	case *parser.IfNode:
+		g.ifNode(n, min)
+	case *parser.UnlessNode:
+		g.unless(n, min)
+	case *parser.WhileNode:
+		g.loop(n, "while", n.Predicate, n.Statements, n.ClosingLoc, n.IsBEGIN_MODIFIER(), min)
+	case *parser.UntilNode:
+		g.loop(n, "until", n.Predicate, n.Statements, n.ClosingLoc, n.IsBEGIN_MODIFIER(), min)
+	case *parser.ForNode:
+		g.forNode(n)
+	case *parser.CaseNode:
+		g.caseNode(n.Predicate, n.Conditions, n.ElseClause)
+	case *parser.CaseMatchNode:
+		g.caseNode(n.Predicate, n.Conditions, n.ElseClause)
+	case *parser.WhenNode:
+		g.when(n)
+	case *parser.InNode:
+		g.in(n)
+	case *parser.ElseNode:
+		g.write("else")
+		g.indented(n.Statements)
+	case *parser.EnsureNode:
+		g.write("ensure")
+		g.indented(n.Statements)
+	case *parser.RescueNode:
+		g.rescue(n)
+	case *parser.RescueModifierNode:
+		g.expression(n.Expression, precRescue)
+		g.write(" rescue ")
+		g.tail(n.RescueExpression, precRescue+1)
+	case *parser.BreakNode:
+		g.jump("break", n.Arguments)
+	case *parser.NextNode:
+		g.jump("next", n.Arguments)
+	case *parser.ReturnNode:
+		g.jump("return", n.Arguments)
+	case *parser.DefinedNode:
+		g.write("defined?(")
+		g.list([]parser.Node{n.Value}, func(value parser.Node) { g.tail(value, precStatement) })
+		g.write(")")
+	case *parser.AliasMethodNode:
+		g.write("alias ")
+		g.expression(n.NewName, precPrimary)
+		g.write(" ")
+		g.expression(n.OldName, precPrimary)
+	case *parser.AliasGlobalVariableNode:
+		g.write("alias ")
+		g.expression(n.NewName, precPrimary)
+		g.write(" ")
+		g.expression(n.OldName, precPrimary)
+	case *parser.UndefNode:
+		g.write("undef ")
+		g.list(n.Names, func(name parser.Node) { g.expression(name, precPrimary) })
+	case *parser.PreExecutionNode:
+		g.write("BEGIN {")
+		g.indented(n.Statements)
+		g.write("}")
+	case *parser.PostExecutionNode:
+		g.write("END {")
+		g.indented(n.Statements)
+		g.write("}")
+	case *parser.MatchPredicateNode:
+		g.argumentAt(n.Value, false)
+		g.write(" in ")
+		g.pattern(n.Pattern, patternTop)
+	case *parser.MatchRequiredNode:
+		g.argumentAt(n.Value, false)
+		g.write(" => ")
+		g.pattern(n.Pattern, patternTop)
+	case *parser.MatchWriteNode:
+		if n.Call != nil {
+			g.call(n.Call, min)
+		}
+
+	case *parser.ArrayPatternNode, *parser.FindPatternNode, *parser.HashPatternNode, *parser.AlternationPatternNode,
+		*parser.CapturePatternNode, *parser.PinnedVariableNode, *parser.PinnedExpressionNode:
+		g.pattern(n, patternTop)
+
+	default:
+		g.fail("cannot unparse %T", node)
+	}
+}
+
+// prefixed writes an operator such as * or &, followed by the expression
+// if there is one.
+func (g *generator) prefixed(operator string, node parser.Node) {
+	g.write(operator)
+	if node != nil {
+		g.expression(node, precPrimary)
+	}
+}
+
+func (g *generator) parentheses(n *parser.ParenthesesNode) {
+	saved := g.context
+	g.context = context{delimited: true}
+	g.write("(")
+	if n.Body != nil {
+		statements := statementList(n.Body)
+		if len(statements) == 1 {
+			g.tail(statements[0], precStatement)
+		} else {
+			g.inlineStatements(n.Body)
+		}
+	}
+	g.write(")")
+	g.context = saved
+}
+
+// parsed reports whether the node comes from parsed source, so that its
+// locations can be used as layout hints.
+func parsed(node parser.Node) bool {
+	return node.GetLocation().Length > 0
+}
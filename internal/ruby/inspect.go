@@ -0,0 +1,173 @@
+// Package ruby formats values the way Ruby's inspect methods do. It is shared
+// by the packages that print Ruby literals.
+package ruby
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// InspectString quotes value the way Ruby's String#inspect does. Characters
+// are decoded as UTF-8 when utf8Encoded is true and as bytes otherwise.
+func InspectString(value string, utf8Encoded bool) string {
+	return `"` + EscapeString(value, utf8Encoded, '"') + `"`
+}
+
+// EscapeString escapes value for the inside of a double-quoted literal with
+// the given closing delimiter, the way String#inspect does for '"'. A zero
+// delimiter escapes none, as in heredocs.
+func EscapeString(value string, utf8Encoded bool, delimiter byte) string {
+	var builder strings.Builder
+	for index := 0; index < len(value); {
+		c := value[index]
+		r, size := rune(c), 1
+		if utf8Encoded && c >= utf8.RuneSelf {
+			r, size = utf8.DecodeRuneInString(value[index:])
+		}
+		switch {
+		case r == '\\' || (delimiter != 0 && r == rune(delimiter)):
+			builder.WriteByte('\\')
+			builder.WriteByte(c)
+		case r == '#' && index+1 < len(value) && strings.IndexByte("{$@", value[index+1]) >= 0:
+			builder.WriteString("\\#")
+		case r == '\n':
+			builder.WriteString("\\n")
+		case r == '\r':
+			builder.WriteString("\\r")
+		case r == '\t':
+			builder.WriteString("\\t")
+		case r == '\f':
+			builder.WriteString("\\f")
+		case r == '\v':
+			builder.WriteString("\\v")
+		case r == '\b':
+			builder.WriteString("\\b")
+		case r == '\a':
+			builder.WriteString("\\a")
+		case r == 0x1b:
+			builder.WriteString("\\e")
+		case r >= 0x20 && r < 0x7f:
+			builder.WriteByte(c)
+		case utf8Encoded && r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&builder, "\\x%02X", c)
+		case utf8Encoded && r >= utf8.RuneSelf && (unicode.IsPrint(r) || unicode.Is(unicode.Zs, r)):
+			builder.WriteString(value[index : index+size])
+		case utf8Encoded && r < 0x10000:
+			fmt.Fprintf(&builder, "\\u%04X", r)
+		case utf8Encoded:
+			fmt.Fprintf(&builder, "\\u{%X}", r)
+		default:
+			fmt.Fprintf(&builder, "\\x%02X", c)
+		}
+		index += size
+	}
+	return builder.String()
+}
+
+var operatorSymbols = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "**": true,
+	"==": true, "===": true, "!=": true, "!~": true, "=~": true,
+	"<": true, "<=": true, ">": true, ">=": true, "<=>": true,
+	"<<": true, ">>": true, "!": true, "~": true, "+@": true, "-@": true,
+	"[]": true, "[]=": true, "`": true, "&": true, "|": true, "^": true,
+}
+
+// InspectSymbol formats name the way Ruby's Symbol#inspect does, quoting it
+// when it is not a valid bare symbol.
+func InspectSymbol(name string) string {
+	if IsBareSymbol(name) {
+		return ":" + name
+	}
+	return ":" + InspectString(name, true)
+}
+
+// IsBareSymbol reports whether name can be written as a symbol without quotes.
+func IsBareSymbol(name string) bool {
+	if operatorSymbols[name] {
+		return true
+	}
+	switch {
+	case strings.HasPrefix(name, "@@"):
+		return IsIdentifier(name[2:])
+	case strings.HasPrefix(name, "@"):
+		return IsIdentifier(name[1:])
+	case strings.HasPrefix(name, "$"):
+		rest := name[1:]
+		if len(rest) == 1 && strings.Contains("~*$?!@/\\;,.=:<>\"&'`+0", rest) {
+			return true
+		}
+		if len(rest) == 2 && rest[0] == '-' && isIdentifierChar(rune(rest[1])) {
+			return true
+		}
+		if rest != "" && strings.Trim(rest, "0123456789") == "" && rest[0] != '0' {
+			return true
+		}
+		return IsIdentifier(rest)
+	}
+	switch name[len(name)-1:] {
+	case "?", "!", "=":
+		name = name[:len(name)-1]
+	}
+	return IsIdentifier(name)
+}
+
+// IsIdentifier reports whether name is a valid local or method identifier.
+func IsIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for index, r := range name {
+		if !isIdentifierChar(r) || (index == 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdentifierChar(r rune) bool {
+	return r == '_' || r >= utf8.RuneSelf || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// InspectFloat formats value the way Ruby's Float#inspect does.
+func InspectFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+
+	// Shortest representation as "d.ddde±XX", then lay it out like Ruby.
+	formatted := strconv.FormatFloat(value, 'e', -1, 64)
+	sign := ""
+	if formatted[0] == '-' {
+		sign = "-"
+		formatted = formatted[1:]
+	}
+	mantissa, exponentText, _ := strings.Cut(formatted, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	exponent, _ := strconv.Atoi(exponentText)
+	decpt := exponent + 1
+
+	switch {
+	case decpt > 0 && decpt <= 16:
+		if len(digits) <= decpt {
+			return sign + digits + strings.Repeat("0", decpt-len(digits)) + ".0"
+		}
+		return sign + digits[:decpt] + "." + digits[decpt:]
+	case decpt <= 0 && decpt > -4:
+		return sign + "0." + strings.Repeat("0", -decpt) + digits
+	default:
+		fraction := digits[1:]
+		if fraction == "" {
+			fraction = "0"
+		}
+		return fmt.Sprintf("%s%s.%se%+03d", sign, digits[:1], fraction, decpt-1)
+	}
+}
@@ -0,0 +1,92 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Config is the configuration of the rules, read from a JSON file such as:
+//
+//	{
+//	  "disabled_by_default": false,
+//	  "rules": {
+//	    "Style/DoubleNegation": {"enabled": false},
+//	    "Lint/Debugger": {"severity": "error"}
+//	  }
+//	}
+//
+// The zero config enables every rule with its own severity.
+type Config struct {
+	// DisabledByDefault disables the rules the config does not enable.
+	DisabledByDefault bool `json:"disabled_by_default"`
+	// Rules configures rules by name.
+	Rules map[string]RuleConfig `json:"rules"`
+}
+
+// RuleConfig is the configuration of a rule. Fields left out keep their
+// defaults.
+type RuleConfig struct {
+	Enabled  *bool     `json:"enabled,omitempty"`
+	Severity *Severity `json:"severity,omitempty"`
+}
+
+// LoadConfig reads a config file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// ParseConfig parses a config. Unknown fields are errors, so that a
+// misspelled setting does not go unnoticed.
+func ParseConfig(data []byte) (*Config, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	config := &Config{}
+	if err := decoder.Decode(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate returns an error naming the rules configured that are not in
+// the registry.
+func (c *Config) Validate(registry *Registry) error {
+	var unknown []string
+	for name := range c.Rules {
+		if _, ok := registry.Rule(name); !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown rules: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Enabled reports whether a rule is enabled.
+func (c *Config) Enabled(rule Rule) bool {
+	if settings, ok := c.Rules[rule.Name()]; ok && settings.Enabled != nil {
+		return *settings.Enabled
+	}
+	return !c.DisabledByDefault
+}
+
+// Severity returns the severity of the offenses of a rule.
+func (c *Config) Severity(rule Rule) Severity {
+	if settings, ok := c.Rules[rule.Name()]; ok && settings.Severity != nil {
+		return *settings.Severity
+	}
+	return rule.Severity()
+}
@@ -0,0 +1,123 @@
+package lint
+
+import (
+	"bytes"
+	"math"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// all is the name that stands for every rule in disable comments.
+const all = "all"
+
+// span is a range of lines, inclusive.
+type span struct {
+	first, last int
+}
+
+// directives are the lines on which the disable comments of a file
+// disable each rule.
+type directives map[string][]span
+
+// parseDirectives reads the lint:disable and lint:enable comments of a
+// parse result. A comment can name several rules, separated by commas,
+// and can give a reason after --:
+//
+//	# lint:disable Lint/Debugger -- the console needs it
+//
+// An enable comment naming all enables every rule disabled before it.
+func parseDirectives(result *parser.ParseResult) directives {
+	d := directives{}
+	open := map[string]int{}
+	for _, comment := range result.Comments {
+		text := result.Source.Slice(comment.Location)
+		action, names, ok := directive(text)
+		if !ok {
+			continue
+		}
+		line, _ := result.Source.Line(comment.Location.StartOffset)
+		trailing := !onlySpace(result.Source, comment.Location.StartOffset)
+		for _, name := range names {
+			switch {
+			case action == "disable" && trailing:
+				d[name] = append(d[name], span{line, line})
+			case action == "disable":
+				if _, ok := open[name]; !ok {
+					open[name] = line
+				}
+			case action == "enable" && name == all:
+				for name, first := range open {
+					d[name] = append(d[name], span{first, line})
+					delete(open, name)
+				}
+			case action == "enable":
+				if first, ok := open[name]; ok {
+					d[name] = append(d[name], span{first, line})
+					delete(open, name)
+				}
+			}
+		}
+	}
+	for name, first := range open {
+		d[name] = append(d[name], span{first, math.MaxInt})
+	}
+	return d
+}
+
+// disabled reports whether a rule is disabled on a line.
+func (d directives) disabled(rule string, line int) bool {
+	for _, name := range []string{rule, all} {
+		for _, s := range d[name] {
+			if s.first <= line && line <= s.last {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// directive returns the action and rule names of a disable or enable
+// comment, and whether the comment is one.
+func directive(text []byte) (string, []string, bool) {
+	rest, ok := bytes.CutPrefix(text, []byte("#"))
+	if !ok {
+		return "", nil, false
+	}
+	rest, ok = bytes.CutPrefix(bytes.TrimSpace(rest), []byte("lint:"))
+	if !ok {
+		return "", nil, false
+	}
+	action, arguments, _ := strings.Cut(string(rest), " ")
+	if action != "disable" && action != "enable" {
+		return "", nil, false
+	}
+	arguments, _, _ = strings.Cut(arguments, "--")
+	var names []string
+	for _, name := range strings.Split(arguments, ",") {
+		if name = strings.TrimSpace(name); validName(name) {
+			names = append(names, name)
+		}
+	}
+	return action, names, len(names) > 0
+}
+
+// validName reports whether a rule name can be written in a disable
+// comment.
+func validName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, ", \t\r\n") && !strings.Contains(name, "--")
+}
+
+// onlySpace reports whether the line of offset has only spaces before it.
+func onlySpace(source *parser.Source, offset int) bool {
+	for index := offset - 1; index >= 0; index-- {
+		switch source.Bytes[index] {
+		case '\n':
+			return true
+		case ' ', '\t', '\r':
+		default:
+			return false
+		}
+	}
+	return true
+}
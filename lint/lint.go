@@ -0,0 +1,153 @@
+// Package lint runs lint rules written in Go over Ruby files, in the manner
+// of RuboCop cops. A rule subscribes to node types and is called with each
+// node of those types; it reports offenses at source locations, and may
+// give each offense a fix that corrects it through a rewriter:
+//
+//	type DoubleNegation struct{}
+//
+//	func (DoubleNegation) Name() string                { return "Style/DoubleNegation" }
+//	func (DoubleNegation) Severity() lint.Severity     { return lint.Convention }
+//	func (DoubleNegation) Types() []parser.NodeType    { return []parser.NodeType{parser.NodeTypeCallNode} }
+//	func (DoubleNegation) Check(c *lint.Context, node parser.Node) {
+//		call := node.(*parser.CallNode)
+//		if inner, ok := call.Receiver.(*parser.CallNode); ok && call.Name == "!" && inner.Name == "!" {
+//			c.Report(call.MessageLoc, "Avoid the use of double negation (!!).")
+//		}
+//	}
+//
+// Rules are registered in a Registry, enabled or disabled by a Config, and
+// run by a Runner, which also applies fixes. Offenses can be disabled in
+// the source with comments:
+//
+//	# lint:disable Style/DoubleNegation, Lint/Debugger
+//	...
+//	# lint:enable Style/DoubleNegation, Lint/Debugger
+//
+//	binding.pry # lint:disable Lint/Debugger
+//
+// A comment on a line of its own disables the rules until a matching
+// enable comment or the end of the file; a comment after code disables
+// them on its line only. The name all stands for every rule.
+package lint
+
+import (
+	"fmt"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/rewriter"
+)
+
+// Severity is the severity of an offense.
+type Severity int
+
+const (
+	// Info is an offense reported for information only.
+	Info Severity = iota
+	// Convention is a departure from a style convention.
+	Convention
+	// Warning is code that is likely to be wrong.
+	Warning
+	// Error is code that is wrong.
+	Error
+)
+
+var severityNames = enum.Names[Severity]{
+	Info:       "info",
+	Convention: "convention",
+	Warning:    "warning",
+	Error:      "error",
+}
+
+func (s Severity) String() string {
+	return severityNames.String(s)
+}
+
+// MarshalText encodes the severity as its name, such as warning.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity from its name.
+func (s *Severity) UnmarshalText(text []byte) error {
+	severity, ok := severityNames.Parse(string(text))
+	if !ok {
+		return fmt.Errorf("unknown severity %q", text)
+	}
+	*s = severity
+	return nil
+}
+
+// Rule is a lint rule.
+type Rule interface {
+	// Name is the name the rule is registered, configured and disabled
+	// with, such as Style/DoubleNegation.
+	Name() string
+	// Severity is the severity of the offenses of the rule, unless the
+	// config sets another.
+	Severity() Severity
+	// Types are the types of the nodes the rule checks.
+	Types() []parser.NodeType
+	// Check checks a node of one of the types, reporting its offenses
+	// through c.
+	Check(c *Context, node parser.Node)
+}
+
+// Fix corrects an offense by editing the source through r. A fix whose
+// edits conflict with those of another is not applied.
+type Fix func(r *rewriter.Rewriter) error
+
+// Offense is a problem reported by a rule.
+type Offense struct {
+	Rule     string          `json:"rule"`
+	Severity Severity        `json:"severity"`
+	Message  string          `json:"message"`
+	Location parser.Location `json:"location"`
+	// Line and Column are where the offense starts, from 1. The column is
+	// counted in bytes.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	// Correctable reports whether the offense has a fix, and Corrected
+	// whether the fix was applied. The location of a corrected offense is
+	// in the source as it was before the fix.
+	Correctable bool `json:"correctable"`
+	Corrected   bool `json:"corrected"`
+
+	fix Fix
+}
+
+// Context is what a rule is given to check a file with.
+type Context struct {
+	// File is the path of the file, which may be empty.
+	File   string
+	Result *parser.ParseResult
+	// Source is the source of the file.
+	Source []byte
+
+	rule     Rule
+	severity Severity
+	offenses []*Offense
+}
+
+// Report reports an offense at the location of target.
+func (c *Context) Report(target rewriter.Ranged, message string) {
+	c.ReportFix(target, message, nil)
+}
+
+// ReportFix reports an offense at the location of target that fix
+// corrects.
+func (c *Context) ReportFix(target rewriter.Ranged, message string, fix Fix) {
+	location := target.GetLocation()
+	line, _ := c.Result.Source.Line(location.StartOffset)
+	column, _ := c.Result.Source.Column(location.StartOffset)
+	c.offenses = append(c.offenses, &Offense{
+		Rule:        c.rule.Name(),
+		Severity:    c.severity,
+		Message:     message,
+		Location:    location,
+		Line:        line,
+		Column:      column + 1,
+		Correctable: fix != nil,
+		fix:         fix,
+	})
+}
@@ -0,0 +1,336 @@
+package lint_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/lint"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/rewriter"
+)
+
+// debugger reports binding.pry, and removes it.
+type debugger struct{}
+
+func (debugger) Name() string             { return "Lint/Debugger" }
+func (debugger) Severity() lint.Severity  { return lint.Warning }
+func (debugger) Types() []parser.NodeType { return []parser.NodeType{parser.NodeTypeCallNode} }
+func (debugger) Check(c *lint.Context, node parser.Node) {
+	call := node.(*parser.CallNode)
+	if receiver, ok := call.Receiver.(*parser.CallNode); ok && receiver.Name == "binding" && call.Name == "pry" {
+		c.ReportFix(call, "Remove debugger entry point binding.pry.", func(r *rewriter.Rewriter) error {
+			return r.Remove(call)
+		})
+	}
+}
+
+// doubleNegation reports !!, without a fix.
+type doubleNegation struct{}
+
+func (doubleNegation) Name() string             { return "Style/DoubleNegation" }
+func (doubleNegation) Severity() lint.Severity  { return lint.Convention }
+func (doubleNegation) Types() []parser.NodeType { return []parser.NodeType{parser.NodeTypeCallNode} }
+func (doubleNegation) Check(c *lint.Context, node parser.Node) {
+	call := node.(*parser.CallNode)
+	if inner, ok := call.Receiver.(*parser.CallNode); ok && call.Name == "!" && inner.Name == "!" {
+		c.Report(call.MessageLoc, "Avoid the use of double negation (!!).")
+	}
+}
+
+// replace replaces the integers from with to.
+type replace struct {
+	name     string
+	from, to string
+}
+
+func (r replace) Name() string             { return r.name }
+func (r replace) Severity() lint.Severity  { return lint.Info }
+func (r replace) Types() []parser.NodeType { return []parser.NodeType{parser.NodeTypeIntegerNode} }
+func (r replace) Check(c *lint.Context, node parser.Node) {
+	if string(c.Result.Source.Slice(node.GetLocation())) == r.from {
+		c.ReportFix(node, "Replace "+r.from+".", func(w *rewriter.Rewriter) error {
+			return w.Replace(node, r.to)
+		})
+	}
+}
+
+type named string
+
+func (n named) Name() string                   { return string(n) }
+func (named) Severity() lint.Severity          { return lint.Info }
+func (named) Types() []parser.NodeType         { return nil }
+func (named) Check(*lint.Context, parser.Node) {}
+
+func runner(t *testing.T, options []lint.Option, rules ...lint.Rule) *lint.Runner {
+	t.Helper()
+	registry, err := lint.NewRegistry(rules...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lint.NewRunner(registry, options...)
+}
+
+// describe renders offenses as rule@line:column, severity.
+func describe(offenses []*lint.Offense) string {
+	var lines []string
+	for _, offense := range offenses {
+		line := fmt.Sprintf("%s@%d:%d %s", offense.Rule, offense.Line, offense.Column, offense.Severity)
+		if offense.Corrected {
+			line += " corrected"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestLint(t *testing.T) {
+	r := runner(t, nil, debugger{}, doubleNegation{})
+	offenses, err := r.Lint(context.Background(), "a.rb", []byte("def f\n  binding.pry\n  !!a\nend"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Lint/Debugger@2:3 warning\nStyle/DoubleNegation@3:3 convention"
+	if got := describe(offenses); got != want {
+		t.Errorf("offenses =\n%s\nwant\n%s", got, want)
+	}
+	if !offenses[0].Correctable || offenses[1].Correctable {
+		t.Errorf("Correctable = %v, %v, want true, false", offenses[0].Correctable, offenses[1].Correctable)
+	}
+}
+
+func TestDirectives(t *testing.T) {
+	tests := []struct {
+		source string
+		want   []int
+	}{
+		{"binding.pry", []int{1}},
+		{"binding.pry # lint:disable Lint/Debugger", nil},
+		{"binding.pry # lint:disable all", nil},
+		{"binding.pry # lint:disable Style/DoubleNegation", []int{1}},
+		{"binding.pry # lint:disable Lint/Debugger -- the console needs it", nil},
+		{"binding.pry # lint:disable", []int{1}},
+		{"binding.pry # lint:ignore Lint/Debugger", []int{1}},
+		{"binding.pry\n# lint:disable Lint/Debugger\nbinding.pry", []int{1}},
+		{"# lint:disable Lint/Debugger\nbinding.pry\n# lint:enable Lint/Debugger\nbinding.pry", []int{4}},
+		{"# lint:disable Style/DoubleNegation, Lint/Debugger\nbinding.pry\n# lint:enable Style/DoubleNegation\nbinding.pry", nil},
+		{"# lint:disable all\nbinding.pry\n# lint:enable all\nbinding.pry", []int{4}},
+		{"# lint:disable Lint/Debugger\nbinding.pry\n# lint:enable all\nbinding.pry", []int{4}},
+		{"# lint:disable Lint/Debugger, Style/DoubleNegation\nbinding.pry\n# lint:enable all\nbinding.pry # lint:disable Style/DoubleNegation\nbinding.pry", []int{4, 5}},
+		{"if a\n  # lint:disable Lint/Debugger\n  binding.pry\nend\nbinding.pry", nil},
+	}
+	r := runner(t, nil, debugger{})
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			offenses, err := r.Lint(context.Background(), "a.rb", []byte(test.source))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var lines []int
+			for _, offense := range offenses {
+				lines = append(lines, offense.Line)
+			}
+			if fmt.Sprint(lines) != fmt.Sprint(test.want) {
+				t.Errorf("offenses on lines %v, want %v", lines, test.want)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"Lint/Debugger", true},
+		{"", false},
+		{"all", false},
+		{"Lint/A B", false},
+		{"Lint/A,B", false},
+		{"Lint/A--B", false},
+		{"Lint/Debugger", false},
+	}
+	registry, err := lint.NewRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		if err := registry.Register(named(test.name)); (err == nil) != test.ok {
+			t.Errorf("Register(%q) = %v, want ok %v", test.name, err, test.ok)
+		}
+	}
+	if _, err := lint.NewRegistry(named("A"), named("A")); err == nil {
+		t.Error("NewRegistry() with a rule twice succeeded")
+	}
+	registry, err = lint.NewRegistry(named("Style/B"), named("Lint/C"), named("Lint/A"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, rule := range registry.Rules() {
+		names = append(names, rule.Name())
+	}
+	if got := strings.Join(names, " "); got != "Lint/A Lint/C Style/B" {
+		t.Errorf("Rules() = %s, want Lint/A Lint/C Style/B", got)
+	}
+	if _, ok := registry.Rule("Lint/C"); !ok {
+		t.Error("Rule(Lint/C) not found")
+	}
+}
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{`{}`, "Lint/Debugger@1:1 warning\nStyle/DoubleNegation@2:1 convention"},
+		{`{"rules": {"Lint/Debugger": {"severity": "error"}}}`, "Lint/Debugger@1:1 error\nStyle/DoubleNegation@2:1 convention"},
+		{`{"rules": {"Style/DoubleNegation": {"enabled": false}}}`, "Lint/Debugger@1:1 warning"},
+		{`{"disabled_by_default": true, "rules": {"Style/DoubleNegation": {"enabled": true}}}`, "Style/DoubleNegation@2:1 convention"},
+		{`{"disabled_by_default": true, "rules": {"Lint/Debugger": {"severity": "info"}}}`, ""},
+	}
+	r := runner(t, []lint.Option{lint.WithConfig(nil)}, debugger{}, doubleNegation{})
+	offenses, err := r.Lint(context.Background(), "a.rb", []byte("binding.pry\n!!a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := describe(offenses), tests[0].want; got != want {
+		t.Errorf("with a nil config, offenses =\n%s\nwant\n%s", got, want)
+	}
+	for _, test := range tests {
+		t.Run(test.config, func(t *testing.T) {
+			config, err := lint.ParseConfig([]byte(test.config))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := runner(t, []lint.Option{lint.WithConfig(config)}, debugger{}, doubleNegation{})
+			offenses, err := r.Lint(context.Background(), "a.rb", []byte("binding.pry\n!!a"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := describe(offenses); got != test.want {
+				t.Errorf("offenses =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{`{"rule": {}}`, `unknown field "rule"`},
+		{`{"rules": {"Lint/Debugger": {"severity": "fatal"}}}`, `unknown severity "fatal"`},
+		{`{"rules": {"Lint/Debugger": {"enable": false}}}`, `unknown field "enable"`},
+	}
+	for _, test := range tests {
+		t.Run(test.config, func(t *testing.T) {
+			_, err := lint.ParseConfig([]byte(test.config))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("ParseConfig() = %v, want an error containing %s", err, test.want)
+			}
+		})
+	}
+	registry, err := lint.NewRegistry(debugger{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := lint.ParseConfig([]byte(`{"rules": {"Lint/Debugger": {}, "Style/B": {}, "Lint/A": {}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.Validate(registry); err == nil || err.Error() != "unknown rules: Lint/A, Style/B" {
+		t.Errorf("Validate() = %v, want unknown rules: Lint/A, Style/B", err)
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	data, err := json.Marshal([]lint.Severity{lint.Info, lint.Convention, lint.Warning, lint.Error})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["info","convention","warning","error"]`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	var severities []lint.Severity
+	if err := json.Unmarshal(data, &severities); err != nil || len(severities) != 4 || severities[3] != lint.Error {
+		t.Errorf("Unmarshal() = %v, %v", severities, err)
+	}
+	if got := lint.Severity(9).String(); got != "lint.Severity(9)" {
+		t.Errorf("String() = %s, want lint.Severity(9)", got)
+	}
+}
+
+func TestFix(t *testing.T) {
+	tests := []struct {
+		source   string
+		rules    []lint.Rule
+		want     string
+		offenses string
+	}{
+		{
+			"binding.pry\n!!a\nbinding.pry",
+			[]lint.Rule{debugger{}, doubleNegation{}},
+			"\n!!a\n",
+			"Lint/Debugger@1:1 warning corrected\nLint/Debugger@3:1 warning corrected\nStyle/DoubleNegation@2:1 convention",
+		},
+		{
+			"binding.pry # lint:disable Lint/Debugger\nbinding.pry",
+			[]lint.Rule{debugger{}},
+			"binding.pry # lint:disable Lint/Debugger\n",
+			"Lint/Debugger@2:1 warning corrected",
+		},
+		{
+			"p 1",
+			[]lint.Rule{replace{"A/One", "1", "2"}, replace{"A/Two", "2", "3"}},
+			"p 3",
+			"A/One@1:3 info corrected\nA/Two@1:3 info corrected",
+		},
+		{
+			"p 1\nbinding.pry(",
+			[]lint.Rule{replace{"A/One", "1", "2"}},
+			"p 1\nbinding.pry(",
+			"A/One@1:3 info",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			fixed, offenses, err := runner(t, nil, test.rules...).Fix(context.Background(), "a.rb", []byte(test.source))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(fixed) != test.want {
+				t.Errorf("Fix() = %q, want %q", fixed, test.want)
+			}
+			if got := describe(offenses); got != test.offenses {
+				t.Errorf("offenses =\n%s\nwant\n%s", got, test.offenses)
+			}
+		})
+	}
+}
+
+func TestFixErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []lint.Rule
+		want  string
+	}{
+		{"undo", []lint.Rule{replace{"A/One", "1", "2"}, replace{"A/Two", "2", "1"}}, "a.rb: fixes by A/Two undo each other"},
+		{"syntax error", []lint.Rule{replace{"A/One", "1", "("}}, "a.rb: fixes by A/One produce a syntax error"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := runner(t, nil, test.rules...).Fix(context.Background(), "a.rb", []byte("p 1"))
+			if err == nil || !strings.HasPrefix(err.Error(), test.want) {
+				t.Errorf("Fix() = %v, want %s", err, test.want)
+			}
+		})
+	}
+	r := runner(t, []lint.Option{lint.WithMaxIterations(2)}, replace{"A/One", "1", "2"}, replace{"A/Two", "2", "3"}, replace{"A/Three", "3", "4"})
+	if _, _, err := r.Fix(context.Background(), "a.rb", []byte("p 1")); err == nil || err.Error() != "a.rb: fixes did not settle after 2 iterations" {
+		t.Errorf("Fix() = %v, want a.rb: fixes did not settle after 2 iterations", err)
+	}
+}
@@ -0,0 +1,53 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Registry is a set of rules by name.
+type Registry struct {
+	rules map[string]Rule
+}
+
+// NewRegistry returns a registry of the given rules.
+func NewRegistry(rules ...Rule) (*Registry, error) {
+	r := &Registry{rules: map[string]Rule{}}
+	for _, rule := range rules {
+		if err := r.Register(rule); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds a rule. It fails if a rule with the same name is
+// registered, or if the name is all or has a space or comma, which the
+// disable comments could not name.
+func (r *Registry) Register(rule Rule) error {
+	name := rule.Name()
+	if name == "" || name == all || !validName(name) {
+		return fmt.Errorf("invalid rule name %q", name)
+	}
+	if _, ok := r.rules[name]; ok {
+		return fmt.Errorf("rule %s is already registered", name)
+	}
+	r.rules[name] = rule
+	return nil
+}
+
+// Rule returns the rule with the given name.
+func (r *Registry) Rule(name string) (Rule, bool) {
+	rule, ok := r.rules[name]
+	return rule, ok
+}
+
+// Rules returns the rules ordered by name.
+func (r *Registry) Rules() []Rule {
+	rules := make([]Rule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name() < rules[j].Name() })
+	return rules
+}
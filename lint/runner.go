@@ -0,0 +1,231 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/rewriter"
+)
+
+// Runner runs the enabled rules of a registry over Ruby sources, and
+// applies the fixes of their offenses.
+type Runner struct {
+	registry      *Registry
+	config        *Config
+	parserOptions []parser.ParserOption
+	iterations    int
+}
+
+// Option configures a Runner.
+type Option func(*Runner)
+
+// WithConfig sets the config of the rules. The default, also used for a
+// nil config, enables every rule.
+func WithConfig(config *Config) Option {
+	return func(r *Runner) {
+		if config == nil {
+			config = &Config{}
+		}
+		r.config = config
+	}
+}
+
+// WithParserOptions sets the options of the parsers the runner creates.
+// The path of the file is always set.
+func WithParserOptions(options ...parser.ParserOption) Option {
+	return func(r *Runner) {
+		r.parserOptions = options
+	}
+}
+
+// WithMaxIterations sets how many times Fix runs the rules over a source
+// it has fixed before giving up. The default is 200.
+func WithMaxIterations(iterations int) Option {
+	return func(r *Runner) {
+		r.iterations = iterations
+	}
+}
+
+// NewRunner returns a runner of the rules of registry.
+func NewRunner(registry *Registry, options ...Option) *Runner {
+	r := &Runner{registry: registry, config: &Config{}, iterations: 200}
+	for _, option := range options {
+		option(r)
+	}
+	return r
+}
+
+// Check runs the rules over a parse result, and returns the offenses not
+// disabled by comments, in source order.
+func (r *Runner) Check(file string, result *parser.ParseResult) []*Offense {
+	subscribers := map[parser.NodeType][]*Context{}
+	var contexts []*Context
+	for _, rule := range r.registry.Rules() {
+		if !r.config.Enabled(rule) {
+			continue
+		}
+		c := &Context{
+			File:     file,
+			Result:   result,
+			Source:   result.Source.Bytes,
+			rule:     rule,
+			severity: r.config.Severity(rule),
+		}
+		contexts = append(contexts, c)
+		for _, t := range rule.Types() {
+			subscribers[t] = append(subscribers[t], c)
+		}
+	}
+	if result.Value != nil && len(subscribers) > 0 {
+		parser.Walk(result.Value, func(node parser.Node) bool {
+			for _, c := range subscribers[node.Type()] {
+				c.rule.Check(c, node)
+			}
+			return true
+		})
+	}
+	d := parseDirectives(result)
+	offenses := []*Offense{}
+	for _, c := range contexts {
+		for _, offense := range c.offenses {
+			if !d.disabled(offense.Rule, offense.Line) {
+				offenses = append(offenses, offense)
+			}
+		}
+	}
+	sortOffenses(offenses)
+	return offenses
+}
+
+// Lint parses a source and runs the rules over it.
+func (r *Runner) Lint(ctx context.Context, file string, source []byte) ([]*Offense, error) {
+	result, err := r.parse(ctx, file, source)
+	if err != nil {
+		return nil, err
+	}
+	return r.Check(file, result), nil
+}
+
+// Fix runs the rules over a source and applies the fixes of their
+// offenses, over and over until no fix applies, like RuboCop's
+// autocorrect. It returns the fixed source, the offenses corrected and
+// the offenses left in the fixed source.
+//
+// Fixes are applied safely: a fix whose edits conflict with those of the
+// offenses before it is left for the next round, and a source with syntax
+// errors is not fixed. Fixing fails if the fixes made produce syntax
+// errors, or if they do not settle, undoing each other or going on for
+// more than the maximum number of iterations. A fix may be called several
+// times on the same source and must make the same edits each time.
+func (r *Runner) Fix(ctx context.Context, file string, source []byte) ([]byte, []*Offense, error) {
+	result, err := r.parse(ctx, file, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	corrected := []*Offense{}
+	seen := map[string]bool{string(source): true}
+	for iteration := 0; ; iteration++ {
+		offenses := r.Check(file, result)
+		if len(result.Errors) > 0 {
+			return source, append(corrected, offenses...), nil
+		}
+		fixed, applied := apply(source, offenses)
+		if len(applied) == 0 {
+			return source, append(corrected, offenses...), nil
+		}
+		if seen[string(fixed)] {
+			return nil, nil, fmt.Errorf("%s: fixes by %s undo each other", file, rules(applied))
+		}
+		if iteration+1 >= r.iterations {
+			return nil, nil, fmt.Errorf("%s: fixes did not settle after %d iterations", file, r.iterations)
+		}
+		next, err := r.parse(ctx, file, fixed)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(next.Errors) > 0 {
+			return nil, nil, fmt.Errorf("%s: fixes by %s produce a syntax error: %s", file, rules(applied), next.Errors[0].Message)
+		}
+		for _, offense := range applied {
+			offense.Corrected = true
+		}
+		corrected = append(corrected, applied...)
+		seen[string(fixed)] = true
+		source, result = fixed, next
+	}
+}
+
+func (r *Runner) parse(ctx context.Context, file string, source []byte) (*parser.ParseResult, error) {
+	options := append(r.parserOptions[:len(r.parserOptions):len(r.parserOptions)], parser.WithFilePath(file))
+	p, err := parser.NewParser(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close(ctx)
+	return p.Parse(ctx, source)
+}
+
+// apply applies the fixes of the offenses to source, skipping those that
+// fail or conflict with the fixes applied before them, and returns the
+// fixed source and the offenses whose fixes were applied.
+func apply(source []byte, offenses []*Offense) ([]byte, []*Offense) {
+	var fixes []Fix
+	var applied []*Offense
+	for _, offense := range offenses {
+		if offense.fix == nil {
+			continue
+		}
+		if _, err := rewrite(source, append(fixes, offense.fix)); err != nil {
+			continue
+		}
+		fixes = append(fixes, offense.fix)
+		applied = append(applied, offense)
+	}
+	if len(applied) == 0 {
+		return source, nil
+	}
+	fixed, _ := rewrite(source, fixes)
+	if string(fixed) == string(source) {
+		return source, nil
+	}
+	return fixed, applied
+}
+
+// rewrite applies fixes to source with a rewriter of its own, so that a
+// fix that fails leaves no edits behind.
+func rewrite(source []byte, fixes []Fix) ([]byte, error) {
+	r := rewriter.NewFromSource(source)
+	for _, fix := range fixes {
+		if err := fix(r); err != nil {
+			return nil, err
+		}
+	}
+	return r.Process(), nil
+}
+
+// rules returns the names of the rules of offenses, for messages.
+func rules(offenses []*Offense) string {
+	var names []string
+	seen := map[string]bool{}
+	for _, offense := range offenses {
+		if !seen[offense.Rule] {
+			seen[offense.Rule] = true
+			names = append(names, offense.Rule)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func sortOffenses(offenses []*Offense) {
+	sort.SliceStable(offenses, func(i, j int) bool {
+		a, b := offenses[i].Location, offenses[j].Location
+		if a.StartOffset != b.StartOffset {
+			return a.StartOffset < b.StartOffset
+		}
+		return offenses[i].Rule < offenses[j].Rule
+	})
+}
@@ -0,0 +1,208 @@
+package metrics
+
+import (
+	"math"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// comparisons are the operators that count as conditions rather than
+// branches.
+var comparisons = map[string]bool{
+	"==": true, "===": true, "!=": true, "<=": true, ">=": true, ">": true, "<": true,
+}
+
+type abc struct {
+	ABC
+	discount csends
+	// excluded are the nodes that are not what they look like in the ABC
+	// size: the guards of in clauses, the variables bound by patterns and
+	// named captures, and the =~ call of a named capture.
+	excluded map[parser.Node]bool
+}
+
+// abcSize returns the ABC size of a method, whose parameters count as
+// assignments, visiting the nodes children first like RuboCop.
+func abcSize(parameters, body parser.Node) ABC {
+	s := &abc{discount: csends{}, excluded: map[parser.Node]bool{}}
+	if parameters != nil {
+		s.visit(parameters)
+	}
+	guards(body, s.excluded)
+	parser.Walk(body, func(node parser.Node) bool {
+		var patterns []parser.Node
+		switch n := node.(type) {
+		case *parser.InNode:
+			patterns = append(patterns, n.Pattern)
+		case *parser.MatchPredicateNode:
+			patterns = append(patterns, n.Pattern)
+		case *parser.MatchRequiredNode:
+			patterns = append(patterns, n.Pattern)
+		case *parser.MatchWriteNode:
+			s.excluded[n.Call] = true
+			patterns = append(patterns, n.Targets...)
+		}
+		for _, pattern := range patterns {
+			parser.Walk(pattern, func(target parser.Node) bool {
+				if _, ok := target.(*parser.LocalVariableTargetNode); ok {
+					s.excluded[target] = true
+				}
+				return true
+			})
+		}
+		return true
+	})
+	s.visit(body)
+	s.Score = math.Round(math.Sqrt(float64(s.Assignments*s.Assignments+s.Branches*s.Branches+s.Conditions*s.Conditions))*100) / 100
+	return s.ABC
+}
+
+func (s *abc) visit(node parser.Node) {
+	if node == nil {
+		return
+	}
+	for _, child := range node.CompactChildNodes() {
+		s.visit(child)
+	}
+	if s.excluded[node] {
+		return
+	}
+	if s.assignment(node) {
+		s.Assignments++
+	}
+	s.discount.reset(node)
+	if s.branch(node) {
+		return
+	}
+	if s.condition(node) {
+		s.Conditions++
+	}
+}
+
+// assignment reports whether a node assigns a variable, a constant or an
+// attribute, or binds a block parameter. Variables named with a leading
+// underscore do not count.
+func (s *abc) assignment(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.LocalVariableWriteNode:
+		return capturing(n.Name)
+	case *parser.LocalVariableTargetNode:
+		return capturing(n.Name)
+	case *parser.LocalVariableOperatorWriteNode:
+		return capturing(n.Name)
+	case *parser.LocalVariableOrWriteNode:
+		return capturing(n.Name)
+	case *parser.LocalVariableAndWriteNode:
+		return capturing(n.Name)
+	case *parser.CallNode:
+		return n.IsATTRIBUTE_WRITE()
+	case *parser.InstanceVariableWriteNode, *parser.InstanceVariableTargetNode, *parser.InstanceVariableOperatorWriteNode,
+		*parser.InstanceVariableOrWriteNode, *parser.InstanceVariableAndWriteNode,
+		*parser.ClassVariableWriteNode, *parser.ClassVariableTargetNode, *parser.ClassVariableOperatorWriteNode,
+		*parser.ClassVariableOrWriteNode, *parser.ClassVariableAndWriteNode,
+		*parser.GlobalVariableWriteNode, *parser.GlobalVariableTargetNode, *parser.GlobalVariableOperatorWriteNode,
+		*parser.GlobalVariableOrWriteNode, *parser.GlobalVariableAndWriteNode,
+		*parser.ConstantWriteNode, *parser.ConstantTargetNode, *parser.ConstantOperatorWriteNode,
+		*parser.ConstantOrWriteNode, *parser.ConstantAndWriteNode,
+		*parser.ConstantPathWriteNode, *parser.ConstantPathTargetNode, *parser.ConstantPathOperatorWriteNode,
+		*parser.ConstantPathOrWriteNode, *parser.ConstantPathAndWriteNode,
+		*parser.ForNode:
+		return true
+	case *parser.CallTargetNode, *parser.IndexTargetNode,
+		*parser.CallOperatorWriteNode, *parser.CallOrWriteNode, *parser.CallAndWriteNode,
+		*parser.IndexOperatorWriteNode, *parser.IndexOrWriteNode, *parser.IndexAndWriteNode:
+		// An attribute or element assigned in a multiple or shorthand
+		// assignment.
+		return true
+	case *parser.RequiredParameterNode:
+		return capturing(n.Name)
+	case *parser.OptionalParameterNode:
+		return capturing(n.Name)
+	case *parser.RequiredKeywordParameterNode:
+		return capturing(n.Name)
+	case *parser.OptionalKeywordParameterNode:
+		return capturing(n.Name)
+	case *parser.BlockLocalVariableNode:
+		return capturing(n.Name)
+	case *parser.RestParameterNode:
+		return n.Name != nil && capturing(*n.Name)
+	case *parser.KeywordRestParameterNode:
+		return n.Name != nil && capturing(*n.Name)
+	case *parser.BlockParameterNode:
+		return n.Name != nil && capturing(*n.Name)
+	}
+	return false
+}
+
+// branch counts a method call or yield, and reports whether the node is
+// one. Comparisons count as conditions, and safe navigation calls as both
+// a branch and a condition.
+func (s *abc) branch(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.CallNode:
+		if comparisons[n.Name] {
+			s.Conditions++
+			return true
+		}
+	case *parser.YieldNode:
+		s.Branches++
+		return true
+	case *parser.CallTargetNode, *parser.IndexTargetNode,
+		*parser.CallOperatorWriteNode, *parser.CallOrWriteNode, *parser.CallAndWriteNode,
+		*parser.IndexOperatorWriteNode, *parser.IndexOrWriteNode, *parser.IndexAndWriteNode:
+	default:
+		return false
+	}
+	s.Branches++
+	if receiver, ok := safeNavigation(node); ok && s.discount.counts(node, receiver) {
+		s.Conditions++
+	}
+	// The ||= and &&= of an attribute or element, and the block of an
+	// iterating method, are conditions too.
+	if shortCircuitWrite(node) {
+		s.Conditions++
+	}
+	if call, ok := node.(*parser.CallNode); ok && iteratingBlock(call) {
+		s.Conditions++
+	}
+	return true
+}
+
+// condition reports whether a node is a condition, and counts the else
+// branch of an if, unless or case.
+func (s *abc) condition(node parser.Node) bool {
+	if shortCircuitWrite(node) {
+		return true
+	}
+	switch n := node.(type) {
+	case *parser.IfNode:
+		if _, ok := n.Subsequent.(*parser.ElseNode); ok && n.IfKeywordLoc != nil {
+			s.Conditions++
+		}
+		return true
+	case *parser.UnlessNode:
+		if n.ElseClause != nil {
+			s.Conditions++
+		}
+		return true
+	case *parser.CaseNode:
+		if n.ElseClause != nil {
+			s.Conditions++
+		}
+		return false
+	case *parser.WhileNode:
+		return !n.IsBEGIN_MODIFIER()
+	case *parser.UntilNode:
+		return !n.IsBEGIN_MODIFIER()
+	case *parser.BeginNode:
+		return n.RescueClause != nil
+	case *parser.ForNode, *parser.RescueModifierNode, *parser.WhenNode, *parser.InNode, *parser.AndNode, *parser.OrNode:
+		return true
+	}
+	return false
+}
+
+func capturing(name string) bool {
+	return name != "" && !strings.HasPrefix(name, "_")
+}
@@ -0,0 +1,275 @@
+package metrics
+
+import (
+	"math"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// iterating are the methods whose blocks add to the complexity of a
+// method, as in RuboCop's Metrics::Utils::IteratingBlock.
+var iterating = map[string]bool{}
+
+func init() {
+	for _, name := range []string{
+		// Enumerable
+		"all?", "any?", "chain", "chunk", "chunk_while", "collect", "collect_concat", "count", "cycle",
+		"detect", "drop", "drop_while", "each", "each_cons", "each_entry", "each_slice",
+		"each_with_index", "each_with_object", "entries", "filter", "filter_map", "find",
+		"find_all", "find_index", "first", "flat_map", "grep", "grep_v", "group_by", "inject",
+		"lazy", "map", "max", "max_by", "min", "min_by", "minmax", "minmax_by", "none?", "one?",
+		"partition", "reduce", "reject", "reverse_each", "select", "slice_after",
+		"slice_before", "slice_when", "sort", "sort_by", "sum", "take", "take_while", "tally",
+		"to_h", "uniq", "zip",
+		// Enumerator
+		"with_index", "with_object",
+		// Array
+		"bsearch", "bsearch_index", "collect!", "combination", "delete_if", "each_index",
+		"keep_if", "map!", "permutation", "product", "reject!", "repeated_combination",
+		"repeated_permutation", "select!", "sort!", "sort_by!",
+		// Hash
+		"each_key", "each_pair", "each_value", "fetch", "fetch_values", "has_key?", "merge",
+		"merge!", "transform_keys", "transform_keys!", "transform_values", "transform_values!",
+	} {
+		iterating[name] = true
+	}
+}
+
+// iteratingBlock reports whether a call is given a block, or a block
+// argument, that adds to the complexity of a method: an explicit block,
+// not one using numbered parameters or it, given to an iterating method.
+func iteratingBlock(call *parser.CallNode) bool {
+	if !iterating[call.Name] {
+		return false
+	}
+	switch block := call.Block.(type) {
+	case *parser.BlockArgumentNode:
+		return true
+	case *parser.BlockNode:
+		switch block.Parameters.(type) {
+		case *parser.NumberedParametersNode, *parser.ItParametersNode:
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// csends discounts repeated safe navigation calls on the same local
+// variable, which count once until the variable is assigned again.
+type csends map[string]parser.Node
+
+// counts reports whether a safe navigation call on a receiver counts.
+func (c csends) counts(call, receiver parser.Node) bool {
+	read, ok := receiver.(*parser.LocalVariableReadNode)
+	if !ok {
+		return true
+	}
+	seen, ok := c[read.Name]
+	if !ok {
+		c[read.Name] = call
+		return true
+	}
+	return seen == call
+}
+
+// reset forgets the safe navigation calls on a local variable assigned.
+func (c csends) reset(node parser.Node) {
+	switch n := node.(type) {
+	case *parser.LocalVariableWriteNode:
+		delete(c, n.Name)
+	case *parser.LocalVariableTargetNode:
+		delete(c, n.Name)
+	case *parser.LocalVariableOperatorWriteNode:
+		delete(c, n.Name)
+	case *parser.LocalVariableOrWriteNode:
+		delete(c, n.Name)
+	case *parser.LocalVariableAndWriteNode:
+		delete(c, n.Name)
+	}
+}
+
+// shortCircuitWrite reports whether a node is an ||= or &&= assignment.
+func shortCircuitWrite(node parser.Node) bool {
+	switch node.(type) {
+	case *parser.LocalVariableOrWriteNode, *parser.LocalVariableAndWriteNode,
+		*parser.InstanceVariableOrWriteNode, *parser.InstanceVariableAndWriteNode,
+		*parser.ClassVariableOrWriteNode, *parser.ClassVariableAndWriteNode,
+		*parser.GlobalVariableOrWriteNode, *parser.GlobalVariableAndWriteNode,
+		*parser.ConstantOrWriteNode, *parser.ConstantAndWriteNode,
+		*parser.ConstantPathOrWriteNode, *parser.ConstantPathAndWriteNode,
+		*parser.CallOrWriteNode, *parser.CallAndWriteNode,
+		*parser.IndexOrWriteNode, *parser.IndexAndWriteNode:
+		return true
+	}
+	return false
+}
+
+// safeNavigation returns the receiver of a safe navigation call, or of an
+// assignment through one, and whether the node is one.
+func safeNavigation(node parser.Node) (parser.Node, bool) {
+	switch n := node.(type) {
+	case *parser.CallNode:
+		return n.Receiver, n.IsSAFE_NAVIGATION()
+	case *parser.CallOperatorWriteNode:
+		return n.Receiver, n.IsSAFE_NAVIGATION()
+	case *parser.CallOrWriteNode:
+		return n.Receiver, n.IsSAFE_NAVIGATION()
+	case *parser.CallAndWriteNode:
+		return n.Receiver, n.IsSAFE_NAVIGATION()
+	}
+	return nil, false
+}
+
+// guards adds to found the if and unless nodes that are the guards of the
+// in clauses within node, which are not conditionals of their own.
+func guards(node parser.Node, found map[parser.Node]bool) {
+	parser.Walk(node, func(n parser.Node) bool {
+		if in, ok := n.(*parser.InNode); ok {
+			switch in.Pattern.(type) {
+			case *parser.IfNode, *parser.UnlessNode:
+				found[in.Pattern] = true
+			}
+		}
+		return true
+	})
+}
+
+// cyclomatic returns the cyclomatic complexity of a method body, or its
+// perceived complexity, which weighs else branches and case statements
+// differently.
+func cyclomatic(body parser.Node, perceived bool) int {
+	excluded := map[parser.Node]bool{}
+	guards(body, excluded)
+	elsifs := map[parser.Node]bool{}
+	discount := csends{}
+	score := 1
+	parser.Walk(body, func(node parser.Node) bool {
+		discount.reset(node)
+		if receiver, ok := safeNavigation(node); ok && discount.counts(node, receiver) {
+			score++
+		}
+		if shortCircuitWrite(node) {
+			score++
+		}
+		switch n := node.(type) {
+		case *parser.IfNode:
+			if excluded[n] {
+				return true
+			}
+			if _, ok := n.Subsequent.(*parser.IfNode); ok {
+				elsifs[n.Subsequent] = true
+			}
+			score++
+			if perceived && n.Subsequent != nil && n.IfKeywordLoc != nil && !elsifs[n] {
+				score++
+			}
+		case *parser.UnlessNode:
+			if excluded[n] {
+				return true
+			}
+			score++
+			if perceived && n.ElseClause != nil {
+				score++
+			}
+		case *parser.WhileNode:
+			if !n.IsBEGIN_MODIFIER() {
+				score++
+			}
+		case *parser.UntilNode:
+			if !n.IsBEGIN_MODIFIER() {
+				score++
+			}
+		case *parser.ForNode, *parser.RescueModifierNode, *parser.InNode, *parser.AndNode, *parser.OrNode:
+			score++
+		case *parser.BeginNode:
+			if n.RescueClause != nil {
+				score++
+			}
+		case *parser.CallNode:
+			if iteratingBlock(n) {
+				score++
+			}
+		case *parser.WhenNode:
+			if !perceived {
+				score++
+			}
+		case *parser.CaseNode:
+			if perceived {
+				branches := len(n.Conditions)
+				if n.ElseClause != nil {
+					branches++
+				}
+				// A case without a predicate is an if with elsif branches.
+				// Otherwise the case counts 0.8 and each branch 0.2.
+				if n.Predicate == nil {
+					score += branches
+				} else {
+					score += int(math.Round(float64(branches)*0.2 + 0.8))
+				}
+			}
+		}
+		return true
+	})
+	return score
+}
+
+// nesting returns the deepest nesting of conditionals, case statements,
+// loops and rescue clauses within a node, counted like
+// Metrics/BlockNesting: elsif and modifier if and unless do not nest.
+func nesting(node parser.Node, level int) int {
+	if node == nil {
+		return level
+	}
+	deepest := level
+	inner := level
+	switch n := node.(type) {
+	case *parser.IfNode:
+		return nestingIf(n, level, false)
+	case *parser.UnlessNode:
+		if n.EndKeywordLoc != nil {
+			inner++
+		}
+	case *parser.CaseNode, *parser.CaseMatchNode, *parser.WhileNode, *parser.UntilNode, *parser.ForNode:
+		inner++
+	case *parser.RescueNode:
+		// Each rescue clause is at the level of the first.
+		deepest = max(deepest, level+1)
+		for _, exception := range n.Exceptions {
+			deepest = max(deepest, nesting(exception, level+1))
+		}
+		deepest = max(deepest, nesting(n.Reference, level+1), nesting(statements(n.Statements), level+1))
+		if n.Subsequent != nil {
+			deepest = max(deepest, nesting(n.Subsequent, level))
+		}
+		return deepest
+	case *parser.RescueModifierNode:
+		return max(nesting(n.Expression, level), level+1, nesting(n.RescueExpression, level+1))
+	}
+	deepest = max(deepest, inner)
+	for _, child := range node.CompactChildNodes() {
+		deepest = max(deepest, nesting(child, inner))
+	}
+	return deepest
+}
+
+// nestingIf returns the deepest nesting within an if, or an elsif, which
+// is at the level of its if.
+func nestingIf(n *parser.IfNode, level int, elsif bool) int {
+	inner := level
+	if !elsif && (n.EndKeywordLoc != nil || n.IfKeywordLoc == nil) {
+		inner++
+	}
+	deepest := max(inner, nesting(n.Predicate, inner), nesting(statements(n.Statements), inner))
+	if subsequent, ok := n.Subsequent.(*parser.IfNode); ok {
+		return max(deepest, nestingIf(subsequent, inner, true))
+	}
+	return max(deepest, nesting(n.Subsequent, inner))
+}
+
+func statements(n *parser.StatementsNode) parser.Node {
+	if n == nil {
+		return nil
+	}
+	return n
+}
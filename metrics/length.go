@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"bytes"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// methodLength returns the number of lines of a method body, from its
+// first expression to its last or to the end of the last heredoc it
+// opens, that are not blank or comments.
+func (m *measurer) methodLength(body parser.Node) int {
+	if body == nil {
+		return 0
+	}
+	start, end := -1, -1
+	parser.Walk(body, func(node parser.Node) bool {
+		for _, location := range extent(node) {
+			if start < 0 || location.StartOffset < start {
+				start = location.StartOffset
+			}
+			end = max(end, location.EndOffset())
+		}
+		return true
+	})
+	if start < 0 {
+		return 0
+	}
+	return m.count(m.line(start), m.line(max(start, end-1)), nil)
+}
+
+// extent returns the locations a node covers itself. The implicit begin
+// of a method body with rescue clauses, and else and ensure clauses,
+// cover the end keyword that follows them: they are covered by their
+// keywords and the statements within. Heredocs cover their bodies and
+// terminators.
+func extent(node parser.Node) []parser.Location {
+	switch n := node.(type) {
+	case *parser.BeginNode:
+		if n.BeginKeywordLoc == nil {
+			return nil
+		}
+	case *parser.ElseNode:
+		return []parser.Location{n.ElseKeywordLoc}
+	case *parser.EnsureNode:
+		return []parser.Location{n.EnsureKeywordLoc}
+	case *parser.StringNode:
+		if n.ClosingLoc != nil {
+			return []parser.Location{n.Location, *n.ClosingLoc}
+		}
+	case *parser.InterpolatedStringNode:
+		if n.ClosingLoc != nil {
+			return []parser.Location{n.Location, *n.ClosingLoc}
+		}
+	case *parser.XStringNode:
+		return []parser.Location{n.Location, n.ClosingLoc}
+	case *parser.InterpolatedXStringNode:
+		return []parser.Location{n.Location, n.ClosingLoc}
+	}
+	return []parser.Location{node.GetLocation()}
+}
+
+// classLength returns the number of lines of the body of a class or
+// module that are not blank or comments, without those of the classes
+// and modules nested in it. A class or module whose body is only a nested
+// class or module, a namespace, has none.
+func (m *measurer) classLength(node, body parser.Node) int {
+	if statements, ok := body.(*parser.StatementsNode); ok && len(statements.Body) == 1 {
+		switch statements.Body[0].(type) {
+		case *parser.ClassNode, *parser.ModuleNode:
+			return 0
+		}
+	}
+	location := node.GetLocation()
+	first, last := m.line(location.StartOffset), m.line(location.EndOffset()-1)
+	nested := map[int]bool{}
+	parser.Walk(body, func(n parser.Node) bool {
+		switch n.(type) {
+		case *parser.ClassNode, *parser.ModuleNode:
+			l := n.GetLocation()
+			for line := m.line(l.StartOffset); line <= m.line(l.EndOffset()-1); line++ {
+				nested[line] = true
+			}
+		}
+		return true
+	})
+	return m.count(first+1, last-1, nested)
+}
+
+// count returns the number of lines from first to last, not skipped, that
+// are not blank or comments.
+func (m *measurer) count(first, last int, skipped map[int]bool) int {
+	count := 0
+	for line := first; line <= last; line++ {
+		if skipped[line] {
+			continue
+		}
+		text := bytes.TrimSpace(m.text(line))
+		if len(text) > 0 && text[0] != '#' {
+			count++
+		}
+	}
+	return count
+}
+
+// text returns the text of a line.
+func (m *measurer) text(line int) []byte {
+	index := line - m.source.StartLine
+	if index < 0 || index >= len(m.source.LineOffsets) {
+		return nil
+	}
+	start := m.source.LineOffsets[index]
+	end := len(m.source.Bytes)
+	if index+1 < len(m.source.LineOffsets) {
+		end = m.source.LineOffsets[index+1]
+	}
+	return m.source.Bytes[start:end]
+}
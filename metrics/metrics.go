@@ -0,0 +1,211 @@
+// Package metrics measures the complexity and size of the methods,
+// classes and modules of Ruby files, computed like RuboCop's Metrics cops
+// with their default configuration, so that the numbers agree with it:
+//
+//	cyclomatic complexity   Metrics/CyclomaticComplexity
+//	perceived complexity    Metrics/PerceivedComplexity
+//	ABC size                Metrics/AbcSize
+//	nesting depth           Metrics/BlockNesting, within the method
+//	parameters              Metrics/ParameterLists
+//	lines                   Metrics/MethodLength, ClassLength and ModuleLength
+//
+// Methods are the def definitions and the blocks given to define_method
+// with a literal name, which RuboCop measures as methods too. Lines are
+// counted without blank and comment lines.
+//
+//	report, err := metrics.Scan(ctx, []string{"app", "lib/tasks.rb"})
+//	data, err := json.MarshalIndent(report, "", "  ")
+package metrics
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/signature"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+	"github.com/danielgatis/go-ruby-prism/synthetic"
+)
+
+// Report holds the metrics of a set of files.
+type Report struct {
+	Files []*File `json:"files"`
+}
+
+// File holds the metrics of the definitions of a file, in source order.
+type File struct {
+	File    string    `json:"file"`
+	Classes []*Class  `json:"classes"`
+	Methods []*Method `json:"methods"`
+}
+
+// Class holds the metrics of a class or module definition. A class
+// reopened in several places is measured in each.
+type Class struct {
+	// Kind is class or module.
+	Kind string `json:"kind"`
+	// Name is the qualified name of the class or module.
+	Name string `json:"name"`
+	Line int    `json:"line"`
+	// Lines is the number of lines of the body, without those of the
+	// classes and modules nested in it, or 0 for a module or class whose
+	// body is only a nested definition.
+	Lines int `json:"lines"`
+}
+
+// Method holds the metrics of a method definition.
+type Method struct {
+	// Name is the qualified name of the method, as in the symbols package.
+	Name string `json:"name"`
+	Line int    `json:"line"`
+	// Lines is the number of lines of the body.
+	Lines                int `json:"lines"`
+	CyclomaticComplexity int `json:"cyclomatic_complexity"`
+	PerceivedComplexity  int `json:"perceived_complexity"`
+	ABC                  ABC `json:"abc_size"`
+	// NestingDepth is the deepest nesting of conditionals, loops and
+	// rescue clauses within the body.
+	NestingDepth int `json:"nesting_depth"`
+	Parameters   int `json:"parameters"`
+}
+
+// ABC is the ABC size of a method: its assignments, branches (method
+// calls) and conditions, and the magnitude of that vector.
+type ABC struct {
+	Assignments int     `json:"assignments"`
+	Branches    int     `json:"branches"`
+	Conditions  int     `json:"conditions"`
+	Score       float64 `json:"score"`
+}
+
+// Measure returns the metrics of a parsed file. A result without a tree or
+// a source, as one built or cloned without them, has none.
+func Measure(file string, result *parser.ParseResult) *File {
+	f := &File{File: file, Classes: []*Class{}, Methods: []*Method{}}
+	if result.Value == nil || result.Source == nil {
+		return f
+	}
+	m := &measurer{source: result.Source}
+	table := symbols.Collect(file, result.Value)
+	for _, s := range table.Symbols {
+		switch n := s.Node.(type) {
+		case *parser.ClassNode:
+			f.Classes = append(f.Classes, m.class("class", s.QualifiedName, n, n.Body))
+		case *parser.ModuleNode:
+			f.Classes = append(f.Classes, m.class("module", s.QualifiedName, n, n.Body))
+		case *parser.DefNode:
+			f.Methods = append(f.Methods, m.method(s.QualifiedName, n, n.Body))
+		}
+	}
+	var defined []*Method
+	for _, synthesized := range synthetic.Expand(table, result.Value) {
+		if synthesized.Kind != synthetic.DefineMethod {
+			continue
+		}
+		call := synthesized.Symbol.Node.(*parser.CallNode)
+		if block, ok := call.Block.(*parser.BlockNode); ok {
+			defined = append(defined, m.method(synthesized.Symbol.QualifiedName, block, block.Body))
+		}
+	}
+	if len(defined) > 0 {
+		f.Methods = append(f.Methods, defined...)
+		sort.SliceStable(f.Methods, func(i, j int) bool { return f.Methods[i].Line < f.Methods[j].Line })
+	}
+	return f
+}
+
+// Scan parses and measures the Ruby files at paths, and those under the
+// directories among them, in lexical order. The files are parsed by a
+// single parser created with options, given the path of each file.
+func Scan(ctx context.Context, paths []string, options ...parser.ParserOption) (*Report, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if file == path && !entry.IsDir() || !entry.IsDir() && strings.HasSuffix(file, ".rb") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	p, err := parser.NewParser(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close(ctx)
+	report := &Report{Files: []*File{}}
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		parser.WithFilePath(file)(p)
+		result, err := p.Parse(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		report.Files = append(report.Files, Measure(file, result))
+	}
+	return report, nil
+}
+
+type measurer struct {
+	source *parser.Source
+}
+
+func (m *measurer) line(offset int) int {
+	line, _ := m.source.Line(offset)
+	return line
+}
+
+func (m *measurer) class(kind, name string, node, body parser.Node) *Class {
+	return &Class{
+		Kind:  kind,
+		Name:  name,
+		Line:  m.line(node.GetLocation().StartOffset),
+		Lines: m.classLength(node, body),
+	}
+}
+
+// method measures a def, or a block given to define_method.
+func (m *measurer) method(name string, node, body parser.Node) *Method {
+	method := &Method{
+		Name:                 name,
+		Line:                 m.line(node.GetLocation().StartOffset),
+		Lines:                m.methodLength(body),
+		CyclomaticComplexity: 1,
+		PerceivedComplexity:  1,
+	}
+	if sig, ok := signature.Of(node, nil); ok {
+		method.Parameters = len(sig.Parameters)
+	}
+	method.ABC = abcSize(parameters(node), body)
+	if body != nil {
+		method.CyclomaticComplexity = cyclomatic(body, false)
+		method.PerceivedComplexity = cyclomatic(body, true)
+		method.NestingDepth = nesting(body, 0)
+	}
+	return method
+}
+
+// parameters returns the parameters of a def or block, or nil.
+func parameters(node parser.Node) parser.Node {
+	switch n := node.(type) {
+	case *parser.DefNode:
+		if n.Parameters != nil {
+			return n.Parameters
+		}
+	case *parser.BlockNode:
+		return n.Parameters
+	}
+	return nil
+}
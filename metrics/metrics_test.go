@@ -0,0 +1,175 @@
+package metrics_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/metrics"
+)
+
+func method(t *testing.T, source string) *metrics.Method {
+	t.Helper()
+	f := metrics.Measure("a.rb", parsetest.Parse(t, source))
+	if len(f.Methods) != 1 {
+		t.Fatalf("%d methods, want 1", len(f.Methods))
+	}
+	return f.Methods[0]
+}
+
+func TestComplexity(t *testing.T) {
+	tests := []struct {
+		source                string
+		cyclomatic, perceived int
+	}{
+		{"def f; end", 1, 1},
+		{"def f(a)\n  if a\n    b\n  elsif c\n    d\n  else\n    e\n  end\nend", 3, 4},
+		{"def f\n  list.each { |x| x&.y }\n  a || b\n  c ||= 1\n  while d; end\n  return unless e\nend", 7, 7},
+		{"def f\n  list.map { _1 }\n  list.map { it }\n  list.tap { |x| x }\n  list.map(&:to_s)\nend", 2, 2},
+		{"def f(x)\n  case x\n  when 1 then a\n  when 2 then b\n  else c\n  end\nend", 3, 2},
+		{"def f(x)\n  case\n  when x then a\n  else c\n  end\nend", 2, 3},
+		{"def f(x)\n  case x\n  in Integer if x > 1 then a\n  in String then b\n  end\nend", 3, 3},
+		{"def f\n  begin\n    a\n  rescue\n    b\n  end\n  c rescue d\n  a ? b : c\nend", 4, 4},
+		{"def f(x)\n  x&.a\n  x&.b\n  x = y\n  x&.c\nend", 3, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			m := method(t, test.source)
+			if m.CyclomaticComplexity != test.cyclomatic || m.PerceivedComplexity != test.perceived {
+				t.Errorf("complexity = %d, %d, want %d, %d", m.CyclomaticComplexity, m.PerceivedComplexity, test.cyclomatic, test.perceived)
+			}
+		})
+	}
+}
+
+func TestABC(t *testing.T) {
+	tests := []struct {
+		source string
+		want   metrics.ABC
+	}{
+		{"def f; end", metrics.ABC{}},
+		{"def f(a)\n  x = a.b(c)\n  x == 1\nend", metrics.ABC{Assignments: 2, Branches: 2, Conditions: 1, Score: 3}},
+		{"def f\n  @a ||= b\nend", metrics.ABC{Assignments: 1, Branches: 1, Conditions: 1, Score: 1.73}},
+		{"def f(x)\n  case x\n  in [y] then y\n  end\nend", metrics.ABC{Assignments: 1, Conditions: 1, Score: 1.41}},
+		{"def f\n  if /(?<m>.)/ =~ s\n    m\n  end\nend", metrics.ABC{Branches: 1, Conditions: 1, Score: 1.41}},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := method(t, test.source).ABC; got != test.want {
+				t.Errorf("ABC = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNestingAndSize(t *testing.T) {
+	tests := []struct {
+		source                    string
+		nesting, lines, arguments int
+	}{
+		{"def f(a, b = 1, *c, d:, &e)\n  a\nend", 0, 1, 5},
+		{"def f\n  if a\n    while b\n      c rescue d\n    end\n  end\nend", 3, 5, 0},
+		{"def f\n  if a\n    b\n  elsif c\n    d if e\n  end\nend", 1, 5, 0},
+		{"def f\n  a\n\n  # comment\n  b\nend", 0, 2, 0},
+		{"def f\n  a(<<~TEXT)\n    one\n    two\n  TEXT\nend", 0, 4, 0},
+		{"def f\n  a\nrescue\n  b\nensure\n  c\nend", 1, 5, 0},
+		{"def f = a", 0, 1, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			m := method(t, test.source)
+			if m.NestingDepth != test.nesting || m.Lines != test.lines || m.Parameters != test.arguments {
+				t.Errorf("nesting, lines, parameters = %d, %d, %d, want %d, %d, %d", m.NestingDepth, m.Lines, m.Parameters, test.nesting, test.lines, test.arguments)
+			}
+		})
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	source := `module App
+  class User
+    X = 1
+
+    # comment
+    class Error < StandardError
+      def message; end
+    end
+
+    def save; end
+    define_method(:build) do |a, b|
+      a if b
+    end
+    def self.find; end
+  end
+end
+`
+	f := metrics.Measure("app.rb", parsetest.Parse(t, source))
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"file":"app.rb","classes":[` +
+		`{"kind":"module","name":"App","line":1,"lines":0},` +
+		`{"kind":"class","name":"App::User","line":2,"lines":6},` +
+		`{"kind":"class","name":"App::User::Error","line":6,"lines":1}],"methods":[` +
+		`{"name":"App::User::Error#message","line":7,"lines":0,"cyclomatic_complexity":1,"perceived_complexity":1,"abc_size":{"assignments":0,"branches":0,"conditions":0,"score":0},"nesting_depth":0,"parameters":0},` +
+		`{"name":"App::User#save","line":10,"lines":0,"cyclomatic_complexity":1,"perceived_complexity":1,"abc_size":{"assignments":0,"branches":0,"conditions":0,"score":0},"nesting_depth":0,"parameters":0},` +
+		`{"name":"App::User#build","line":11,"lines":1,"cyclomatic_complexity":2,"perceived_complexity":2,"abc_size":{"assignments":2,"branches":0,"conditions":1,"score":2.24},"nesting_depth":0,"parameters":2},` +
+		`{"name":"App::User.find","line":14,"lines":0,"cyclomatic_complexity":1,"perceived_complexity":1,"abc_size":{"assignments":0,"branches":0,"conditions":0,"score":0},"nesting_depth":0,"parameters":0}]}`
+	if string(data) != want {
+		t.Errorf("Measure() =\n%s\nwant\n%s", data, want)
+	}
+
+	result := parsetest.Parse(t, source)
+	result.Source = nil
+	if f := metrics.Measure("app.rb", result); len(f.Classes) != 0 || len(f.Methods) != 0 {
+		t.Errorf("Measure() of a result without a source = %d classes and %d methods, want none", len(f.Classes), len(f.Methods))
+	}
+}
+
+func TestScan(t *testing.T) {
+	directory := t.TempDir()
+	files := map[string]string{
+		"app/b.rb":        "class B\n  def b = 1\nend\n",
+		"app/a.rb":        "class A\nend\n",
+		"app/nested/c.rb": "def c(x) = x ? 1 : 2\n",
+		"app/notes.txt":   "not ruby",
+		"script":          "puts 1\n",
+	}
+	for name, source := range files {
+		path := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := metrics.Scan(context.Background(), []string{filepath.Join(directory, "app"), filepath.Join(directory, "script")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range report.Files {
+		relative, _ := filepath.Rel(directory, f.File)
+		names = append(names, filepath.ToSlash(relative))
+	}
+	if got, want := strings.Join(names, " "), "app/a.rb app/b.rb app/nested/c.rb script"; got != want {
+		t.Fatalf("scanned %s, want %s", got, want)
+	}
+	if m := report.Files[2].Methods; len(m) != 1 || m[0].Name != "Object#c" || m[0].CyclomaticComplexity != 2 {
+		t.Errorf("methods of c.rb = %+v", m)
+	}
+	if c := report.Files[1].Classes; len(c) != 1 || c[0].Name != "B" || c[0].Lines != 1 {
+		t.Errorf("classes of b.rb = %+v", c)
+	}
+
+	if _, err := metrics.Scan(context.Background(), []string{filepath.Join(directory, "missing")}); err == nil {
+		t.Error("Scan() of a missing path did not fail")
+	}
+}
@@ -0,0 +1,130 @@
+package parser
+
+import (
+	"reflect"
+)
+
+// Clone returns a deep copy of the tree rooted at node. Child nodes, lists,
+// optional locations and constants are copied, so that the copy can be
+// mutated without affecting the original.
+func Clone[T Node](node T) T {
+	clone, _ := cloneNode(node).(T)
+	return clone
+}
+
+func cloneNodes(nodes []Node) []Node {
+	if nodes == nil {
+		return nil
+	}
+	clones := make([]Node, len(nodes))
+	for index, node := range nodes {
+		clones[index] = cloneNode(node)
+	}
+	return clones
+}
+
+func cloneOptional[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	clone := *value
+	return &clone
+}
+
+type equalOptions struct {
+	ignoreLocations bool
+	ignoreNodeIDs   bool
+	ignoreFlags     bool
+}
+
+// EqualOption configures Equal.
+type EqualOption func(*equalOptions)
+
+// IgnoreLocations makes Equal ignore the locations of nodes and of their
+// location fields, such as OpeningLoc.
+func IgnoreLocations() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreLocations = true
+	}
+}
+
+// IgnoreNodeIDs makes Equal ignore node IDs.
+func IgnoreNodeIDs() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreNodeIDs = true
+	}
+}
+
+// IgnoreFlags makes Equal ignore node flags, including the flags shared by
+// every node.
+func IgnoreFlags() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreFlags = true
+	}
+}
+
+// Equal reports whether two trees are structurally equal: their nodes have
+// the same types and equal fields, recursively. A nil node and a nil
+// pointer held by a Node are equal.
+func Equal(a, b Node, options ...EqualOption) bool {
+	o := &equalOptions{}
+	for _, option := range options {
+		option(o)
+	}
+	return o.node(a, b)
+}
+
+func (o *equalOptions) node(a, b Node) bool {
+	if isNilNode(a) || isNilNode(b) {
+		return isNilNode(a) && isNilNode(b)
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	if !o.ignoreNodeIDs && a.GetNodeID() != b.GetNodeID() {
+		return false
+	}
+	if !o.ignoreLocations && a.GetLocation() != b.GetLocation() {
+		return false
+	}
+	if !o.ignoreFlags && a.Flags().Bits() != b.Flags().Bits() {
+		return false
+	}
+	return equalFields(a, b, o)
+}
+
+func (o *equalOptions) nodes(a, b []Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if !o.node(a[index], b[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *equalOptions) location(a, b Location) bool {
+	return o.ignoreLocations || a == b
+}
+
+// optionalLocation compares optional location fields. Their presence is
+// part of the structure, so it is compared even when locations are ignored.
+func (o *equalOptions) optionalLocation(a, b *Location) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return o.location(*a, *b)
+}
+
+func equalOptionalString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func isNilNode(node Node) bool {
+	return node == nil || reflect.ValueOf(node).IsNil()
+}
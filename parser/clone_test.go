@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	sources := []string{
+		"foo.bar(1, *a, &b)",
+		"def foo(a, b = 1, *c, d:, **e, &f) = a",
+		"class Foo < Bar; attr_reader :x; end",
+		`x = "a#{b}c" if y`,
+		"case x; in [Integer => a, *] then a; end",
+		"a, (b, *c) = d",
+		"x = <<~E\n  a\nE\n",
+	}
+	for _, source := range sources {
+		t.Run(source, func(t *testing.T) {
+			program := parse(t, source).Value
+			clone := Clone(program)
+			if clone == program {
+				t.Fatal("Clone() returned the same node")
+			}
+			if !Equal(program, clone) {
+				t.Errorf("Clone() is not equal to the original")
+			}
+		})
+	}
+}
+
+func TestCloneIsDeep(t *testing.T) {
+	call := statement(t, "foo.bar(1)").(*CallNode)
+	clone := Clone(call)
+	clone.Name = "baz"
+	clone.MessageLoc.StartOffset = 100
+	clone.Arguments.Arguments[0] = statement(t, "2")
+	if err := clone.SetChild("receiver", nil); err != nil {
+		t.Fatal(err)
+	}
+	if call.Name != "bar" || call.MessageLoc.StartOffset != 4 || call.Receiver == nil {
+		t.Errorf("mutating the clone changed the original: %v", call)
+	}
+	if integer, ok := call.Arguments.Arguments[0].(*IntegerNode); !ok || integer.GetLocation().StartOffset != 8 {
+		t.Errorf("mutating the arguments of the clone changed the original: %v", call.Arguments)
+	}
+	if Clone[Node](nil) != nil {
+		t.Error("Clone(nil) is not nil")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	loose := []EqualOption{IgnoreLocations(), IgnoreNodeIDs()}
+	tests := []struct {
+		a, b    string
+		options []EqualOption
+		want    bool
+	}{
+		{"foo(1)", "foo(1)", nil, true},
+		{"foo(1)", "foo( 1 )", nil, false},
+		{"foo(1)", "foo( 1 )", loose, true},
+		{"foo(1)", "foo(2)", loose, false},
+		{"foo(1)", "bar(1)", loose, false},
+		// Whether an optional location is present is structure.
+		{"foo", "foo()", loose, false},
+		{"[1]", "[1, 2]", loose, false},
+		{"1", "0x1", loose, false},
+		{"1", "0x1", append(loose, IgnoreFlags()), true},
+		{"x = 1; x", "x = 1\nx", loose, true},
+	}
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			a, b := parse(t, test.a).Value, parse(t, test.b).Value
+			if got := Equal(a, b, test.options...); got != test.want {
+				t.Errorf("Equal() = %v, want %v", got, test.want)
+			}
+		})
+	}
+	var call *CallNode
+	if !Equal(nil, call) {
+		t.Error("Equal(nil, nil pointer) is false")
+	}
+	if Equal(nil, statement(t, "foo")) {
+		t.Error("Equal(nil, node) is true")
+	}
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flags shared by every node.
+const (
+	NodeFlagsNEWLINE        = 1 << 0
+	NodeFlagsSTATIC_LITERAL = 1 << 1
+)
+
+var nodeFlagsInfo = []FlagInfo{
+	{Name: "NEWLINE", Mask: NodeFlagsNEWLINE},
+	{Name: "STATIC_LITERAL", Mask: NodeFlagsSTATIC_LITERAL},
+}
+
+// FlagSet is the set of flags on a node. The concrete type is the flag set of
+// the node type, e.g. CallNodeFlags, or NodeFlags when the node type only has
+// the flags shared by every node.
+type FlagSet interface {
+	fmt.Stringer
+	Bits() uint32
+}
+
+// NodeFlags is the flag set of nodes that only have the flags shared by every node.
+type NodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f NodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f NodeFlags) String() string {
+	return formatFlags(uint32(f), nil)
+}
+
+// formatFlags returns the names of the flags set in bits separated by "|",
+// or "0" when no known flag is set.
+func formatFlags(bits uint32, infos []FlagInfo) string {
+	names := flagNames(bits, infos)
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, "|")
+}
+
+// flagNames returns the names of the flags set in bits, the flags shared by
+// every node first.
+func flagNames(bits uint32, infos []FlagInfo) []string {
+	var names []string
+	for _, info := range nodeFlagsInfo {
+		if bits&info.Mask != 0 {
+			names = append(names, info.Name)
+		}
+	}
+	for _, info := range infos {
+		if bits&info.Mask != 0 {
+			names = append(names, info.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlags(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"foo&.bar", "NEWLINE|SAFE_NAVIGATION"},
+		{"foo", "NEWLINE|VARIABLE_CALL|IGNORE_VISIBILITY"},
+		{"foo.bar = 1", "NEWLINE|ATTRIBUTE_WRITE"},
+		{"0x1f", "NEWLINE|STATIC_LITERAL|HEXADECIMAL"},
+		{"10", "NEWLINE|STATIC_LITERAL|DECIMAL"},
+		{"[1, *a]", "NEWLINE|CONTAINS_SPLAT"},
+		{"/a/i", "NEWLINE|STATIC_LITERAL|IGNORE_CASE|FORCED_US_ASCII_ENCODING"},
+		{"1..2", "NEWLINE|STATIC_LITERAL"},
+		{"1...2", "NEWLINE|STATIC_LITERAL|EXCLUDE_END"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			node := statement(t, test.source)
+			if got := node.Flags().String(); got != test.want {
+				t.Errorf("Flags() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFlagHelpers(t *testing.T) {
+	call := statement(t, "foo&.bar").(*CallNode)
+	if !call.IsNewline() || call.IsStaticLiteral() || !call.IsSAFE_NAVIGATION() || call.IsVARIABLE_CALL() {
+		t.Errorf("flag helpers disagree with %s", call.Flags())
+	}
+	if _, ok := call.Flags().(CallNodeFlags); !ok {
+		t.Errorf("Flags() is a %T, want CallNodeFlags", call.Flags())
+	}
+	if bits := call.Flags().Bits(); bits != NodeFlagsNEWLINE|CallNodeFlagsSAFE_NAVIGATION {
+		t.Errorf("Bits() = %b", bits)
+	}
+	self := statement(t, "self")
+	if _, ok := self.Flags().(NodeFlags); !ok {
+		t.Errorf("Flags() of SelfNode is a %T, want NodeFlags", self.Flags())
+	}
+}
+
+func TestFlagsJSON(t *testing.T) {
+	data, err := json.Marshal(statement(t, "foo&.bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Flags uint32 `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Flags != NodeFlagsNEWLINE|CallNodeFlagsSAFE_NAVIGATION {
+		t.Errorf("flags = %b in %s", decoded.Flags, data)
+	}
+}
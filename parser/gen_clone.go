@@ -0,0 +1,2763 @@
+/*----------------------------------------------------------------------------*/
+/* This file is generated by the templates/template.rb script and should not  */
+/* be modified manually. See                                                  */
+/* templates/../../templates/gen_clone.go.erb                                 */
+/* if you are looking to modify the                                           */
+/* template                                                                   */
+/*----------------------------------------------------------------------------*/
+
+package parser
+
+import (
+	"slices"
+)
+
+// Clone returns a deep copy of the node.
+func (n *AliasGlobalVariableNode) Clone() *AliasGlobalVariableNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.NewName = cloneNode(n.NewName)
+	clone.OldName = cloneNode(n.OldName)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *AliasMethodNode) Clone() *AliasMethodNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.NewName = cloneNode(n.NewName)
+	clone.OldName = cloneNode(n.OldName)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *AlternationPatternNode) Clone() *AlternationPatternNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Left = cloneNode(n.Left)
+	clone.Right = cloneNode(n.Right)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *AndNode) Clone() *AndNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Left = cloneNode(n.Left)
+	clone.Right = cloneNode(n.Right)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ArgumentsNode) Clone() *ArgumentsNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Arguments = cloneNodes(n.Arguments)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ArrayNode) Clone() *ArrayNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Elements = cloneNodes(n.Elements)
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ArrayPatternNode) Clone() *ArrayPatternNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Constant = cloneNode(n.Constant)
+	clone.Requireds = cloneNodes(n.Requireds)
+	clone.Rest = cloneNode(n.Rest)
+	clone.Posts = cloneNodes(n.Posts)
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *AssocNode) Clone() *AssocNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Key = cloneNode(n.Key)
+	clone.Value = cloneNode(n.Value)
+	clone.OperatorLoc = cloneOptional(n.OperatorLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *AssocSplatNode) Clone() *AssocSplatNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BackReferenceReadNode) Clone() *BackReferenceReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BeginNode) Clone() *BeginNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.BeginKeywordLoc = cloneOptional(n.BeginKeywordLoc)
+	clone.Statements = n.Statements.Clone()
+	clone.RescueClause = n.RescueClause.Clone()
+	clone.ElseClause = n.ElseClause.Clone()
+	clone.EnsureClause = n.EnsureClause.Clone()
+	clone.EndKeywordLoc = cloneOptional(n.EndKeywordLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BlockArgumentNode) Clone() *BlockArgumentNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Expression = cloneNode(n.Expression)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BlockLocalVariableNode) Clone() *BlockLocalVariableNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BlockNode) Clone() *BlockNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Locals = slices.Clone(n.Locals)
+	clone.Parameters = cloneNode(n.Parameters)
+	clone.Body = cloneNode(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BlockParameterNode) Clone() *BlockParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Name = cloneOptional(n.Name)
+	clone.NameLoc = cloneOptional(n.NameLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BlockParametersNode) Clone() *BlockParametersNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Parameters = n.Parameters.Clone()
+	clone.Locals = cloneNodes(n.Locals)
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *BreakNode) Clone() *BreakNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Arguments = n.Arguments.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CallAndWriteNode) Clone() *CallAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.MessageLoc = cloneOptional(n.MessageLoc)
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CallNode) Clone() *CallNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.MessageLoc = cloneOptional(n.MessageLoc)
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.Arguments = n.Arguments.Clone()
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	clone.Block = cloneNode(n.Block)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CallOperatorWriteNode) Clone() *CallOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.MessageLoc = cloneOptional(n.MessageLoc)
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CallOrWriteNode) Clone() *CallOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.MessageLoc = cloneOptional(n.MessageLoc)
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CallTargetNode) Clone() *CallTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CapturePatternNode) Clone() *CapturePatternNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	clone.Target = n.Target.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CaseMatchNode) Clone() *CaseMatchNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Predicate = cloneNode(n.Predicate)
+	clone.Conditions = cloneNodes(n.Conditions)
+	clone.ElseClause = n.ElseClause.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *CaseNode) Clone() *CaseNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Predicate = cloneNode(n.Predicate)
+	clone.Conditions = cloneNodes(n.Conditions)
+	clone.ElseClause = n.ElseClause.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassNode) Clone() *ClassNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Locals = slices.Clone(n.Locals)
+	clone.ConstantPath = cloneNode(n.ConstantPath)
+	clone.InheritanceOperatorLoc = cloneOptional(n.InheritanceOperatorLoc)
+	clone.Superclass = cloneNode(n.Superclass)
+	clone.Body = cloneNode(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassVariableAndWriteNode) Clone() *ClassVariableAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassVariableOperatorWriteNode) Clone() *ClassVariableOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassVariableOrWriteNode) Clone() *ClassVariableOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassVariableReadNode) Clone() *ClassVariableReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassVariableTargetNode) Clone() *ClassVariableTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ClassVariableWriteNode) Clone() *ClassVariableWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantAndWriteNode) Clone() *ConstantAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantOperatorWriteNode) Clone() *ConstantOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantOrWriteNode) Clone() *ConstantOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantPathAndWriteNode) Clone() *ConstantPathAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Target = n.Target.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantPathNode) Clone() *ConstantPathNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Parent = cloneNode(n.Parent)
+	clone.Name = cloneOptional(n.Name)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantPathOperatorWriteNode) Clone() *ConstantPathOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Target = n.Target.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantPathOrWriteNode) Clone() *ConstantPathOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Target = n.Target.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantPathTargetNode) Clone() *ConstantPathTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Parent = cloneNode(n.Parent)
+	clone.Name = cloneOptional(n.Name)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantPathWriteNode) Clone() *ConstantPathWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Target = n.Target.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantReadNode) Clone() *ConstantReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantTargetNode) Clone() *ConstantTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ConstantWriteNode) Clone() *ConstantWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *DefNode) Clone() *DefNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.Parameters = n.Parameters.Clone()
+	clone.Body = cloneNode(n.Body)
+	clone.Locals = slices.Clone(n.Locals)
+	clone.OperatorLoc = cloneOptional(n.OperatorLoc)
+	clone.LparenLoc = cloneOptional(n.LparenLoc)
+	clone.RparenLoc = cloneOptional(n.RparenLoc)
+	clone.EqualLoc = cloneOptional(n.EqualLoc)
+	clone.EndKeywordLoc = cloneOptional(n.EndKeywordLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *DefinedNode) Clone() *DefinedNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.LparenLoc = cloneOptional(n.LparenLoc)
+	clone.Value = cloneNode(n.Value)
+	clone.RparenLoc = cloneOptional(n.RparenLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ElseNode) Clone() *ElseNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Statements = n.Statements.Clone()
+	clone.EndKeywordLoc = cloneOptional(n.EndKeywordLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *EmbeddedStatementsNode) Clone() *EmbeddedStatementsNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *EmbeddedVariableNode) Clone() *EmbeddedVariableNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Variable = cloneNode(n.Variable)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *EnsureNode) Clone() *EnsureNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *FalseNode) Clone() *FalseNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *FindPatternNode) Clone() *FindPatternNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Constant = cloneNode(n.Constant)
+	clone.Left = n.Left.Clone()
+	clone.Requireds = cloneNodes(n.Requireds)
+	clone.Right = cloneNode(n.Right)
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *FlipFlopNode) Clone() *FlipFlopNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Left = cloneNode(n.Left)
+	clone.Right = cloneNode(n.Right)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *FloatNode) Clone() *FloatNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ForNode) Clone() *ForNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Index = cloneNode(n.Index)
+	clone.Collection = cloneNode(n.Collection)
+	clone.Statements = n.Statements.Clone()
+	clone.DoKeywordLoc = cloneOptional(n.DoKeywordLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ForwardingArgumentsNode) Clone() *ForwardingArgumentsNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ForwardingParameterNode) Clone() *ForwardingParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ForwardingSuperNode) Clone() *ForwardingSuperNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Block = n.Block.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *GlobalVariableAndWriteNode) Clone() *GlobalVariableAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *GlobalVariableOperatorWriteNode) Clone() *GlobalVariableOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *GlobalVariableOrWriteNode) Clone() *GlobalVariableOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *GlobalVariableReadNode) Clone() *GlobalVariableReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *GlobalVariableTargetNode) Clone() *GlobalVariableTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *GlobalVariableWriteNode) Clone() *GlobalVariableWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *HashNode) Clone() *HashNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Elements = cloneNodes(n.Elements)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *HashPatternNode) Clone() *HashPatternNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Constant = cloneNode(n.Constant)
+	clone.Elements = cloneNodes(n.Elements)
+	clone.Rest = cloneNode(n.Rest)
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *IfNode) Clone() *IfNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.IfKeywordLoc = cloneOptional(n.IfKeywordLoc)
+	clone.Predicate = cloneNode(n.Predicate)
+	clone.ThenKeywordLoc = cloneOptional(n.ThenKeywordLoc)
+	clone.Statements = n.Statements.Clone()
+	clone.Subsequent = cloneNode(n.Subsequent)
+	clone.EndKeywordLoc = cloneOptional(n.EndKeywordLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ImaginaryNode) Clone() *ImaginaryNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Numeric = cloneNode(n.Numeric)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ImplicitNode) Clone() *ImplicitNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ImplicitRestNode) Clone() *ImplicitRestNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InNode) Clone() *InNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Pattern = cloneNode(n.Pattern)
+	clone.Statements = n.Statements.Clone()
+	clone.ThenLoc = cloneOptional(n.ThenLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *IndexAndWriteNode) Clone() *IndexAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.Arguments = n.Arguments.Clone()
+	clone.Block = n.Block.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *IndexOperatorWriteNode) Clone() *IndexOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.Arguments = n.Arguments.Clone()
+	clone.Block = n.Block.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *IndexOrWriteNode) Clone() *IndexOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.CallOperatorLoc = cloneOptional(n.CallOperatorLoc)
+	clone.Arguments = n.Arguments.Clone()
+	clone.Block = n.Block.Clone()
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *IndexTargetNode) Clone() *IndexTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Receiver = cloneNode(n.Receiver)
+	clone.Arguments = n.Arguments.Clone()
+	clone.Block = n.Block.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InstanceVariableAndWriteNode) Clone() *InstanceVariableAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InstanceVariableOperatorWriteNode) Clone() *InstanceVariableOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InstanceVariableOrWriteNode) Clone() *InstanceVariableOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InstanceVariableReadNode) Clone() *InstanceVariableReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InstanceVariableTargetNode) Clone() *InstanceVariableTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InstanceVariableWriteNode) Clone() *InstanceVariableWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *IntegerNode) Clone() *IntegerNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InterpolatedMatchLastLineNode) Clone() *InterpolatedMatchLastLineNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Parts = cloneNodes(n.Parts)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InterpolatedRegularExpressionNode) Clone() *InterpolatedRegularExpressionNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Parts = cloneNodes(n.Parts)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InterpolatedStringNode) Clone() *InterpolatedStringNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.Parts = cloneNodes(n.Parts)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InterpolatedSymbolNode) Clone() *InterpolatedSymbolNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.Parts = cloneNodes(n.Parts)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *InterpolatedXStringNode) Clone() *InterpolatedXStringNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Parts = cloneNodes(n.Parts)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ItLocalVariableReadNode) Clone() *ItLocalVariableReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ItParametersNode) Clone() *ItParametersNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *KeywordHashNode) Clone() *KeywordHashNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Elements = cloneNodes(n.Elements)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *KeywordRestParameterNode) Clone() *KeywordRestParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Name = cloneOptional(n.Name)
+	clone.NameLoc = cloneOptional(n.NameLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LambdaNode) Clone() *LambdaNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Locals = slices.Clone(n.Locals)
+	clone.Parameters = cloneNode(n.Parameters)
+	clone.Body = cloneNode(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LocalVariableAndWriteNode) Clone() *LocalVariableAndWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LocalVariableOperatorWriteNode) Clone() *LocalVariableOperatorWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LocalVariableOrWriteNode) Clone() *LocalVariableOrWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LocalVariableReadNode) Clone() *LocalVariableReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LocalVariableTargetNode) Clone() *LocalVariableTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *LocalVariableWriteNode) Clone() *LocalVariableWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MatchLastLineNode) Clone() *MatchLastLineNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MatchPredicateNode) Clone() *MatchPredicateNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	clone.Pattern = cloneNode(n.Pattern)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MatchRequiredNode) Clone() *MatchRequiredNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	clone.Pattern = cloneNode(n.Pattern)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MatchWriteNode) Clone() *MatchWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Call = n.Call.Clone()
+	clone.Targets = cloneNodes(n.Targets)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MissingNode) Clone() *MissingNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ModuleNode) Clone() *ModuleNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Locals = slices.Clone(n.Locals)
+	clone.ConstantPath = cloneNode(n.ConstantPath)
+	clone.Body = cloneNode(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MultiTargetNode) Clone() *MultiTargetNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Lefts = cloneNodes(n.Lefts)
+	clone.Rest = cloneNode(n.Rest)
+	clone.Rights = cloneNodes(n.Rights)
+	clone.LparenLoc = cloneOptional(n.LparenLoc)
+	clone.RparenLoc = cloneOptional(n.RparenLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *MultiWriteNode) Clone() *MultiWriteNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Lefts = cloneNodes(n.Lefts)
+	clone.Rest = cloneNode(n.Rest)
+	clone.Rights = cloneNodes(n.Rights)
+	clone.LparenLoc = cloneOptional(n.LparenLoc)
+	clone.RparenLoc = cloneOptional(n.RparenLoc)
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *NextNode) Clone() *NextNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Arguments = n.Arguments.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *NilNode) Clone() *NilNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *NoKeywordsParameterNode) Clone() *NoKeywordsParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *NumberedParametersNode) Clone() *NumberedParametersNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *NumberedReferenceReadNode) Clone() *NumberedReferenceReadNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *OptionalKeywordParameterNode) Clone() *OptionalKeywordParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *OptionalParameterNode) Clone() *OptionalParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Value = cloneNode(n.Value)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *OrNode) Clone() *OrNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Left = cloneNode(n.Left)
+	clone.Right = cloneNode(n.Right)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ParametersNode) Clone() *ParametersNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Requireds = cloneNodes(n.Requireds)
+	clone.Optionals = cloneNodes(n.Optionals)
+	clone.Rest = cloneNode(n.Rest)
+	clone.Posts = cloneNodes(n.Posts)
+	clone.Keywords = cloneNodes(n.Keywords)
+	clone.KeywordRest = cloneNode(n.KeywordRest)
+	clone.Block = n.Block.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ParenthesesNode) Clone() *ParenthesesNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Body = cloneNode(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *PinnedExpressionNode) Clone() *PinnedExpressionNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Expression = cloneNode(n.Expression)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *PinnedVariableNode) Clone() *PinnedVariableNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Variable = cloneNode(n.Variable)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *PostExecutionNode) Clone() *PostExecutionNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *PreExecutionNode) Clone() *PreExecutionNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ProgramNode) Clone() *ProgramNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Locals = slices.Clone(n.Locals)
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RangeNode) Clone() *RangeNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Left = cloneNode(n.Left)
+	clone.Right = cloneNode(n.Right)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RationalNode) Clone() *RationalNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RedoNode) Clone() *RedoNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RegularExpressionNode) Clone() *RegularExpressionNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RequiredKeywordParameterNode) Clone() *RequiredKeywordParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RequiredParameterNode) Clone() *RequiredParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RescueModifierNode) Clone() *RescueModifierNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Expression = cloneNode(n.Expression)
+	clone.RescueExpression = cloneNode(n.RescueExpression)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RescueNode) Clone() *RescueNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Exceptions = cloneNodes(n.Exceptions)
+	clone.OperatorLoc = cloneOptional(n.OperatorLoc)
+	clone.Reference = cloneNode(n.Reference)
+	clone.ThenKeywordLoc = cloneOptional(n.ThenKeywordLoc)
+	clone.Statements = n.Statements.Clone()
+	clone.Subsequent = n.Subsequent.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RestParameterNode) Clone() *RestParameterNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Name = cloneOptional(n.Name)
+	clone.NameLoc = cloneOptional(n.NameLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *RetryNode) Clone() *RetryNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ReturnNode) Clone() *ReturnNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Arguments = n.Arguments.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SelfNode) Clone() *SelfNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *ShareableConstantNode) Clone() *ShareableConstantNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Write = cloneNode(n.Write)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SingletonClassNode) Clone() *SingletonClassNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Locals = slices.Clone(n.Locals)
+	clone.Expression = cloneNode(n.Expression)
+	clone.Body = cloneNode(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SourceEncodingNode) Clone() *SourceEncodingNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SourceFileNode) Clone() *SourceFileNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SourceLineNode) Clone() *SourceLineNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SplatNode) Clone() *SplatNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Expression = cloneNode(n.Expression)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *StatementsNode) Clone() *StatementsNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Body = cloneNodes(n.Body)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *StringNode) Clone() *StringNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SuperNode) Clone() *SuperNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.LparenLoc = cloneOptional(n.LparenLoc)
+	clone.Arguments = n.Arguments.Clone()
+	clone.RparenLoc = cloneOptional(n.RparenLoc)
+	clone.Block = cloneNode(n.Block)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *SymbolNode) Clone() *SymbolNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.OpeningLoc = cloneOptional(n.OpeningLoc)
+	clone.ValueLoc = cloneOptional(n.ValueLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *TrueNode) Clone() *TrueNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *UndefNode) Clone() *UndefNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Names = cloneNodes(n.Names)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *UnlessNode) Clone() *UnlessNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Predicate = cloneNode(n.Predicate)
+	clone.ThenKeywordLoc = cloneOptional(n.ThenKeywordLoc)
+	clone.Statements = n.Statements.Clone()
+	clone.ElseClause = n.ElseClause.Clone()
+	clone.EndKeywordLoc = cloneOptional(n.EndKeywordLoc)
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *UntilNode) Clone() *UntilNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.DoKeywordLoc = cloneOptional(n.DoKeywordLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	clone.Predicate = cloneNode(n.Predicate)
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *WhenNode) Clone() *WhenNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.Conditions = cloneNodes(n.Conditions)
+	clone.ThenKeywordLoc = cloneOptional(n.ThenKeywordLoc)
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *WhileNode) Clone() *WhileNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.DoKeywordLoc = cloneOptional(n.DoKeywordLoc)
+	clone.ClosingLoc = cloneOptional(n.ClosingLoc)
+	clone.Predicate = cloneNode(n.Predicate)
+	clone.Statements = n.Statements.Clone()
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *XStringNode) Clone() *XStringNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	return &clone
+}
+
+// Clone returns a deep copy of the node.
+func (n *YieldNode) Clone() *YieldNode {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.LparenLoc = cloneOptional(n.LparenLoc)
+	clone.Arguments = n.Arguments.Clone()
+	clone.RparenLoc = cloneOptional(n.RparenLoc)
+	return &clone
+}
+
+// cloneNode returns a deep copy of a node of any type.
+func cloneNode(node Node) Node {
+	switch n := node.(type) {
+	case *AliasGlobalVariableNode:
+		return n.Clone()
+	case *AliasMethodNode:
+		return n.Clone()
+	case *AlternationPatternNode:
+		return n.Clone()
+	case *AndNode:
+		return n.Clone()
+	case *ArgumentsNode:
+		return n.Clone()
+	case *ArrayNode:
+		return n.Clone()
+	case *ArrayPatternNode:
+		return n.Clone()
+	case *AssocNode:
+		return n.Clone()
+	case *AssocSplatNode:
+		return n.Clone()
+	case *BackReferenceReadNode:
+		return n.Clone()
+	case *BeginNode:
+		return n.Clone()
+	case *BlockArgumentNode:
+		return n.Clone()
+	case *BlockLocalVariableNode:
+		return n.Clone()
+	case *BlockNode:
+		return n.Clone()
+	case *BlockParameterNode:
+		return n.Clone()
+	case *BlockParametersNode:
+		return n.Clone()
+	case *BreakNode:
+		return n.Clone()
+	case *CallAndWriteNode:
+		return n.Clone()
+	case *CallNode:
+		return n.Clone()
+	case *CallOperatorWriteNode:
+		return n.Clone()
+	case *CallOrWriteNode:
+		return n.Clone()
+	case *CallTargetNode:
+		return n.Clone()
+	case *CapturePatternNode:
+		return n.Clone()
+	case *CaseMatchNode:
+		return n.Clone()
+	case *CaseNode:
+		return n.Clone()
+	case *ClassNode:
+		return n.Clone()
+	case *ClassVariableAndWriteNode:
+		return n.Clone()
+	case *ClassVariableOperatorWriteNode:
+		return n.Clone()
+	case *ClassVariableOrWriteNode:
+		return n.Clone()
+	case *ClassVariableReadNode:
+		return n.Clone()
+	case *ClassVariableTargetNode:
+		return n.Clone()
+	case *ClassVariableWriteNode:
+		return n.Clone()
+	case *ConstantAndWriteNode:
+		return n.Clone()
+	case *ConstantOperatorWriteNode:
+		return n.Clone()
+	case *ConstantOrWriteNode:
+		return n.Clone()
+	case *ConstantPathAndWriteNode:
+		return n.Clone()
+	case *ConstantPathNode:
+		return n.Clone()
+	case *ConstantPathOperatorWriteNode:
+		return n.Clone()
+	case *ConstantPathOrWriteNode:
+		return n.Clone()
+	case *ConstantPathTargetNode:
+		return n.Clone()
+	case *ConstantPathWriteNode:
+		return n.Clone()
+	case *ConstantReadNode:
+		return n.Clone()
+	case *ConstantTargetNode:
+		return n.Clone()
+	case *ConstantWriteNode:
+		return n.Clone()
+	case *DefNode:
+		return n.Clone()
+	case *DefinedNode:
+		return n.Clone()
+	case *ElseNode:
+		return n.Clone()
+	case *EmbeddedStatementsNode:
+		return n.Clone()
+	case *EmbeddedVariableNode:
+		return n.Clone()
+	case *EnsureNode:
+		return n.Clone()
+	case *FalseNode:
+		return n.Clone()
+	case *FindPatternNode:
+		return n.Clone()
+	case *FlipFlopNode:
+		return n.Clone()
+	case *FloatNode:
+		return n.Clone()
+	case *ForNode:
+		return n.Clone()
+	case *ForwardingArgumentsNode:
+		return n.Clone()
+	case *ForwardingParameterNode:
+		return n.Clone()
+	case *ForwardingSuperNode:
+		return n.Clone()
+	case *GlobalVariableAndWriteNode:
+		return n.Clone()
+	case *GlobalVariableOperatorWriteNode:
+		return n.Clone()
+	case *GlobalVariableOrWriteNode:
+		return n.Clone()
+	case *GlobalVariableReadNode:
+		return n.Clone()
+	case *GlobalVariableTargetNode:
+		return n.Clone()
+	case *GlobalVariableWriteNode:
+		return n.Clone()
+	case *HashNode:
+		return n.Clone()
+	case *HashPatternNode:
+		return n.Clone()
+	case *IfNode:
+		return n.Clone()
+	case *ImaginaryNode:
+		return n.Clone()
+	case *ImplicitNode:
+		return n.Clone()
+	case *ImplicitRestNode:
+		return n.Clone()
+	case *InNode:
+		return n.Clone()
+	case *IndexAndWriteNode:
+		return n.Clone()
+	case *IndexOperatorWriteNode:
+		return n.Clone()
+	case *IndexOrWriteNode:
+		return n.Clone()
+	case *IndexTargetNode:
+		return n.Clone()
+	case *InstanceVariableAndWriteNode:
+		return n.Clone()
+	case *InstanceVariableOperatorWriteNode:
+		return n.Clone()
+	case *InstanceVariableOrWriteNode:
+		return n.Clone()
+	case *InstanceVariableReadNode:
+		return n.Clone()
+	case *InstanceVariableTargetNode:
+		return n.Clone()
+	case *InstanceVariableWriteNode:
+		return n.Clone()
+	case *IntegerNode:
+		return n.Clone()
+	case *InterpolatedMatchLastLineNode:
+		return n.Clone()
+	case *InterpolatedRegularExpressionNode:
+		return n.Clone()
+	case *InterpolatedStringNode:
+		return n.Clone()
+	case *InterpolatedSymbolNode:
+		return n.Clone()
+	case *InterpolatedXStringNode:
+		return n.Clone()
+	case *ItLocalVariableReadNode:
+		return n.Clone()
+	case *ItParametersNode:
+		return n.Clone()
+	case *KeywordHashNode:
+		return n.Clone()
+	case *KeywordRestParameterNode:
+		return n.Clone()
+	case *LambdaNode:
+		return n.Clone()
+	case *LocalVariableAndWriteNode:
+		return n.Clone()
+	case *LocalVariableOperatorWriteNode:
+		return n.Clone()
+	case *LocalVariableOrWriteNode:
+		return n.Clone()
+	case *LocalVariableReadNode:
+		return n.Clone()
+	case *LocalVariableTargetNode:
+		return n.Clone()
+	case *LocalVariableWriteNode:
+		return n.Clone()
+	case *MatchLastLineNode:
+		return n.Clone()
+	case *MatchPredicateNode:
+		return n.Clone()
+	case *MatchRequiredNode:
+		return n.Clone()
+	case *MatchWriteNode:
+		return n.Clone()
+	case *MissingNode:
+		return n.Clone()
+	case *ModuleNode:
+		return n.Clone()
+	case *MultiTargetNode:
+		return n.Clone()
+	case *MultiWriteNode:
+		return n.Clone()
+	case *NextNode:
+		return n.Clone()
+	case *NilNode:
+		return n.Clone()
+	case *NoKeywordsParameterNode:
+		return n.Clone()
+	case *NumberedParametersNode:
+		return n.Clone()
+	case *NumberedReferenceReadNode:
+		return n.Clone()
+	case *OptionalKeywordParameterNode:
+		return n.Clone()
+	case *OptionalParameterNode:
+		return n.Clone()
+	case *OrNode:
+		return n.Clone()
+	case *ParametersNode:
+		return n.Clone()
+	case *ParenthesesNode:
+		return n.Clone()
+	case *PinnedExpressionNode:
+		return n.Clone()
+	case *PinnedVariableNode:
+		return n.Clone()
+	case *PostExecutionNode:
+		return n.Clone()
+	case *PreExecutionNode:
+		return n.Clone()
+	case *ProgramNode:
+		return n.Clone()
+	case *RangeNode:
+		return n.Clone()
+	case *RationalNode:
+		return n.Clone()
+	case *RedoNode:
+		return n.Clone()
+	case *RegularExpressionNode:
+		return n.Clone()
+	case *RequiredKeywordParameterNode:
+		return n.Clone()
+	case *RequiredParameterNode:
+		return n.Clone()
+	case *RescueModifierNode:
+		return n.Clone()
+	case *RescueNode:
+		return n.Clone()
+	case *RestParameterNode:
+		return n.Clone()
+	case *RetryNode:
+		return n.Clone()
+	case *ReturnNode:
+		return n.Clone()
+	case *SelfNode:
+		return n.Clone()
+	case *ShareableConstantNode:
+		return n.Clone()
+	case *SingletonClassNode:
+		return n.Clone()
+	case *SourceEncodingNode:
+		return n.Clone()
+	case *SourceFileNode:
+		return n.Clone()
+	case *SourceLineNode:
+		return n.Clone()
+	case *SplatNode:
+		return n.Clone()
+	case *StatementsNode:
+		return n.Clone()
+	case *StringNode:
+		return n.Clone()
+	case *SuperNode:
+		return n.Clone()
+	case *SymbolNode:
+		return n.Clone()
+	case *TrueNode:
+		return n.Clone()
+	case *UndefNode:
+		return n.Clone()
+	case *UnlessNode:
+		return n.Clone()
+	case *UntilNode:
+		return n.Clone()
+	case *WhenNode:
+		return n.Clone()
+	case *WhileNode:
+		return n.Clone()
+	case *XStringNode:
+		return n.Clone()
+	case *YieldNode:
+		return n.Clone()
+	}
+	return nil
+}
+
+// equalFields compares the fields of two nodes of the same type.
+func equalFields(a, b Node, o *equalOptions) bool {
+	switch x := a.(type) {
+	case *AliasGlobalVariableNode:
+		y := b.(*AliasGlobalVariableNode)
+		return o.node(x.NewName, y.NewName) &&
+			o.node(x.OldName, y.OldName) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *AliasMethodNode:
+		y := b.(*AliasMethodNode)
+		return o.node(x.NewName, y.NewName) &&
+			o.node(x.OldName, y.OldName) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *AlternationPatternNode:
+		y := b.(*AlternationPatternNode)
+		return o.node(x.Left, y.Left) &&
+			o.node(x.Right, y.Right) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *AndNode:
+		y := b.(*AndNode)
+		return o.node(x.Left, y.Left) &&
+			o.node(x.Right, y.Right) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *ArgumentsNode:
+		y := b.(*ArgumentsNode)
+		return o.nodes(x.Arguments, y.Arguments)
+	case *ArrayNode:
+		y := b.(*ArrayNode)
+		return o.nodes(x.Elements, y.Elements) &&
+			o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *ArrayPatternNode:
+		y := b.(*ArrayPatternNode)
+		return o.node(x.Constant, y.Constant) &&
+			o.nodes(x.Requireds, y.Requireds) &&
+			o.node(x.Rest, y.Rest) &&
+			o.nodes(x.Posts, y.Posts) &&
+			o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *AssocNode:
+		y := b.(*AssocNode)
+		return o.node(x.Key, y.Key) &&
+			o.node(x.Value, y.Value) &&
+			o.optionalLocation(x.OperatorLoc, y.OperatorLoc)
+	case *AssocSplatNode:
+		y := b.(*AssocSplatNode)
+		return o.node(x.Value, y.Value) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *BackReferenceReadNode:
+		y := b.(*BackReferenceReadNode)
+		return x.Name == y.Name
+	case *BeginNode:
+		y := b.(*BeginNode)
+		return o.optionalLocation(x.BeginKeywordLoc, y.BeginKeywordLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.node(x.RescueClause, y.RescueClause) &&
+			o.node(x.ElseClause, y.ElseClause) &&
+			o.node(x.EnsureClause, y.EnsureClause) &&
+			o.optionalLocation(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *BlockArgumentNode:
+		y := b.(*BlockArgumentNode)
+		return o.node(x.Expression, y.Expression) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *BlockLocalVariableNode:
+		y := b.(*BlockLocalVariableNode)
+		return x.Name == y.Name
+	case *BlockNode:
+		y := b.(*BlockNode)
+		return slices.Equal(x.Locals, y.Locals) &&
+			o.node(x.Parameters, y.Parameters) &&
+			o.node(x.Body, y.Body) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *BlockParameterNode:
+		y := b.(*BlockParameterNode)
+		return equalOptionalString(x.Name, y.Name) &&
+			o.optionalLocation(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *BlockParametersNode:
+		y := b.(*BlockParametersNode)
+		return o.node(x.Parameters, y.Parameters) &&
+			o.nodes(x.Locals, y.Locals) &&
+			o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *BreakNode:
+		y := b.(*BreakNode)
+		return o.node(x.Arguments, y.Arguments) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *CallAndWriteNode:
+		y := b.(*CallAndWriteNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			o.optionalLocation(x.MessageLoc, y.MessageLoc) &&
+			x.ReadName == y.ReadName &&
+			x.WriteName == y.WriteName &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *CallNode:
+		y := b.(*CallNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			x.Name == y.Name &&
+			o.optionalLocation(x.MessageLoc, y.MessageLoc) &&
+			o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Block, y.Block)
+	case *CallOperatorWriteNode:
+		y := b.(*CallOperatorWriteNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			o.optionalLocation(x.MessageLoc, y.MessageLoc) &&
+			x.ReadName == y.ReadName &&
+			x.WriteName == y.WriteName &&
+			x.BinaryOperator == y.BinaryOperator &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *CallOrWriteNode:
+		y := b.(*CallOrWriteNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			o.optionalLocation(x.MessageLoc, y.MessageLoc) &&
+			x.ReadName == y.ReadName &&
+			x.WriteName == y.WriteName &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *CallTargetNode:
+		y := b.(*CallTargetNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.location(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			x.Name == y.Name &&
+			o.location(x.MessageLoc, y.MessageLoc)
+	case *CapturePatternNode:
+		y := b.(*CapturePatternNode)
+		return o.node(x.Value, y.Value) &&
+			o.node(x.Target, y.Target) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *CaseMatchNode:
+		y := b.(*CaseMatchNode)
+		return o.node(x.Predicate, y.Predicate) &&
+			o.nodes(x.Conditions, y.Conditions) &&
+			o.node(x.ElseClause, y.ElseClause) &&
+			o.location(x.CaseKeywordLoc, y.CaseKeywordLoc) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *CaseNode:
+		y := b.(*CaseNode)
+		return o.node(x.Predicate, y.Predicate) &&
+			o.nodes(x.Conditions, y.Conditions) &&
+			o.node(x.ElseClause, y.ElseClause) &&
+			o.location(x.CaseKeywordLoc, y.CaseKeywordLoc) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *ClassNode:
+		y := b.(*ClassNode)
+		return slices.Equal(x.Locals, y.Locals) &&
+			o.location(x.ClassKeywordLoc, y.ClassKeywordLoc) &&
+			o.node(x.ConstantPath, y.ConstantPath) &&
+			o.optionalLocation(x.InheritanceOperatorLoc, y.InheritanceOperatorLoc) &&
+			o.node(x.Superclass, y.Superclass) &&
+			o.node(x.Body, y.Body) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc) &&
+			x.Name == y.Name
+	case *ClassVariableAndWriteNode:
+		y := b.(*ClassVariableAndWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ClassVariableOperatorWriteNode:
+		y := b.(*ClassVariableOperatorWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.BinaryOperator == y.BinaryOperator
+	case *ClassVariableOrWriteNode:
+		y := b.(*ClassVariableOrWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ClassVariableReadNode:
+		y := b.(*ClassVariableReadNode)
+		return x.Name == y.Name
+	case *ClassVariableTargetNode:
+		y := b.(*ClassVariableTargetNode)
+		return x.Name == y.Name
+	case *ClassVariableWriteNode:
+		y := b.(*ClassVariableWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Value, y.Value) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *ConstantAndWriteNode:
+		y := b.(*ConstantAndWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ConstantOperatorWriteNode:
+		y := b.(*ConstantOperatorWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.BinaryOperator == y.BinaryOperator
+	case *ConstantOrWriteNode:
+		y := b.(*ConstantOrWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ConstantPathAndWriteNode:
+		y := b.(*ConstantPathAndWriteNode)
+		return o.node(x.Target, y.Target) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ConstantPathNode:
+		y := b.(*ConstantPathNode)
+		return o.node(x.Parent, y.Parent) &&
+			equalOptionalString(x.Name, y.Name) &&
+			o.location(x.DelimiterLoc, y.DelimiterLoc) &&
+			o.location(x.NameLoc, y.NameLoc)
+	case *ConstantPathOperatorWriteNode:
+		y := b.(*ConstantPathOperatorWriteNode)
+		return o.node(x.Target, y.Target) &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.BinaryOperator == y.BinaryOperator
+	case *ConstantPathOrWriteNode:
+		y := b.(*ConstantPathOrWriteNode)
+		return o.node(x.Target, y.Target) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ConstantPathTargetNode:
+		y := b.(*ConstantPathTargetNode)
+		return o.node(x.Parent, y.Parent) &&
+			equalOptionalString(x.Name, y.Name) &&
+			o.location(x.DelimiterLoc, y.DelimiterLoc) &&
+			o.location(x.NameLoc, y.NameLoc)
+	case *ConstantPathWriteNode:
+		y := b.(*ConstantPathWriteNode)
+		return o.node(x.Target, y.Target) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *ConstantReadNode:
+		y := b.(*ConstantReadNode)
+		return x.Name == y.Name
+	case *ConstantTargetNode:
+		y := b.(*ConstantTargetNode)
+		return x.Name == y.Name
+	case *ConstantWriteNode:
+		y := b.(*ConstantWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Value, y.Value) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *DefNode:
+		y := b.(*DefNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Receiver, y.Receiver) &&
+			o.node(x.Parameters, y.Parameters) &&
+			o.node(x.Body, y.Body) &&
+			slices.Equal(x.Locals, y.Locals) &&
+			o.location(x.DefKeywordLoc, y.DefKeywordLoc) &&
+			o.optionalLocation(x.OperatorLoc, y.OperatorLoc) &&
+			o.optionalLocation(x.LparenLoc, y.LparenLoc) &&
+			o.optionalLocation(x.RparenLoc, y.RparenLoc) &&
+			o.optionalLocation(x.EqualLoc, y.EqualLoc) &&
+			o.optionalLocation(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *DefinedNode:
+		y := b.(*DefinedNode)
+		return o.optionalLocation(x.LparenLoc, y.LparenLoc) &&
+			o.node(x.Value, y.Value) &&
+			o.optionalLocation(x.RparenLoc, y.RparenLoc) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *ElseNode:
+		y := b.(*ElseNode)
+		return o.location(x.ElseKeywordLoc, y.ElseKeywordLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.optionalLocation(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *EmbeddedStatementsNode:
+		y := b.(*EmbeddedStatementsNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *EmbeddedVariableNode:
+		y := b.(*EmbeddedVariableNode)
+		return o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Variable, y.Variable)
+	case *EnsureNode:
+		y := b.(*EnsureNode)
+		return o.location(x.EnsureKeywordLoc, y.EnsureKeywordLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *FalseNode:
+		return true
+	case *FindPatternNode:
+		y := b.(*FindPatternNode)
+		return o.node(x.Constant, y.Constant) &&
+			o.node(x.Left, y.Left) &&
+			o.nodes(x.Requireds, y.Requireds) &&
+			o.node(x.Right, y.Right) &&
+			o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *FlipFlopNode:
+		y := b.(*FlipFlopNode)
+		return o.node(x.Left, y.Left) &&
+			o.node(x.Right, y.Right) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *FloatNode:
+		y := b.(*FloatNode)
+		return x.Value == y.Value
+	case *ForNode:
+		y := b.(*ForNode)
+		return o.node(x.Index, y.Index) &&
+			o.node(x.Collection, y.Collection) &&
+			o.node(x.Statements, y.Statements) &&
+			o.location(x.ForKeywordLoc, y.ForKeywordLoc) &&
+			o.location(x.InKeywordLoc, y.InKeywordLoc) &&
+			o.optionalLocation(x.DoKeywordLoc, y.DoKeywordLoc) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *ForwardingArgumentsNode:
+		return true
+	case *ForwardingParameterNode:
+		return true
+	case *ForwardingSuperNode:
+		y := b.(*ForwardingSuperNode)
+		return o.node(x.Block, y.Block)
+	case *GlobalVariableAndWriteNode:
+		y := b.(*GlobalVariableAndWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *GlobalVariableOperatorWriteNode:
+		y := b.(*GlobalVariableOperatorWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.BinaryOperator == y.BinaryOperator
+	case *GlobalVariableOrWriteNode:
+		y := b.(*GlobalVariableOrWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *GlobalVariableReadNode:
+		y := b.(*GlobalVariableReadNode)
+		return x.Name == y.Name
+	case *GlobalVariableTargetNode:
+		y := b.(*GlobalVariableTargetNode)
+		return x.Name == y.Name
+	case *GlobalVariableWriteNode:
+		y := b.(*GlobalVariableWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Value, y.Value) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *HashNode:
+		y := b.(*HashNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.nodes(x.Elements, y.Elements) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *HashPatternNode:
+		y := b.(*HashPatternNode)
+		return o.node(x.Constant, y.Constant) &&
+			o.nodes(x.Elements, y.Elements) &&
+			o.node(x.Rest, y.Rest) &&
+			o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *IfNode:
+		y := b.(*IfNode)
+		return o.optionalLocation(x.IfKeywordLoc, y.IfKeywordLoc) &&
+			o.node(x.Predicate, y.Predicate) &&
+			o.optionalLocation(x.ThenKeywordLoc, y.ThenKeywordLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.node(x.Subsequent, y.Subsequent) &&
+			o.optionalLocation(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *ImaginaryNode:
+		y := b.(*ImaginaryNode)
+		return o.node(x.Numeric, y.Numeric)
+	case *ImplicitNode:
+		y := b.(*ImplicitNode)
+		return o.node(x.Value, y.Value)
+	case *ImplicitRestNode:
+		return true
+	case *InNode:
+		y := b.(*InNode)
+		return o.node(x.Pattern, y.Pattern) &&
+			o.node(x.Statements, y.Statements) &&
+			o.location(x.InLoc, y.InLoc) &&
+			o.optionalLocation(x.ThenLoc, y.ThenLoc)
+	case *IndexAndWriteNode:
+		y := b.(*IndexAndWriteNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Block, y.Block) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *IndexOperatorWriteNode:
+		y := b.(*IndexOperatorWriteNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Block, y.Block) &&
+			x.BinaryOperator == y.BinaryOperator &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *IndexOrWriteNode:
+		y := b.(*IndexOrWriteNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.optionalLocation(x.CallOperatorLoc, y.CallOperatorLoc) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Block, y.Block) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *IndexTargetNode:
+		y := b.(*IndexTargetNode)
+		return o.node(x.Receiver, y.Receiver) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Block, y.Block)
+	case *InstanceVariableAndWriteNode:
+		y := b.(*InstanceVariableAndWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *InstanceVariableOperatorWriteNode:
+		y := b.(*InstanceVariableOperatorWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.BinaryOperator == y.BinaryOperator
+	case *InstanceVariableOrWriteNode:
+		y := b.(*InstanceVariableOrWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *InstanceVariableReadNode:
+		y := b.(*InstanceVariableReadNode)
+		return x.Name == y.Name
+	case *InstanceVariableTargetNode:
+		y := b.(*InstanceVariableTargetNode)
+		return x.Name == y.Name
+	case *InstanceVariableWriteNode:
+		y := b.(*InstanceVariableWriteNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Value, y.Value) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *IntegerNode:
+		y := b.(*IntegerNode)
+		return x.Value == y.Value
+	case *InterpolatedMatchLastLineNode:
+		y := b.(*InterpolatedMatchLastLineNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.nodes(x.Parts, y.Parts) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *InterpolatedRegularExpressionNode:
+		y := b.(*InterpolatedRegularExpressionNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.nodes(x.Parts, y.Parts) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *InterpolatedStringNode:
+		y := b.(*InterpolatedStringNode)
+		return o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.nodes(x.Parts, y.Parts) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *InterpolatedSymbolNode:
+		y := b.(*InterpolatedSymbolNode)
+		return o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.nodes(x.Parts, y.Parts) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc)
+	case *InterpolatedXStringNode:
+		y := b.(*InterpolatedXStringNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.nodes(x.Parts, y.Parts) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *ItLocalVariableReadNode:
+		return true
+	case *ItParametersNode:
+		return true
+	case *KeywordHashNode:
+		y := b.(*KeywordHashNode)
+		return o.nodes(x.Elements, y.Elements)
+	case *KeywordRestParameterNode:
+		y := b.(*KeywordRestParameterNode)
+		return equalOptionalString(x.Name, y.Name) &&
+			o.optionalLocation(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *LambdaNode:
+		y := b.(*LambdaNode)
+		return slices.Equal(x.Locals, y.Locals) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Parameters, y.Parameters) &&
+			o.node(x.Body, y.Body)
+	case *LocalVariableAndWriteNode:
+		y := b.(*LocalVariableAndWriteNode)
+		return o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.Name == y.Name &&
+			x.Depth == y.Depth
+	case *LocalVariableOperatorWriteNode:
+		y := b.(*LocalVariableOperatorWriteNode)
+		return o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.BinaryOperatorLoc, y.BinaryOperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.Name == y.Name &&
+			x.BinaryOperator == y.BinaryOperator &&
+			x.Depth == y.Depth
+	case *LocalVariableOrWriteNode:
+		y := b.(*LocalVariableOrWriteNode)
+		return o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value) &&
+			x.Name == y.Name &&
+			x.Depth == y.Depth
+	case *LocalVariableReadNode:
+		y := b.(*LocalVariableReadNode)
+		return x.Name == y.Name &&
+			x.Depth == y.Depth
+	case *LocalVariableTargetNode:
+		y := b.(*LocalVariableTargetNode)
+		return x.Name == y.Name &&
+			x.Depth == y.Depth
+	case *LocalVariableWriteNode:
+		y := b.(*LocalVariableWriteNode)
+		return x.Name == y.Name &&
+			x.Depth == y.Depth &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Value, y.Value) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *MatchLastLineNode:
+		y := b.(*MatchLastLineNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ContentLoc, y.ContentLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			x.Unescaped == y.Unescaped
+	case *MatchPredicateNode:
+		y := b.(*MatchPredicateNode)
+		return o.node(x.Value, y.Value) &&
+			o.node(x.Pattern, y.Pattern) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *MatchRequiredNode:
+		y := b.(*MatchRequiredNode)
+		return o.node(x.Value, y.Value) &&
+			o.node(x.Pattern, y.Pattern) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *MatchWriteNode:
+		y := b.(*MatchWriteNode)
+		return o.node(x.Call, y.Call) &&
+			o.nodes(x.Targets, y.Targets)
+	case *MissingNode:
+		return true
+	case *ModuleNode:
+		y := b.(*ModuleNode)
+		return slices.Equal(x.Locals, y.Locals) &&
+			o.location(x.ModuleKeywordLoc, y.ModuleKeywordLoc) &&
+			o.node(x.ConstantPath, y.ConstantPath) &&
+			o.node(x.Body, y.Body) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc) &&
+			x.Name == y.Name
+	case *MultiTargetNode:
+		y := b.(*MultiTargetNode)
+		return o.nodes(x.Lefts, y.Lefts) &&
+			o.node(x.Rest, y.Rest) &&
+			o.nodes(x.Rights, y.Rights) &&
+			o.optionalLocation(x.LparenLoc, y.LparenLoc) &&
+			o.optionalLocation(x.RparenLoc, y.RparenLoc)
+	case *MultiWriteNode:
+		y := b.(*MultiWriteNode)
+		return o.nodes(x.Lefts, y.Lefts) &&
+			o.node(x.Rest, y.Rest) &&
+			o.nodes(x.Rights, y.Rights) &&
+			o.optionalLocation(x.LparenLoc, y.LparenLoc) &&
+			o.optionalLocation(x.RparenLoc, y.RparenLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *NextNode:
+		y := b.(*NextNode)
+		return o.node(x.Arguments, y.Arguments) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *NilNode:
+		return true
+	case *NoKeywordsParameterNode:
+		y := b.(*NoKeywordsParameterNode)
+		return o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *NumberedParametersNode:
+		y := b.(*NumberedParametersNode)
+		return x.Maximum == y.Maximum
+	case *NumberedReferenceReadNode:
+		y := b.(*NumberedReferenceReadNode)
+		return x.Number == y.Number
+	case *OptionalKeywordParameterNode:
+		y := b.(*OptionalKeywordParameterNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.node(x.Value, y.Value)
+	case *OptionalParameterNode:
+		y := b.(*OptionalParameterNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Value, y.Value)
+	case *OrNode:
+		y := b.(*OrNode)
+		return o.node(x.Left, y.Left) &&
+			o.node(x.Right, y.Right) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *ParametersNode:
+		y := b.(*ParametersNode)
+		return o.nodes(x.Requireds, y.Requireds) &&
+			o.nodes(x.Optionals, y.Optionals) &&
+			o.node(x.Rest, y.Rest) &&
+			o.nodes(x.Posts, y.Posts) &&
+			o.nodes(x.Keywords, y.Keywords) &&
+			o.node(x.KeywordRest, y.KeywordRest) &&
+			o.node(x.Block, y.Block)
+	case *ParenthesesNode:
+		y := b.(*ParenthesesNode)
+		return o.node(x.Body, y.Body) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *PinnedExpressionNode:
+		y := b.(*PinnedExpressionNode)
+		return o.node(x.Expression, y.Expression) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.location(x.LparenLoc, y.LparenLoc) &&
+			o.location(x.RparenLoc, y.RparenLoc)
+	case *PinnedVariableNode:
+		y := b.(*PinnedVariableNode)
+		return o.node(x.Variable, y.Variable) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *PostExecutionNode:
+		y := b.(*PostExecutionNode)
+		return o.node(x.Statements, y.Statements) &&
+			o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *PreExecutionNode:
+		y := b.(*PreExecutionNode)
+		return o.node(x.Statements, y.Statements) &&
+			o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc)
+	case *ProgramNode:
+		y := b.(*ProgramNode)
+		return slices.Equal(x.Locals, y.Locals) &&
+			o.node(x.Statements, y.Statements)
+	case *RangeNode:
+		y := b.(*RangeNode)
+		return o.node(x.Left, y.Left) &&
+			o.node(x.Right, y.Right) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *RationalNode:
+		y := b.(*RationalNode)
+		return x.Numerator == y.Numerator &&
+			x.Denominator == y.Denominator
+	case *RedoNode:
+		return true
+	case *RegularExpressionNode:
+		y := b.(*RegularExpressionNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ContentLoc, y.ContentLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			x.Unescaped == y.Unescaped
+	case *RequiredKeywordParameterNode:
+		y := b.(*RequiredKeywordParameterNode)
+		return x.Name == y.Name &&
+			o.location(x.NameLoc, y.NameLoc)
+	case *RequiredParameterNode:
+		y := b.(*RequiredParameterNode)
+		return x.Name == y.Name
+	case *RescueModifierNode:
+		y := b.(*RescueModifierNode)
+		return o.node(x.Expression, y.Expression) &&
+			o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.node(x.RescueExpression, y.RescueExpression)
+	case *RescueNode:
+		y := b.(*RescueNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.nodes(x.Exceptions, y.Exceptions) &&
+			o.optionalLocation(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Reference, y.Reference) &&
+			o.optionalLocation(x.ThenKeywordLoc, y.ThenKeywordLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.node(x.Subsequent, y.Subsequent)
+	case *RestParameterNode:
+		y := b.(*RestParameterNode)
+		return equalOptionalString(x.Name, y.Name) &&
+			o.optionalLocation(x.NameLoc, y.NameLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc)
+	case *RetryNode:
+		return true
+	case *ReturnNode:
+		y := b.(*ReturnNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.node(x.Arguments, y.Arguments)
+	case *SelfNode:
+		return true
+	case *ShareableConstantNode:
+		y := b.(*ShareableConstantNode)
+		return o.node(x.Write, y.Write)
+	case *SingletonClassNode:
+		y := b.(*SingletonClassNode)
+		return slices.Equal(x.Locals, y.Locals) &&
+			o.location(x.ClassKeywordLoc, y.ClassKeywordLoc) &&
+			o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Expression, y.Expression) &&
+			o.node(x.Body, y.Body) &&
+			o.location(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *SourceEncodingNode:
+		return true
+	case *SourceFileNode:
+		y := b.(*SourceFileNode)
+		return x.Filepath == y.Filepath
+	case *SourceLineNode:
+		return true
+	case *SplatNode:
+		y := b.(*SplatNode)
+		return o.location(x.OperatorLoc, y.OperatorLoc) &&
+			o.node(x.Expression, y.Expression)
+	case *StatementsNode:
+		y := b.(*StatementsNode)
+		return o.nodes(x.Body, y.Body)
+	case *StringNode:
+		y := b.(*StringNode)
+		return o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ContentLoc, y.ContentLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc) &&
+			x.Unescaped == y.Unescaped
+	case *SuperNode:
+		y := b.(*SuperNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.optionalLocation(x.LparenLoc, y.LparenLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.optionalLocation(x.RparenLoc, y.RparenLoc) &&
+			o.node(x.Block, y.Block)
+	case *SymbolNode:
+		y := b.(*SymbolNode)
+		return o.optionalLocation(x.OpeningLoc, y.OpeningLoc) &&
+			o.optionalLocation(x.ValueLoc, y.ValueLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc) &&
+			x.Unescaped == y.Unescaped
+	case *TrueNode:
+		return true
+	case *UndefNode:
+		y := b.(*UndefNode)
+		return o.nodes(x.Names, y.Names) &&
+			o.location(x.KeywordLoc, y.KeywordLoc)
+	case *UnlessNode:
+		y := b.(*UnlessNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.node(x.Predicate, y.Predicate) &&
+			o.optionalLocation(x.ThenKeywordLoc, y.ThenKeywordLoc) &&
+			o.node(x.Statements, y.Statements) &&
+			o.node(x.ElseClause, y.ElseClause) &&
+			o.optionalLocation(x.EndKeywordLoc, y.EndKeywordLoc)
+	case *UntilNode:
+		y := b.(*UntilNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.optionalLocation(x.DoKeywordLoc, y.DoKeywordLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Predicate, y.Predicate) &&
+			o.node(x.Statements, y.Statements)
+	case *WhenNode:
+		y := b.(*WhenNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.nodes(x.Conditions, y.Conditions) &&
+			o.optionalLocation(x.ThenKeywordLoc, y.ThenKeywordLoc) &&
+			o.node(x.Statements, y.Statements)
+	case *WhileNode:
+		y := b.(*WhileNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.optionalLocation(x.DoKeywordLoc, y.DoKeywordLoc) &&
+			o.optionalLocation(x.ClosingLoc, y.ClosingLoc) &&
+			o.node(x.Predicate, y.Predicate) &&
+			o.node(x.Statements, y.Statements)
+	case *XStringNode:
+		y := b.(*XStringNode)
+		return o.location(x.OpeningLoc, y.OpeningLoc) &&
+			o.location(x.ContentLoc, y.ContentLoc) &&
+			o.location(x.ClosingLoc, y.ClosingLoc) &&
+			x.Unescaped == y.Unescaped
+	case *YieldNode:
+		y := b.(*YieldNode)
+		return o.location(x.KeywordLoc, y.KeywordLoc) &&
+			o.optionalLocation(x.LparenLoc, y.LparenLoc) &&
+			o.node(x.Arguments, y.Arguments) &&
+			o.optionalLocation(x.RparenLoc, y.RparenLoc)
+	}
+	return false
+}
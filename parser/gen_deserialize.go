@@ -59,6 +59,7 @@ type ParseResult struct {
 	DataLoc       *Location      `json:"dataLoc"`
 	Errors        []ParseError   `json:"errors"`
 	Warnings      []ParseWarning `json:"warnings"`
+	Source        *Source        `json:"-"`
 }
 
 // SerializationBuffer handles reading from the serialized binary format.
@@ -135,6 +136,12 @@ func (b *SerializationBuffer) ReadVarInt() int {
 	return result
 }
 
+// ReadVarSInt reads a zigzag-encoded variable-length signed integer.
+func (b *SerializationBuffer) ReadVarSInt() int {
+	value := b.ReadVarInt()
+	return (value >> 1) ^ -(value & 1)
+}
+
 // ReadLocation reads a location from the buffer.
 func (b *SerializationBuffer) ReadLocation() Location {
 	return Location{
@@ -583,14 +590,17 @@ func Deserialize(source, array []byte) (*ParseResult, error) {
 	encodingLength := buffer.ReadVarInt()
 	buffer.fileEncoding = buffer.ReadString(encodingLength, 0)
 
-	// Skip start line
-	buffer.ReadVarInt()
+	// Read start line
+	src := NewSource(source)
+	src.SetStartLine(buffer.ReadVarSInt())
 
-	// Skip line offsets
+	// Read line offsets
 	lineOffsetsCount := buffer.ReadVarInt()
+	lineOffsets := make([]int, lineOffsetsCount)
 	for i := 0; i < lineOffsetsCount; i++ {
-		buffer.ReadVarInt()
+		lineOffsets[i] = buffer.ReadVarInt()
 	}
+	src.SetLineOffsets(lineOffsets)
 
 	// Read comments
 	commentsCount := buffer.ReadVarInt()
@@ -682,6 +692,7 @@ func Deserialize(source, array []byte) (*ParseResult, error) {
 		DataLoc:       dataLoc,
 		Errors:        errors,
 		Warnings:      warnings,
+		Source:        src,
 	}, nil
 }
 
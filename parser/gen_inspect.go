@@ -0,0 +1,1581 @@
+/*----------------------------------------------------------------------------*/
+/* This file is generated by the templates/template.rb script and should not  */
+/* be modified manually. See                                                  */
+/* templates/../../templates/gen_inspect.go.erb                               */
+/* if you are looking to modify the                                           */
+/* template                                                                   */
+/*----------------------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+)
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *AliasGlobalVariableNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *AliasMethodNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *AlternationPatternNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *AndNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ArgumentsNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ArrayNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ArrayPatternNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *AssocNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *AssocSplatNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BackReferenceReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BeginNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BlockArgumentNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BlockLocalVariableNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BlockNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BlockParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BlockParametersNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *BreakNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CallAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CallNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CallOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CallOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CallTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CapturePatternNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CaseMatchNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *CaseNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassVariableAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassVariableOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassVariableOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassVariableReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassVariableTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ClassVariableWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantPathAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantPathNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantPathOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantPathOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantPathTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantPathWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ConstantWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *DefNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *DefinedNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ElseNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *EmbeddedStatementsNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *EmbeddedVariableNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *EnsureNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *FalseNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *FindPatternNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *FlipFlopNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *FloatNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ForNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ForwardingArgumentsNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ForwardingParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ForwardingSuperNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *GlobalVariableAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *GlobalVariableOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *GlobalVariableOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *GlobalVariableReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *GlobalVariableTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *GlobalVariableWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *HashNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *HashPatternNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *IfNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ImaginaryNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ImplicitNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ImplicitRestNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *IndexAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *IndexOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *IndexOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *IndexTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InstanceVariableAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InstanceVariableOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InstanceVariableOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InstanceVariableReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InstanceVariableTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InstanceVariableWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *IntegerNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InterpolatedMatchLastLineNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InterpolatedRegularExpressionNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InterpolatedStringNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InterpolatedSymbolNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *InterpolatedXStringNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ItLocalVariableReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ItParametersNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *KeywordHashNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *KeywordRestParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LambdaNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LocalVariableAndWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LocalVariableOperatorWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LocalVariableOrWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LocalVariableReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LocalVariableTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *LocalVariableWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MatchLastLineNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MatchPredicateNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MatchRequiredNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MatchWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MissingNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ModuleNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MultiTargetNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *MultiWriteNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *NextNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *NilNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *NoKeywordsParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *NumberedParametersNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *NumberedReferenceReadNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *OptionalKeywordParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *OptionalParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *OrNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ParametersNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ParenthesesNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *PinnedExpressionNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *PinnedVariableNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *PostExecutionNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *PreExecutionNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ProgramNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RangeNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RationalNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RedoNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RegularExpressionNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RequiredKeywordParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RequiredParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RescueModifierNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RescueNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RestParameterNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *RetryNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ReturnNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SelfNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *ShareableConstantNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SingletonClassNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SourceEncodingNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SourceFileNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SourceLineNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SplatNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *StatementsNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *StringNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SuperNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *SymbolNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *TrueNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *UndefNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *UnlessNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *UntilNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *WhenNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *WhileNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *XStringNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// Format implements fmt.Formatter, printing the node as with Inspect.
+func (n *YieldNode) Format(f fmt.State, verb rune) {
+	formatNode(n, f, verb)
+}
+
+// inspectFields writes the fields of the node, in the order of the prism config.
+func inspectFields(i *inspector, node Node, indent string) {
+	switch n := node.(type) {
+	case *AliasGlobalVariableNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "new_name", n.NewName)
+		i.child(indent, false, "old_name", n.OldName)
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *AliasMethodNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "new_name", n.NewName)
+		i.child(indent, false, "old_name", n.OldName)
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *AlternationPatternNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "left", n.Left)
+		i.child(indent, false, "right", n.Right)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *AndNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "left", n.Left)
+		i.child(indent, false, "right", n.Right)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *ArgumentsNode:
+		i.flags(indent, false, n.flags, argumentsNodeFlagsInfo)
+		i.list(indent, true, "arguments", n.Arguments)
+	case *ArrayNode:
+		i.flags(indent, false, n.flags, arrayNodeFlagsInfo)
+		i.list(indent, false, "elements", n.Elements)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *ArrayPatternNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "constant", n.Constant)
+		i.list(indent, false, "requireds", n.Requireds)
+		i.child(indent, false, "rest", n.Rest)
+		i.list(indent, false, "posts", n.Posts)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *AssocNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "key", n.Key)
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(n.OperatorLoc))
+	case *AssocSplatNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *BackReferenceReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *BeginNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "begin_keyword_loc", i.location(n.BeginKeywordLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.child(indent, false, "rescue_clause", nodeOrNil(n.RescueClause))
+		i.child(indent, false, "else_clause", nodeOrNil(n.ElseClause))
+		i.child(indent, false, "ensure_clause", nodeOrNil(n.EnsureClause))
+		i.field(indent, true, "end_keyword_loc", i.location(n.EndKeywordLoc))
+	case *BlockArgumentNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "expression", n.Expression)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *BlockLocalVariableNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *BlockNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.child(indent, false, "parameters", n.Parameters)
+		i.child(indent, false, "body", n.Body)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *BlockParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, false, "name", inspectOptionalSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(n.NameLoc))
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *BlockParametersNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "parameters", nodeOrNil(n.Parameters))
+		i.list(indent, false, "locals", n.Locals)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *BreakNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *CallAndWriteNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "message_loc", i.location(n.MessageLoc))
+		i.field(indent, false, "read_name", ruby.InspectSymbol(n.ReadName))
+		i.field(indent, false, "write_name", ruby.InspectSymbol(n.WriteName))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *CallNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "message_loc", i.location(n.MessageLoc))
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, false, "closing_loc", i.location(n.ClosingLoc))
+		i.child(indent, true, "block", n.Block)
+	case *CallOperatorWriteNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "message_loc", i.location(n.MessageLoc))
+		i.field(indent, false, "read_name", ruby.InspectSymbol(n.ReadName))
+		i.field(indent, false, "write_name", ruby.InspectSymbol(n.WriteName))
+		i.field(indent, false, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *CallOrWriteNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "message_loc", i.location(n.MessageLoc))
+		i.field(indent, false, "read_name", ruby.InspectSymbol(n.ReadName))
+		i.field(indent, false, "write_name", ruby.InspectSymbol(n.WriteName))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *CallTargetNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(&n.CallOperatorLoc))
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, true, "message_loc", i.location(&n.MessageLoc))
+	case *CapturePatternNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "value", n.Value)
+		i.child(indent, false, "target", nodeOrNil(n.Target))
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *CaseMatchNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "predicate", n.Predicate)
+		i.list(indent, false, "conditions", n.Conditions)
+		i.child(indent, false, "else_clause", nodeOrNil(n.ElseClause))
+		i.field(indent, false, "case_keyword_loc", i.location(&n.CaseKeywordLoc))
+		i.field(indent, true, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+	case *CaseNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "predicate", n.Predicate)
+		i.list(indent, false, "conditions", n.Conditions)
+		i.child(indent, false, "else_clause", nodeOrNil(n.ElseClause))
+		i.field(indent, false, "case_keyword_loc", i.location(&n.CaseKeywordLoc))
+		i.field(indent, true, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+	case *ClassNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.field(indent, false, "class_keyword_loc", i.location(&n.ClassKeywordLoc))
+		i.child(indent, false, "constant_path", n.ConstantPath)
+		i.field(indent, false, "inheritance_operator_loc", i.location(n.InheritanceOperatorLoc))
+		i.child(indent, false, "superclass", n.Superclass)
+		i.child(indent, false, "body", n.Body)
+		i.field(indent, false, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *ClassVariableAndWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ClassVariableOperatorWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+	case *ClassVariableOrWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ClassVariableReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *ClassVariableTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *ClassVariableWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *ConstantAndWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ConstantOperatorWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+	case *ConstantOrWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ConstantPathAndWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "target", nodeOrNil(n.Target))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ConstantPathNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "parent", n.Parent)
+		i.field(indent, false, "name", inspectOptionalSymbol(n.Name))
+		i.field(indent, false, "delimiter_loc", i.location(&n.DelimiterLoc))
+		i.field(indent, true, "name_loc", i.location(&n.NameLoc))
+	case *ConstantPathOperatorWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "target", nodeOrNil(n.Target))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+	case *ConstantPathOrWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "target", nodeOrNil(n.Target))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ConstantPathTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "parent", n.Parent)
+		i.field(indent, false, "name", inspectOptionalSymbol(n.Name))
+		i.field(indent, false, "delimiter_loc", i.location(&n.DelimiterLoc))
+		i.field(indent, true, "name_loc", i.location(&n.NameLoc))
+	case *ConstantPathWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "target", nodeOrNil(n.Target))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *ConstantReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *ConstantTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *ConstantWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *DefNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, false, "receiver", n.Receiver)
+		i.child(indent, false, "parameters", nodeOrNil(n.Parameters))
+		i.child(indent, false, "body", n.Body)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.field(indent, false, "def_keyword_loc", i.location(&n.DefKeywordLoc))
+		i.field(indent, false, "operator_loc", i.location(n.OperatorLoc))
+		i.field(indent, false, "lparen_loc", i.location(n.LparenLoc))
+		i.field(indent, false, "rparen_loc", i.location(n.RparenLoc))
+		i.field(indent, false, "equal_loc", i.location(n.EqualLoc))
+		i.field(indent, true, "end_keyword_loc", i.location(n.EndKeywordLoc))
+	case *DefinedNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "lparen_loc", i.location(n.LparenLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, false, "rparen_loc", i.location(n.RparenLoc))
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *ElseNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "else_keyword_loc", i.location(&n.ElseKeywordLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, true, "end_keyword_loc", i.location(n.EndKeywordLoc))
+	case *EmbeddedStatementsNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *EmbeddedVariableNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "variable", n.Variable)
+	case *EnsureNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "ensure_keyword_loc", i.location(&n.EnsureKeywordLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, true, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+	case *FalseNode:
+		i.flags(indent, true, n.flags, nil)
+	case *FindPatternNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "constant", n.Constant)
+		i.child(indent, false, "left", nodeOrNil(n.Left))
+		i.list(indent, false, "requireds", n.Requireds)
+		i.child(indent, false, "right", n.Right)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *FlipFlopNode:
+		i.flags(indent, false, n.flags, rangeFlagsInfo)
+		i.child(indent, false, "left", n.Left)
+		i.child(indent, false, "right", n.Right)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *FloatNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "value", ruby.InspectFloat(n.Value))
+	case *ForNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "index", n.Index)
+		i.child(indent, false, "collection", n.Collection)
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, false, "for_keyword_loc", i.location(&n.ForKeywordLoc))
+		i.field(indent, false, "in_keyword_loc", i.location(&n.InKeywordLoc))
+		i.field(indent, false, "do_keyword_loc", i.location(n.DoKeywordLoc))
+		i.field(indent, true, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+	case *ForwardingArgumentsNode:
+		i.flags(indent, true, n.flags, nil)
+	case *ForwardingParameterNode:
+		i.flags(indent, true, n.flags, nil)
+	case *ForwardingSuperNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, true, "block", nodeOrNil(n.Block))
+	case *GlobalVariableAndWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *GlobalVariableOperatorWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+	case *GlobalVariableOrWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *GlobalVariableReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *GlobalVariableTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *GlobalVariableWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *HashNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.list(indent, false, "elements", n.Elements)
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *HashPatternNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "constant", n.Constant)
+		i.list(indent, false, "elements", n.Elements)
+		i.child(indent, false, "rest", n.Rest)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *IfNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "if_keyword_loc", i.location(n.IfKeywordLoc))
+		i.child(indent, false, "predicate", n.Predicate)
+		i.field(indent, false, "then_keyword_loc", i.location(n.ThenKeywordLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.child(indent, false, "subsequent", n.Subsequent)
+		i.field(indent, true, "end_keyword_loc", i.location(n.EndKeywordLoc))
+	case *ImaginaryNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, true, "numeric", n.Numeric)
+	case *ImplicitNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, true, "value", n.Value)
+	case *ImplicitRestNode:
+		i.flags(indent, true, n.flags, nil)
+	case *InNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "pattern", n.Pattern)
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, false, "in_loc", i.location(&n.InLoc))
+		i.field(indent, true, "then_loc", i.location(n.ThenLoc))
+	case *IndexAndWriteNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.child(indent, false, "block", nodeOrNil(n.Block))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *IndexOperatorWriteNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.child(indent, false, "block", nodeOrNil(n.Block))
+		i.field(indent, false, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *IndexOrWriteNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "call_operator_loc", i.location(n.CallOperatorLoc))
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.child(indent, false, "block", nodeOrNil(n.Block))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *IndexTargetNode:
+		i.flags(indent, false, n.flags, callNodeFlagsInfo)
+		i.child(indent, false, "receiver", n.Receiver)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.child(indent, true, "block", nodeOrNil(n.Block))
+	case *InstanceVariableAndWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *InstanceVariableOperatorWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+	case *InstanceVariableOrWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *InstanceVariableReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *InstanceVariableTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *InstanceVariableWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *IntegerNode:
+		i.flags(indent, false, n.flags, integerBaseFlagsInfo)
+		i.field(indent, true, "value", strconv.FormatInt(n.Value, 10))
+	case *InterpolatedMatchLastLineNode:
+		i.flags(indent, false, n.flags, regularExpressionFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.list(indent, false, "parts", n.Parts)
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *InterpolatedRegularExpressionNode:
+		i.flags(indent, false, n.flags, regularExpressionFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.list(indent, false, "parts", n.Parts)
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *InterpolatedStringNode:
+		i.flags(indent, false, n.flags, interpolatedStringNodeFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.list(indent, false, "parts", n.Parts)
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *InterpolatedSymbolNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.list(indent, false, "parts", n.Parts)
+		i.field(indent, true, "closing_loc", i.location(n.ClosingLoc))
+	case *InterpolatedXStringNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.list(indent, false, "parts", n.Parts)
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *ItLocalVariableReadNode:
+		i.flags(indent, true, n.flags, nil)
+	case *ItParametersNode:
+		i.flags(indent, true, n.flags, nil)
+	case *KeywordHashNode:
+		i.flags(indent, false, n.flags, keywordHashNodeFlagsInfo)
+		i.list(indent, true, "elements", n.Elements)
+	case *KeywordRestParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, false, "name", inspectOptionalSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(n.NameLoc))
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *LambdaNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.child(indent, false, "parameters", n.Parameters)
+		i.child(indent, true, "body", n.Body)
+	case *LocalVariableAndWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, true, "depth", strconv.FormatUint(uint64(n.Depth), 10))
+	case *LocalVariableOperatorWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "binary_operator_loc", i.location(&n.BinaryOperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "binary_operator", ruby.InspectSymbol(n.BinaryOperator))
+		i.field(indent, true, "depth", strconv.FormatUint(uint64(n.Depth), 10))
+	case *LocalVariableOrWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, true, "depth", strconv.FormatUint(uint64(n.Depth), 10))
+	case *LocalVariableReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, true, "depth", strconv.FormatUint(uint64(n.Depth), 10))
+	case *LocalVariableTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, true, "depth", strconv.FormatUint(uint64(n.Depth), 10))
+	case *LocalVariableWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "depth", strconv.FormatUint(uint64(n.Depth), 10))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, false, "value", n.Value)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *MatchLastLineNode:
+		i.flags(indent, false, n.flags, regularExpressionFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, false, "content_loc", i.location(&n.ContentLoc))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.field(indent, true, "unescaped", inspectRubyString(n.Unescaped))
+	case *MatchPredicateNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "value", n.Value)
+		i.child(indent, false, "pattern", n.Pattern)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *MatchRequiredNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "value", n.Value)
+		i.child(indent, false, "pattern", n.Pattern)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *MatchWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "call", nodeOrNil(n.Call))
+		i.list(indent, true, "targets", n.Targets)
+	case *MissingNode:
+		i.flags(indent, true, n.flags, nil)
+	case *ModuleNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.field(indent, false, "module_keyword_loc", i.location(&n.ModuleKeywordLoc))
+		i.child(indent, false, "constant_path", n.ConstantPath)
+		i.child(indent, false, "body", n.Body)
+		i.field(indent, false, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *MultiTargetNode:
+		i.flags(indent, false, n.flags, nil)
+		i.list(indent, false, "lefts", n.Lefts)
+		i.child(indent, false, "rest", n.Rest)
+		i.list(indent, false, "rights", n.Rights)
+		i.field(indent, false, "lparen_loc", i.location(n.LparenLoc))
+		i.field(indent, true, "rparen_loc", i.location(n.RparenLoc))
+	case *MultiWriteNode:
+		i.flags(indent, false, n.flags, nil)
+		i.list(indent, false, "lefts", n.Lefts)
+		i.child(indent, false, "rest", n.Rest)
+		i.list(indent, false, "rights", n.Rights)
+		i.field(indent, false, "lparen_loc", i.location(n.LparenLoc))
+		i.field(indent, false, "rparen_loc", i.location(n.RparenLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *NextNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *NilNode:
+		i.flags(indent, true, n.flags, nil)
+	case *NoKeywordsParameterNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *NumberedParametersNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "maximum", strconv.FormatUint(uint64(n.Maximum), 10))
+	case *NumberedReferenceReadNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, true, "number", strconv.FormatUint(uint64(n.Number), 10))
+	case *OptionalKeywordParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.child(indent, true, "value", n.Value)
+	case *OptionalParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(&n.NameLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "value", n.Value)
+	case *OrNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "left", n.Left)
+		i.child(indent, false, "right", n.Right)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *ParametersNode:
+		i.flags(indent, false, n.flags, nil)
+		i.list(indent, false, "requireds", n.Requireds)
+		i.list(indent, false, "optionals", n.Optionals)
+		i.child(indent, false, "rest", n.Rest)
+		i.list(indent, false, "posts", n.Posts)
+		i.list(indent, false, "keywords", n.Keywords)
+		i.child(indent, false, "keyword_rest", n.KeywordRest)
+		i.child(indent, true, "block", nodeOrNil(n.Block))
+	case *ParenthesesNode:
+		i.flags(indent, false, n.flags, parenthesesNodeFlagsInfo)
+		i.child(indent, false, "body", n.Body)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *PinnedExpressionNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "expression", n.Expression)
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.field(indent, false, "lparen_loc", i.location(&n.LparenLoc))
+		i.field(indent, true, "rparen_loc", i.location(&n.RparenLoc))
+	case *PinnedVariableNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "variable", n.Variable)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *PostExecutionNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *PreExecutionNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, true, "closing_loc", i.location(&n.ClosingLoc))
+	case *ProgramNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.child(indent, true, "statements", nodeOrNil(n.Statements))
+	case *RangeNode:
+		i.flags(indent, false, n.flags, rangeFlagsInfo)
+		i.child(indent, false, "left", n.Left)
+		i.child(indent, false, "right", n.Right)
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *RationalNode:
+		i.flags(indent, false, n.flags, integerBaseFlagsInfo)
+		i.field(indent, false, "numerator", strconv.FormatInt(n.Numerator, 10))
+		i.field(indent, true, "denominator", strconv.FormatInt(n.Denominator, 10))
+	case *RedoNode:
+		i.flags(indent, true, n.flags, nil)
+	case *RegularExpressionNode:
+		i.flags(indent, false, n.flags, regularExpressionFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, false, "content_loc", i.location(&n.ContentLoc))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.field(indent, true, "unescaped", inspectRubyString(n.Unescaped))
+	case *RequiredKeywordParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, false, "name", ruby.InspectSymbol(n.Name))
+		i.field(indent, true, "name_loc", i.location(&n.NameLoc))
+	case *RequiredParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, true, "name", ruby.InspectSymbol(n.Name))
+	case *RescueModifierNode:
+		i.flags(indent, false, n.flags, nil)
+		i.child(indent, false, "expression", n.Expression)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.child(indent, true, "rescue_expression", n.RescueExpression)
+	case *RescueNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.list(indent, false, "exceptions", n.Exceptions)
+		i.field(indent, false, "operator_loc", i.location(n.OperatorLoc))
+		i.child(indent, false, "reference", n.Reference)
+		i.field(indent, false, "then_keyword_loc", i.location(n.ThenKeywordLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.child(indent, true, "subsequent", nodeOrNil(n.Subsequent))
+	case *RestParameterNode:
+		i.flags(indent, false, n.flags, parameterFlagsInfo)
+		i.field(indent, false, "name", inspectOptionalSymbol(n.Name))
+		i.field(indent, false, "name_loc", i.location(n.NameLoc))
+		i.field(indent, true, "operator_loc", i.location(&n.OperatorLoc))
+	case *RetryNode:
+		i.flags(indent, true, n.flags, nil)
+	case *ReturnNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.child(indent, true, "arguments", nodeOrNil(n.Arguments))
+	case *SelfNode:
+		i.flags(indent, true, n.flags, nil)
+	case *ShareableConstantNode:
+		i.flags(indent, false, n.flags, shareableConstantNodeFlagsInfo)
+		i.child(indent, true, "write", n.Write)
+	case *SingletonClassNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "locals", inspectSymbols(n.Locals))
+		i.field(indent, false, "class_keyword_loc", i.location(&n.ClassKeywordLoc))
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, false, "expression", n.Expression)
+		i.child(indent, false, "body", n.Body)
+		i.field(indent, true, "end_keyword_loc", i.location(&n.EndKeywordLoc))
+	case *SourceEncodingNode:
+		i.flags(indent, true, n.flags, nil)
+	case *SourceFileNode:
+		i.flags(indent, false, n.flags, stringFlagsInfo)
+		i.field(indent, true, "filepath", inspectRubyString(n.Filepath))
+	case *SourceLineNode:
+		i.flags(indent, true, n.flags, nil)
+	case *SplatNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "operator_loc", i.location(&n.OperatorLoc))
+		i.child(indent, true, "expression", n.Expression)
+	case *StatementsNode:
+		i.flags(indent, false, n.flags, nil)
+		i.list(indent, true, "body", n.Body)
+	case *StringNode:
+		i.flags(indent, false, n.flags, stringFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, false, "content_loc", i.location(&n.ContentLoc))
+		i.field(indent, false, "closing_loc", i.location(n.ClosingLoc))
+		i.field(indent, true, "unescaped", inspectRubyString(n.Unescaped))
+	case *SuperNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.field(indent, false, "lparen_loc", i.location(n.LparenLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, false, "rparen_loc", i.location(n.RparenLoc))
+		i.child(indent, true, "block", n.Block)
+	case *SymbolNode:
+		i.flags(indent, false, n.flags, symbolFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(n.OpeningLoc))
+		i.field(indent, false, "value_loc", i.location(n.ValueLoc))
+		i.field(indent, false, "closing_loc", i.location(n.ClosingLoc))
+		i.field(indent, true, "unescaped", inspectRubyString(n.Unescaped))
+	case *TrueNode:
+		i.flags(indent, true, n.flags, nil)
+	case *UndefNode:
+		i.flags(indent, false, n.flags, nil)
+		i.list(indent, false, "names", n.Names)
+		i.field(indent, true, "keyword_loc", i.location(&n.KeywordLoc))
+	case *UnlessNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.child(indent, false, "predicate", n.Predicate)
+		i.field(indent, false, "then_keyword_loc", i.location(n.ThenKeywordLoc))
+		i.child(indent, false, "statements", nodeOrNil(n.Statements))
+		i.child(indent, false, "else_clause", nodeOrNil(n.ElseClause))
+		i.field(indent, true, "end_keyword_loc", i.location(n.EndKeywordLoc))
+	case *UntilNode:
+		i.flags(indent, false, n.flags, loopFlagsInfo)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.field(indent, false, "do_keyword_loc", i.location(n.DoKeywordLoc))
+		i.field(indent, false, "closing_loc", i.location(n.ClosingLoc))
+		i.child(indent, false, "predicate", n.Predicate)
+		i.child(indent, true, "statements", nodeOrNil(n.Statements))
+	case *WhenNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.list(indent, false, "conditions", n.Conditions)
+		i.field(indent, false, "then_keyword_loc", i.location(n.ThenKeywordLoc))
+		i.child(indent, true, "statements", nodeOrNil(n.Statements))
+	case *WhileNode:
+		i.flags(indent, false, n.flags, loopFlagsInfo)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.field(indent, false, "do_keyword_loc", i.location(n.DoKeywordLoc))
+		i.field(indent, false, "closing_loc", i.location(n.ClosingLoc))
+		i.child(indent, false, "predicate", n.Predicate)
+		i.child(indent, true, "statements", nodeOrNil(n.Statements))
+	case *XStringNode:
+		i.flags(indent, false, n.flags, encodingFlagsInfo)
+		i.field(indent, false, "opening_loc", i.location(&n.OpeningLoc))
+		i.field(indent, false, "content_loc", i.location(&n.ContentLoc))
+		i.field(indent, false, "closing_loc", i.location(&n.ClosingLoc))
+		i.field(indent, true, "unescaped", inspectRubyString(n.Unescaped))
+	case *YieldNode:
+		i.flags(indent, false, n.flags, nil)
+		i.field(indent, false, "keyword_loc", i.location(&n.KeywordLoc))
+		i.field(indent, false, "lparen_loc", i.location(n.LparenLoc))
+		i.child(indent, false, "arguments", nodeOrNil(n.Arguments))
+		i.field(indent, true, "rparen_loc", i.location(n.RparenLoc))
+	}
+}
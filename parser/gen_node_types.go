@@ -0,0 +1,1690 @@
+/*----------------------------------------------------------------------------*/
+/* This file is generated by the templates/template.rb script and should not  */
+/* be modified manually. See                                                  */
+/* templates/../../templates/gen_node_types.go.erb                            */
+/* if you are looking to modify the                                           */
+/* template                                                                   */
+/*----------------------------------------------------------------------------*/
+
+package parser
+
+// NodeType identifies the concrete type of a node. The values match the node
+// type identifiers used by the serialization format.
+type NodeType uint8
+
+const (
+	NodeTypeAliasGlobalVariableNode           NodeType = 1
+	NodeTypeAliasMethodNode                   NodeType = 2
+	NodeTypeAlternationPatternNode            NodeType = 3
+	NodeTypeAndNode                           NodeType = 4
+	NodeTypeArgumentsNode                     NodeType = 5
+	NodeTypeArrayNode                         NodeType = 6
+	NodeTypeArrayPatternNode                  NodeType = 7
+	NodeTypeAssocNode                         NodeType = 8
+	NodeTypeAssocSplatNode                    NodeType = 9
+	NodeTypeBackReferenceReadNode             NodeType = 10
+	NodeTypeBeginNode                         NodeType = 11
+	NodeTypeBlockArgumentNode                 NodeType = 12
+	NodeTypeBlockLocalVariableNode            NodeType = 13
+	NodeTypeBlockNode                         NodeType = 14
+	NodeTypeBlockParameterNode                NodeType = 15
+	NodeTypeBlockParametersNode               NodeType = 16
+	NodeTypeBreakNode                         NodeType = 17
+	NodeTypeCallAndWriteNode                  NodeType = 18
+	NodeTypeCallNode                          NodeType = 19
+	NodeTypeCallOperatorWriteNode             NodeType = 20
+	NodeTypeCallOrWriteNode                   NodeType = 21
+	NodeTypeCallTargetNode                    NodeType = 22
+	NodeTypeCapturePatternNode                NodeType = 23
+	NodeTypeCaseMatchNode                     NodeType = 24
+	NodeTypeCaseNode                          NodeType = 25
+	NodeTypeClassNode                         NodeType = 26
+	NodeTypeClassVariableAndWriteNode         NodeType = 27
+	NodeTypeClassVariableOperatorWriteNode    NodeType = 28
+	NodeTypeClassVariableOrWriteNode          NodeType = 29
+	NodeTypeClassVariableReadNode             NodeType = 30
+	NodeTypeClassVariableTargetNode           NodeType = 31
+	NodeTypeClassVariableWriteNode            NodeType = 32
+	NodeTypeConstantAndWriteNode              NodeType = 33
+	NodeTypeConstantOperatorWriteNode         NodeType = 34
+	NodeTypeConstantOrWriteNode               NodeType = 35
+	NodeTypeConstantPathAndWriteNode          NodeType = 36
+	NodeTypeConstantPathNode                  NodeType = 37
+	NodeTypeConstantPathOperatorWriteNode     NodeType = 38
+	NodeTypeConstantPathOrWriteNode           NodeType = 39
+	NodeTypeConstantPathTargetNode            NodeType = 40
+	NodeTypeConstantPathWriteNode             NodeType = 41
+	NodeTypeConstantReadNode                  NodeType = 42
+	NodeTypeConstantTargetNode                NodeType = 43
+	NodeTypeConstantWriteNode                 NodeType = 44
+	NodeTypeDefNode                           NodeType = 45
+	NodeTypeDefinedNode                       NodeType = 46
+	NodeTypeElseNode                          NodeType = 47
+	NodeTypeEmbeddedStatementsNode            NodeType = 48
+	NodeTypeEmbeddedVariableNode              NodeType = 49
+	NodeTypeEnsureNode                        NodeType = 50
+	NodeTypeFalseNode                         NodeType = 51
+	NodeTypeFindPatternNode                   NodeType = 52
+	NodeTypeFlipFlopNode                      NodeType = 53
+	NodeTypeFloatNode                         NodeType = 54
+	NodeTypeForNode                           NodeType = 55
+	NodeTypeForwardingArgumentsNode           NodeType = 56
+	NodeTypeForwardingParameterNode           NodeType = 57
+	NodeTypeForwardingSuperNode               NodeType = 58
+	NodeTypeGlobalVariableAndWriteNode        NodeType = 59
+	NodeTypeGlobalVariableOperatorWriteNode   NodeType = 60
+	NodeTypeGlobalVariableOrWriteNode         NodeType = 61
+	NodeTypeGlobalVariableReadNode            NodeType = 62
+	NodeTypeGlobalVariableTargetNode          NodeType = 63
+	NodeTypeGlobalVariableWriteNode           NodeType = 64
+	NodeTypeHashNode                          NodeType = 65
+	NodeTypeHashPatternNode                   NodeType = 66
+	NodeTypeIfNode                            NodeType = 67
+	NodeTypeImaginaryNode                     NodeType = 68
+	NodeTypeImplicitNode                      NodeType = 69
+	NodeTypeImplicitRestNode                  NodeType = 70
+	NodeTypeInNode                            NodeType = 71
+	NodeTypeIndexAndWriteNode                 NodeType = 72
+	NodeTypeIndexOperatorWriteNode            NodeType = 73
+	NodeTypeIndexOrWriteNode                  NodeType = 74
+	NodeTypeIndexTargetNode                   NodeType = 75
+	NodeTypeInstanceVariableAndWriteNode      NodeType = 76
+	NodeTypeInstanceVariableOperatorWriteNode NodeType = 77
+	NodeTypeInstanceVariableOrWriteNode       NodeType = 78
+	NodeTypeInstanceVariableReadNode          NodeType = 79
+	NodeTypeInstanceVariableTargetNode        NodeType = 80
+	NodeTypeInstanceVariableWriteNode         NodeType = 81
+	NodeTypeIntegerNode                       NodeType = 82
+	NodeTypeInterpolatedMatchLastLineNode     NodeType = 83
+	NodeTypeInterpolatedRegularExpressionNode NodeType = 84
+	NodeTypeInterpolatedStringNode            NodeType = 85
+	NodeTypeInterpolatedSymbolNode            NodeType = 86
+	NodeTypeInterpolatedXStringNode           NodeType = 87
+	NodeTypeItLocalVariableReadNode           NodeType = 88
+	NodeTypeItParametersNode                  NodeType = 89
+	NodeTypeKeywordHashNode                   NodeType = 90
+	NodeTypeKeywordRestParameterNode          NodeType = 91
+	NodeTypeLambdaNode                        NodeType = 92
+	NodeTypeLocalVariableAndWriteNode         NodeType = 93
+	NodeTypeLocalVariableOperatorWriteNode    NodeType = 94
+	NodeTypeLocalVariableOrWriteNode          NodeType = 95
+	NodeTypeLocalVariableReadNode             NodeType = 96
+	NodeTypeLocalVariableTargetNode           NodeType = 97
+	NodeTypeLocalVariableWriteNode            NodeType = 98
+	NodeTypeMatchLastLineNode                 NodeType = 99
+	NodeTypeMatchPredicateNode                NodeType = 100
+	NodeTypeMatchRequiredNode                 NodeType = 101
+	NodeTypeMatchWriteNode                    NodeType = 102
+	NodeTypeMissingNode                       NodeType = 103
+	NodeTypeModuleNode                        NodeType = 104
+	NodeTypeMultiTargetNode                   NodeType = 105
+	NodeTypeMultiWriteNode                    NodeType = 106
+	NodeTypeNextNode                          NodeType = 107
+	NodeTypeNilNode                           NodeType = 108
+	NodeTypeNoKeywordsParameterNode           NodeType = 109
+	NodeTypeNumberedParametersNode            NodeType = 110
+	NodeTypeNumberedReferenceReadNode         NodeType = 111
+	NodeTypeOptionalKeywordParameterNode      NodeType = 112
+	NodeTypeOptionalParameterNode             NodeType = 113
+	NodeTypeOrNode                            NodeType = 114
+	NodeTypeParametersNode                    NodeType = 115
+	NodeTypeParenthesesNode                   NodeType = 116
+	NodeTypePinnedExpressionNode              NodeType = 117
+	NodeTypePinnedVariableNode                NodeType = 118
+	NodeTypePostExecutionNode                 NodeType = 119
+	NodeTypePreExecutionNode                  NodeType = 120
+	NodeTypeProgramNode                       NodeType = 121
+	NodeTypeRangeNode                         NodeType = 122
+	NodeTypeRationalNode                      NodeType = 123
+	NodeTypeRedoNode                          NodeType = 124
+	NodeTypeRegularExpressionNode             NodeType = 125
+	NodeTypeRequiredKeywordParameterNode      NodeType = 126
+	NodeTypeRequiredParameterNode             NodeType = 127
+	NodeTypeRescueModifierNode                NodeType = 128
+	NodeTypeRescueNode                        NodeType = 129
+	NodeTypeRestParameterNode                 NodeType = 130
+	NodeTypeRetryNode                         NodeType = 131
+	NodeTypeReturnNode                        NodeType = 132
+	NodeTypeSelfNode                          NodeType = 133
+	NodeTypeShareableConstantNode             NodeType = 134
+	NodeTypeSingletonClassNode                NodeType = 135
+	NodeTypeSourceEncodingNode                NodeType = 136
+	NodeTypeSourceFileNode                    NodeType = 137
+	NodeTypeSourceLineNode                    NodeType = 138
+	NodeTypeSplatNode                         NodeType = 139
+	NodeTypeStatementsNode                    NodeType = 140
+	NodeTypeStringNode                        NodeType = 141
+	NodeTypeSuperNode                         NodeType = 142
+	NodeTypeSymbolNode                        NodeType = 143
+	NodeTypeTrueNode                          NodeType = 144
+	NodeTypeUndefNode                         NodeType = 145
+	NodeTypeUnlessNode                        NodeType = 146
+	NodeTypeUntilNode                         NodeType = 147
+	NodeTypeWhenNode                          NodeType = 148
+	NodeTypeWhileNode                         NodeType = 149
+	NodeTypeXStringNode                       NodeType = 150
+	NodeTypeYieldNode                         NodeType = 151
+)
+
+var argumentsNodeFlagsInfo = []FlagInfo{
+	{Name: "CONTAINS_FORWARDING", Mask: ArgumentsNodeFlagsCONTAINS_FORWARDING},
+	{Name: "CONTAINS_KEYWORDS", Mask: ArgumentsNodeFlagsCONTAINS_KEYWORDS},
+	{Name: "CONTAINS_KEYWORD_SPLAT", Mask: ArgumentsNodeFlagsCONTAINS_KEYWORD_SPLAT},
+	{Name: "CONTAINS_SPLAT", Mask: ArgumentsNodeFlagsCONTAINS_SPLAT},
+	{Name: "CONTAINS_MULTIPLE_SPLATS", Mask: ArgumentsNodeFlagsCONTAINS_MULTIPLE_SPLATS},
+}
+
+var arrayNodeFlagsInfo = []FlagInfo{
+	{Name: "CONTAINS_SPLAT", Mask: ArrayNodeFlagsCONTAINS_SPLAT},
+}
+
+var callNodeFlagsInfo = []FlagInfo{
+	{Name: "SAFE_NAVIGATION", Mask: CallNodeFlagsSAFE_NAVIGATION},
+	{Name: "VARIABLE_CALL", Mask: CallNodeFlagsVARIABLE_CALL},
+	{Name: "ATTRIBUTE_WRITE", Mask: CallNodeFlagsATTRIBUTE_WRITE},
+	{Name: "IGNORE_VISIBILITY", Mask: CallNodeFlagsIGNORE_VISIBILITY},
+}
+
+var encodingFlagsInfo = []FlagInfo{
+	{Name: "FORCED_UTF8_ENCODING", Mask: EncodingFlagsFORCED_UTF8_ENCODING},
+	{Name: "FORCED_BINARY_ENCODING", Mask: EncodingFlagsFORCED_BINARY_ENCODING},
+}
+
+var integerBaseFlagsInfo = []FlagInfo{
+	{Name: "BINARY", Mask: IntegerBaseFlagsBINARY},
+	{Name: "DECIMAL", Mask: IntegerBaseFlagsDECIMAL},
+	{Name: "OCTAL", Mask: IntegerBaseFlagsOCTAL},
+	{Name: "HEXADECIMAL", Mask: IntegerBaseFlagsHEXADECIMAL},
+}
+
+var interpolatedStringNodeFlagsInfo = []FlagInfo{
+	{Name: "FROZEN", Mask: InterpolatedStringNodeFlagsFROZEN},
+	{Name: "MUTABLE", Mask: InterpolatedStringNodeFlagsMUTABLE},
+}
+
+var keywordHashNodeFlagsInfo = []FlagInfo{
+	{Name: "SYMBOL_KEYS", Mask: KeywordHashNodeFlagsSYMBOL_KEYS},
+}
+
+var loopFlagsInfo = []FlagInfo{
+	{Name: "BEGIN_MODIFIER", Mask: LoopFlagsBEGIN_MODIFIER},
+}
+
+var parameterFlagsInfo = []FlagInfo{
+	{Name: "REPEATED_PARAMETER", Mask: ParameterFlagsREPEATED_PARAMETER},
+}
+
+var parenthesesNodeFlagsInfo = []FlagInfo{
+	{Name: "MULTIPLE_STATEMENTS", Mask: ParenthesesNodeFlagsMULTIPLE_STATEMENTS},
+}
+
+var rangeFlagsInfo = []FlagInfo{
+	{Name: "EXCLUDE_END", Mask: RangeFlagsEXCLUDE_END},
+}
+
+var regularExpressionFlagsInfo = []FlagInfo{
+	{Name: "IGNORE_CASE", Mask: RegularExpressionFlagsIGNORE_CASE},
+	{Name: "EXTENDED", Mask: RegularExpressionFlagsEXTENDED},
+	{Name: "MULTI_LINE", Mask: RegularExpressionFlagsMULTI_LINE},
+	{Name: "ONCE", Mask: RegularExpressionFlagsONCE},
+	{Name: "EUC_JP", Mask: RegularExpressionFlagsEUC_JP},
+	{Name: "ASCII_8BIT", Mask: RegularExpressionFlagsASCII_8BIT},
+	{Name: "WINDOWS_31J", Mask: RegularExpressionFlagsWINDOWS_31J},
+	{Name: "UTF_8", Mask: RegularExpressionFlagsUTF_8},
+	{Name: "FORCED_UTF8_ENCODING", Mask: RegularExpressionFlagsFORCED_UTF8_ENCODING},
+	{Name: "FORCED_BINARY_ENCODING", Mask: RegularExpressionFlagsFORCED_BINARY_ENCODING},
+	{Name: "FORCED_US_ASCII_ENCODING", Mask: RegularExpressionFlagsFORCED_US_ASCII_ENCODING},
+}
+
+var shareableConstantNodeFlagsInfo = []FlagInfo{
+	{Name: "LITERAL", Mask: ShareableConstantNodeFlagsLITERAL},
+	{Name: "EXPERIMENTAL_EVERYTHING", Mask: ShareableConstantNodeFlagsEXPERIMENTAL_EVERYTHING},
+	{Name: "EXPERIMENTAL_COPY", Mask: ShareableConstantNodeFlagsEXPERIMENTAL_COPY},
+}
+
+var stringFlagsInfo = []FlagInfo{
+	{Name: "FORCED_UTF8_ENCODING", Mask: StringFlagsFORCED_UTF8_ENCODING},
+	{Name: "FORCED_BINARY_ENCODING", Mask: StringFlagsFORCED_BINARY_ENCODING},
+	{Name: "FROZEN", Mask: StringFlagsFROZEN},
+	{Name: "MUTABLE", Mask: StringFlagsMUTABLE},
+}
+
+var symbolFlagsInfo = []FlagInfo{
+	{Name: "FORCED_UTF8_ENCODING", Mask: SymbolFlagsFORCED_UTF8_ENCODING},
+	{Name: "FORCED_BINARY_ENCODING", Mask: SymbolFlagsFORCED_BINARY_ENCODING},
+	{Name: "FORCED_US_ASCII_ENCODING", Mask: SymbolFlagsFORCED_US_ASCII_ENCODING},
+}
+
+var nodeInfos = [...]NodeInfo{
+	NodeTypeAliasGlobalVariableNode: {
+		Type: NodeTypeAliasGlobalVariableNode,
+		Name: "AliasGlobalVariableNode",
+		Fields: []FieldInfo{
+			{Name: "new_name", Kind: FieldKindNode},
+			{Name: "old_name", Kind: FieldKindNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeAliasMethodNode: {
+		Type: NodeTypeAliasMethodNode,
+		Name: "AliasMethodNode",
+		Fields: []FieldInfo{
+			{Name: "new_name", Kind: FieldKindNode},
+			{Name: "old_name", Kind: FieldKindNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeAlternationPatternNode: {
+		Type: NodeTypeAlternationPatternNode,
+		Name: "AlternationPatternNode",
+		Fields: []FieldInfo{
+			{Name: "left", Kind: FieldKindNode},
+			{Name: "right", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeAndNode: {
+		Type: NodeTypeAndNode,
+		Name: "AndNode",
+		Fields: []FieldInfo{
+			{Name: "left", Kind: FieldKindNode},
+			{Name: "right", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeArgumentsNode: {
+		Type: NodeTypeArgumentsNode,
+		Name: "ArgumentsNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: argumentsNodeFlagsInfo},
+			{Name: "arguments", Kind: FieldKindNodeList},
+		},
+	},
+	NodeTypeArrayNode: {
+		Type: NodeTypeArrayNode,
+		Name: "ArrayNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: arrayNodeFlagsInfo},
+			{Name: "elements", Kind: FieldKindNodeList},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeArrayPatternNode: {
+		Type: NodeTypeArrayPatternNode,
+		Name: "ArrayPatternNode",
+		Fields: []FieldInfo{
+			{Name: "constant", Kind: FieldKindOptionalNode},
+			{Name: "requireds", Kind: FieldKindNodeList},
+			{Name: "rest", Kind: FieldKindOptionalNode},
+			{Name: "posts", Kind: FieldKindNodeList},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeAssocNode: {
+		Type: NodeTypeAssocNode,
+		Name: "AssocNode",
+		Fields: []FieldInfo{
+			{Name: "key", Kind: FieldKindNode},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeAssocSplatNode: {
+		Type: NodeTypeAssocSplatNode,
+		Name: "AssocSplatNode",
+		Fields: []FieldInfo{
+			{Name: "value", Kind: FieldKindOptionalNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeBackReferenceReadNode: {
+		Type: NodeTypeBackReferenceReadNode,
+		Name: "BackReferenceReadNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeBeginNode: {
+		Type: NodeTypeBeginNode,
+		Name: "BeginNode",
+		Fields: []FieldInfo{
+			{Name: "begin_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "rescue_clause", Kind: FieldKindOptionalNode, NodeType: NodeTypeRescueNode},
+			{Name: "else_clause", Kind: FieldKindOptionalNode, NodeType: NodeTypeElseNode},
+			{Name: "ensure_clause", Kind: FieldKindOptionalNode, NodeType: NodeTypeEnsureNode},
+			{Name: "end_keyword_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeBlockArgumentNode: {
+		Type: NodeTypeBlockArgumentNode,
+		Name: "BlockArgumentNode",
+		Fields: []FieldInfo{
+			{Name: "expression", Kind: FieldKindOptionalNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeBlockLocalVariableNode: {
+		Type: NodeTypeBlockLocalVariableNode,
+		Name: "BlockLocalVariableNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeBlockNode: {
+		Type: NodeTypeBlockNode,
+		Name: "BlockNode",
+		Fields: []FieldInfo{
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "parameters", Kind: FieldKindOptionalNode},
+			{Name: "body", Kind: FieldKindOptionalNode},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeBlockParameterNode: {
+		Type: NodeTypeBlockParameterNode,
+		Name: "BlockParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindOptionalConstant},
+			{Name: "name_loc", Kind: FieldKindOptionalLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeBlockParametersNode: {
+		Type: NodeTypeBlockParametersNode,
+		Name: "BlockParametersNode",
+		Fields: []FieldInfo{
+			{Name: "parameters", Kind: FieldKindOptionalNode, NodeType: NodeTypeParametersNode},
+			{Name: "locals", Kind: FieldKindNodeList},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeBreakNode: {
+		Type: NodeTypeBreakNode,
+		Name: "BreakNode",
+		Fields: []FieldInfo{
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeCallAndWriteNode: {
+		Type: NodeTypeCallAndWriteNode,
+		Name: "CallAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "message_loc", Kind: FieldKindOptionalLocation},
+			{Name: "read_name", Kind: FieldKindConstant},
+			{Name: "write_name", Kind: FieldKindConstant},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeCallNode: {
+		Type: NodeTypeCallNode,
+		Name: "CallNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "message_loc", Kind: FieldKindOptionalLocation},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+			{Name: "block", Kind: FieldKindOptionalNode},
+		},
+	},
+	NodeTypeCallOperatorWriteNode: {
+		Type: NodeTypeCallOperatorWriteNode,
+		Name: "CallOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "message_loc", Kind: FieldKindOptionalLocation},
+			{Name: "read_name", Kind: FieldKindConstant},
+			{Name: "write_name", Kind: FieldKindConstant},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeCallOrWriteNode: {
+		Type: NodeTypeCallOrWriteNode,
+		Name: "CallOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "message_loc", Kind: FieldKindOptionalLocation},
+			{Name: "read_name", Kind: FieldKindConstant},
+			{Name: "write_name", Kind: FieldKindConstant},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeCallTargetNode: {
+		Type: NodeTypeCallTargetNode,
+		Name: "CallTargetNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindNode},
+			{Name: "call_operator_loc", Kind: FieldKindLocation},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "message_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeCapturePatternNode: {
+		Type: NodeTypeCapturePatternNode,
+		Name: "CapturePatternNode",
+		Fields: []FieldInfo{
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "target", Kind: FieldKindNode, NodeType: NodeTypeLocalVariableTargetNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeCaseMatchNode: {
+		Type: NodeTypeCaseMatchNode,
+		Name: "CaseMatchNode",
+		Fields: []FieldInfo{
+			{Name: "predicate", Kind: FieldKindOptionalNode},
+			{Name: "conditions", Kind: FieldKindNodeList},
+			{Name: "else_clause", Kind: FieldKindOptionalNode, NodeType: NodeTypeElseNode},
+			{Name: "case_keyword_loc", Kind: FieldKindLocation},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeCaseNode: {
+		Type: NodeTypeCaseNode,
+		Name: "CaseNode",
+		Fields: []FieldInfo{
+			{Name: "predicate", Kind: FieldKindOptionalNode},
+			{Name: "conditions", Kind: FieldKindNodeList},
+			{Name: "else_clause", Kind: FieldKindOptionalNode, NodeType: NodeTypeElseNode},
+			{Name: "case_keyword_loc", Kind: FieldKindLocation},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeClassNode: {
+		Type: NodeTypeClassNode,
+		Name: "ClassNode",
+		Fields: []FieldInfo{
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "class_keyword_loc", Kind: FieldKindLocation},
+			{Name: "constant_path", Kind: FieldKindNode},
+			{Name: "inheritance_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "superclass", Kind: FieldKindOptionalNode},
+			{Name: "body", Kind: FieldKindOptionalNode},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeClassVariableAndWriteNode: {
+		Type: NodeTypeClassVariableAndWriteNode,
+		Name: "ClassVariableAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeClassVariableOperatorWriteNode: {
+		Type: NodeTypeClassVariableOperatorWriteNode,
+		Name: "ClassVariableOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeClassVariableOrWriteNode: {
+		Type: NodeTypeClassVariableOrWriteNode,
+		Name: "ClassVariableOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeClassVariableReadNode: {
+		Type: NodeTypeClassVariableReadNode,
+		Name: "ClassVariableReadNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeClassVariableTargetNode: {
+		Type: NodeTypeClassVariableTargetNode,
+		Name: "ClassVariableTargetNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeClassVariableWriteNode: {
+		Type: NodeTypeClassVariableWriteNode,
+		Name: "ClassVariableWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeConstantAndWriteNode: {
+		Type: NodeTypeConstantAndWriteNode,
+		Name: "ConstantAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeConstantOperatorWriteNode: {
+		Type: NodeTypeConstantOperatorWriteNode,
+		Name: "ConstantOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeConstantOrWriteNode: {
+		Type: NodeTypeConstantOrWriteNode,
+		Name: "ConstantOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeConstantPathAndWriteNode: {
+		Type: NodeTypeConstantPathAndWriteNode,
+		Name: "ConstantPathAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "target", Kind: FieldKindNode, NodeType: NodeTypeConstantPathNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeConstantPathNode: {
+		Type: NodeTypeConstantPathNode,
+		Name: "ConstantPathNode",
+		Fields: []FieldInfo{
+			{Name: "parent", Kind: FieldKindOptionalNode},
+			{Name: "name", Kind: FieldKindOptionalConstant},
+			{Name: "delimiter_loc", Kind: FieldKindLocation},
+			{Name: "name_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeConstantPathOperatorWriteNode: {
+		Type: NodeTypeConstantPathOperatorWriteNode,
+		Name: "ConstantPathOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "target", Kind: FieldKindNode, NodeType: NodeTypeConstantPathNode},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeConstantPathOrWriteNode: {
+		Type: NodeTypeConstantPathOrWriteNode,
+		Name: "ConstantPathOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "target", Kind: FieldKindNode, NodeType: NodeTypeConstantPathNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeConstantPathTargetNode: {
+		Type: NodeTypeConstantPathTargetNode,
+		Name: "ConstantPathTargetNode",
+		Fields: []FieldInfo{
+			{Name: "parent", Kind: FieldKindOptionalNode},
+			{Name: "name", Kind: FieldKindOptionalConstant},
+			{Name: "delimiter_loc", Kind: FieldKindLocation},
+			{Name: "name_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeConstantPathWriteNode: {
+		Type: NodeTypeConstantPathWriteNode,
+		Name: "ConstantPathWriteNode",
+		Fields: []FieldInfo{
+			{Name: "target", Kind: FieldKindNode, NodeType: NodeTypeConstantPathNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeConstantReadNode: {
+		Type: NodeTypeConstantReadNode,
+		Name: "ConstantReadNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeConstantTargetNode: {
+		Type: NodeTypeConstantTargetNode,
+		Name: "ConstantTargetNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeConstantWriteNode: {
+		Type: NodeTypeConstantWriteNode,
+		Name: "ConstantWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeDefNode: {
+		Type: NodeTypeDefNode,
+		Name: "DefNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "parameters", Kind: FieldKindOptionalNode, NodeType: NodeTypeParametersNode},
+			{Name: "body", Kind: FieldKindOptionalNode},
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "def_keyword_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "lparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "rparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "equal_loc", Kind: FieldKindOptionalLocation},
+			{Name: "end_keyword_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeDefinedNode: {
+		Type: NodeTypeDefinedNode,
+		Name: "DefinedNode",
+		Fields: []FieldInfo{
+			{Name: "lparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "rparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeElseNode: {
+		Type: NodeTypeElseNode,
+		Name: "ElseNode",
+		Fields: []FieldInfo{
+			{Name: "else_keyword_loc", Kind: FieldKindLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "end_keyword_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeEmbeddedStatementsNode: {
+		Type: NodeTypeEmbeddedStatementsNode,
+		Name: "EmbeddedStatementsNode",
+		Fields: []FieldInfo{
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeEmbeddedVariableNode: {
+		Type: NodeTypeEmbeddedVariableNode,
+		Name: "EmbeddedVariableNode",
+		Fields: []FieldInfo{
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "variable", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeEnsureNode: {
+		Type: NodeTypeEnsureNode,
+		Name: "EnsureNode",
+		Fields: []FieldInfo{
+			{Name: "ensure_keyword_loc", Kind: FieldKindLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeFalseNode: {
+		Type:   NodeTypeFalseNode,
+		Name:   "FalseNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeFindPatternNode: {
+		Type: NodeTypeFindPatternNode,
+		Name: "FindPatternNode",
+		Fields: []FieldInfo{
+			{Name: "constant", Kind: FieldKindOptionalNode},
+			{Name: "left", Kind: FieldKindNode, NodeType: NodeTypeSplatNode},
+			{Name: "requireds", Kind: FieldKindNodeList},
+			{Name: "right", Kind: FieldKindNode},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeFlipFlopNode: {
+		Type: NodeTypeFlipFlopNode,
+		Name: "FlipFlopNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: rangeFlagsInfo},
+			{Name: "left", Kind: FieldKindOptionalNode},
+			{Name: "right", Kind: FieldKindOptionalNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeFloatNode: {
+		Type: NodeTypeFloatNode,
+		Name: "FloatNode",
+		Fields: []FieldInfo{
+			{Name: "value", Kind: FieldKindDouble},
+		},
+	},
+	NodeTypeForNode: {
+		Type: NodeTypeForNode,
+		Name: "ForNode",
+		Fields: []FieldInfo{
+			{Name: "index", Kind: FieldKindNode},
+			{Name: "collection", Kind: FieldKindNode},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "for_keyword_loc", Kind: FieldKindLocation},
+			{Name: "in_keyword_loc", Kind: FieldKindLocation},
+			{Name: "do_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeForwardingArgumentsNode: {
+		Type:   NodeTypeForwardingArgumentsNode,
+		Name:   "ForwardingArgumentsNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeForwardingParameterNode: {
+		Type:   NodeTypeForwardingParameterNode,
+		Name:   "ForwardingParameterNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeForwardingSuperNode: {
+		Type: NodeTypeForwardingSuperNode,
+		Name: "ForwardingSuperNode",
+		Fields: []FieldInfo{
+			{Name: "block", Kind: FieldKindOptionalNode, NodeType: NodeTypeBlockNode},
+		},
+	},
+	NodeTypeGlobalVariableAndWriteNode: {
+		Type: NodeTypeGlobalVariableAndWriteNode,
+		Name: "GlobalVariableAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeGlobalVariableOperatorWriteNode: {
+		Type: NodeTypeGlobalVariableOperatorWriteNode,
+		Name: "GlobalVariableOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeGlobalVariableOrWriteNode: {
+		Type: NodeTypeGlobalVariableOrWriteNode,
+		Name: "GlobalVariableOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeGlobalVariableReadNode: {
+		Type: NodeTypeGlobalVariableReadNode,
+		Name: "GlobalVariableReadNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeGlobalVariableTargetNode: {
+		Type: NodeTypeGlobalVariableTargetNode,
+		Name: "GlobalVariableTargetNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeGlobalVariableWriteNode: {
+		Type: NodeTypeGlobalVariableWriteNode,
+		Name: "GlobalVariableWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeHashNode: {
+		Type: NodeTypeHashNode,
+		Name: "HashNode",
+		Fields: []FieldInfo{
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "elements", Kind: FieldKindNodeList},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeHashPatternNode: {
+		Type: NodeTypeHashPatternNode,
+		Name: "HashPatternNode",
+		Fields: []FieldInfo{
+			{Name: "constant", Kind: FieldKindOptionalNode},
+			{Name: "elements", Kind: FieldKindNodeList},
+			{Name: "rest", Kind: FieldKindOptionalNode},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeIfNode: {
+		Type: NodeTypeIfNode,
+		Name: "IfNode",
+		Fields: []FieldInfo{
+			{Name: "if_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "predicate", Kind: FieldKindNode},
+			{Name: "then_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "subsequent", Kind: FieldKindOptionalNode},
+			{Name: "end_keyword_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeImaginaryNode: {
+		Type: NodeTypeImaginaryNode,
+		Name: "ImaginaryNode",
+		Fields: []FieldInfo{
+			{Name: "numeric", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeImplicitNode: {
+		Type: NodeTypeImplicitNode,
+		Name: "ImplicitNode",
+		Fields: []FieldInfo{
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeImplicitRestNode: {
+		Type:   NodeTypeImplicitRestNode,
+		Name:   "ImplicitRestNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeInNode: {
+		Type: NodeTypeInNode,
+		Name: "InNode",
+		Fields: []FieldInfo{
+			{Name: "pattern", Kind: FieldKindNode},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "in_loc", Kind: FieldKindLocation},
+			{Name: "then_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeIndexAndWriteNode: {
+		Type: NodeTypeIndexAndWriteNode,
+		Name: "IndexAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "block", Kind: FieldKindOptionalNode, NodeType: NodeTypeBlockArgumentNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeIndexOperatorWriteNode: {
+		Type: NodeTypeIndexOperatorWriteNode,
+		Name: "IndexOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "block", Kind: FieldKindOptionalNode, NodeType: NodeTypeBlockArgumentNode},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeIndexOrWriteNode: {
+		Type: NodeTypeIndexOrWriteNode,
+		Name: "IndexOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindOptionalNode},
+			{Name: "call_operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "block", Kind: FieldKindOptionalNode, NodeType: NodeTypeBlockArgumentNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeIndexTargetNode: {
+		Type: NodeTypeIndexTargetNode,
+		Name: "IndexTargetNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: callNodeFlagsInfo},
+			{Name: "receiver", Kind: FieldKindNode},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "block", Kind: FieldKindOptionalNode, NodeType: NodeTypeBlockArgumentNode},
+		},
+	},
+	NodeTypeInstanceVariableAndWriteNode: {
+		Type: NodeTypeInstanceVariableAndWriteNode,
+		Name: "InstanceVariableAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeInstanceVariableOperatorWriteNode: {
+		Type: NodeTypeInstanceVariableOperatorWriteNode,
+		Name: "InstanceVariableOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeInstanceVariableOrWriteNode: {
+		Type: NodeTypeInstanceVariableOrWriteNode,
+		Name: "InstanceVariableOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeInstanceVariableReadNode: {
+		Type: NodeTypeInstanceVariableReadNode,
+		Name: "InstanceVariableReadNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeInstanceVariableTargetNode: {
+		Type: NodeTypeInstanceVariableTargetNode,
+		Name: "InstanceVariableTargetNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeInstanceVariableWriteNode: {
+		Type: NodeTypeInstanceVariableWriteNode,
+		Name: "InstanceVariableWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeIntegerNode: {
+		Type: NodeTypeIntegerNode,
+		Name: "IntegerNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: integerBaseFlagsInfo},
+			{Name: "value", Kind: FieldKindInteger},
+		},
+	},
+	NodeTypeInterpolatedMatchLastLineNode: {
+		Type: NodeTypeInterpolatedMatchLastLineNode,
+		Name: "InterpolatedMatchLastLineNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: regularExpressionFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "parts", Kind: FieldKindNodeList},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeInterpolatedRegularExpressionNode: {
+		Type: NodeTypeInterpolatedRegularExpressionNode,
+		Name: "InterpolatedRegularExpressionNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: regularExpressionFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "parts", Kind: FieldKindNodeList},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeInterpolatedStringNode: {
+		Type: NodeTypeInterpolatedStringNode,
+		Name: "InterpolatedStringNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: interpolatedStringNodeFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "parts", Kind: FieldKindNodeList},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeInterpolatedSymbolNode: {
+		Type: NodeTypeInterpolatedSymbolNode,
+		Name: "InterpolatedSymbolNode",
+		Fields: []FieldInfo{
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "parts", Kind: FieldKindNodeList},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeInterpolatedXStringNode: {
+		Type: NodeTypeInterpolatedXStringNode,
+		Name: "InterpolatedXStringNode",
+		Fields: []FieldInfo{
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "parts", Kind: FieldKindNodeList},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeItLocalVariableReadNode: {
+		Type:   NodeTypeItLocalVariableReadNode,
+		Name:   "ItLocalVariableReadNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeItParametersNode: {
+		Type:   NodeTypeItParametersNode,
+		Name:   "ItParametersNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeKeywordHashNode: {
+		Type: NodeTypeKeywordHashNode,
+		Name: "KeywordHashNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: keywordHashNodeFlagsInfo},
+			{Name: "elements", Kind: FieldKindNodeList},
+		},
+	},
+	NodeTypeKeywordRestParameterNode: {
+		Type: NodeTypeKeywordRestParameterNode,
+		Name: "KeywordRestParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindOptionalConstant},
+			{Name: "name_loc", Kind: FieldKindOptionalLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeLambdaNode: {
+		Type: NodeTypeLambdaNode,
+		Name: "LambdaNode",
+		Fields: []FieldInfo{
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "parameters", Kind: FieldKindOptionalNode},
+			{Name: "body", Kind: FieldKindOptionalNode},
+		},
+	},
+	NodeTypeLocalVariableAndWriteNode: {
+		Type: NodeTypeLocalVariableAndWriteNode,
+		Name: "LocalVariableAndWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "depth", Kind: FieldKindUInt32},
+		},
+	},
+	NodeTypeLocalVariableOperatorWriteNode: {
+		Type: NodeTypeLocalVariableOperatorWriteNode,
+		Name: "LocalVariableOperatorWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "binary_operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "binary_operator", Kind: FieldKindConstant},
+			{Name: "depth", Kind: FieldKindUInt32},
+		},
+	},
+	NodeTypeLocalVariableOrWriteNode: {
+		Type: NodeTypeLocalVariableOrWriteNode,
+		Name: "LocalVariableOrWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "depth", Kind: FieldKindUInt32},
+		},
+	},
+	NodeTypeLocalVariableReadNode: {
+		Type: NodeTypeLocalVariableReadNode,
+		Name: "LocalVariableReadNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "depth", Kind: FieldKindUInt32},
+		},
+	},
+	NodeTypeLocalVariableTargetNode: {
+		Type: NodeTypeLocalVariableTargetNode,
+		Name: "LocalVariableTargetNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "depth", Kind: FieldKindUInt32},
+		},
+	},
+	NodeTypeLocalVariableWriteNode: {
+		Type: NodeTypeLocalVariableWriteNode,
+		Name: "LocalVariableWriteNode",
+		Fields: []FieldInfo{
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "depth", Kind: FieldKindUInt32},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeMatchLastLineNode: {
+		Type: NodeTypeMatchLastLineNode,
+		Name: "MatchLastLineNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: regularExpressionFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "content_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "unescaped", Kind: FieldKindString},
+		},
+	},
+	NodeTypeMatchPredicateNode: {
+		Type: NodeTypeMatchPredicateNode,
+		Name: "MatchPredicateNode",
+		Fields: []FieldInfo{
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "pattern", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeMatchRequiredNode: {
+		Type: NodeTypeMatchRequiredNode,
+		Name: "MatchRequiredNode",
+		Fields: []FieldInfo{
+			{Name: "value", Kind: FieldKindNode},
+			{Name: "pattern", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeMatchWriteNode: {
+		Type: NodeTypeMatchWriteNode,
+		Name: "MatchWriteNode",
+		Fields: []FieldInfo{
+			{Name: "call", Kind: FieldKindNode, NodeType: NodeTypeCallNode},
+			{Name: "targets", Kind: FieldKindNodeList},
+		},
+	},
+	NodeTypeMissingNode: {
+		Type:   NodeTypeMissingNode,
+		Name:   "MissingNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeModuleNode: {
+		Type: NodeTypeModuleNode,
+		Name: "ModuleNode",
+		Fields: []FieldInfo{
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "module_keyword_loc", Kind: FieldKindLocation},
+			{Name: "constant_path", Kind: FieldKindNode},
+			{Name: "body", Kind: FieldKindOptionalNode},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeMultiTargetNode: {
+		Type: NodeTypeMultiTargetNode,
+		Name: "MultiTargetNode",
+		Fields: []FieldInfo{
+			{Name: "lefts", Kind: FieldKindNodeList},
+			{Name: "rest", Kind: FieldKindOptionalNode},
+			{Name: "rights", Kind: FieldKindNodeList},
+			{Name: "lparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "rparen_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeMultiWriteNode: {
+		Type: NodeTypeMultiWriteNode,
+		Name: "MultiWriteNode",
+		Fields: []FieldInfo{
+			{Name: "lefts", Kind: FieldKindNodeList},
+			{Name: "rest", Kind: FieldKindOptionalNode},
+			{Name: "rights", Kind: FieldKindNodeList},
+			{Name: "lparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "rparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeNextNode: {
+		Type: NodeTypeNextNode,
+		Name: "NextNode",
+		Fields: []FieldInfo{
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeNilNode: {
+		Type:   NodeTypeNilNode,
+		Name:   "NilNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeNoKeywordsParameterNode: {
+		Type: NodeTypeNoKeywordsParameterNode,
+		Name: "NoKeywordsParameterNode",
+		Fields: []FieldInfo{
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeNumberedParametersNode: {
+		Type: NodeTypeNumberedParametersNode,
+		Name: "NumberedParametersNode",
+		Fields: []FieldInfo{
+			{Name: "maximum", Kind: FieldKindUInt8},
+		},
+	},
+	NodeTypeNumberedReferenceReadNode: {
+		Type: NodeTypeNumberedReferenceReadNode,
+		Name: "NumberedReferenceReadNode",
+		Fields: []FieldInfo{
+			{Name: "number", Kind: FieldKindUInt32},
+		},
+	},
+	NodeTypeOptionalKeywordParameterNode: {
+		Type: NodeTypeOptionalKeywordParameterNode,
+		Name: "OptionalKeywordParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeOptionalParameterNode: {
+		Type: NodeTypeOptionalParameterNode,
+		Name: "OptionalParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "value", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeOrNode: {
+		Type: NodeTypeOrNode,
+		Name: "OrNode",
+		Fields: []FieldInfo{
+			{Name: "left", Kind: FieldKindNode},
+			{Name: "right", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeParametersNode: {
+		Type: NodeTypeParametersNode,
+		Name: "ParametersNode",
+		Fields: []FieldInfo{
+			{Name: "requireds", Kind: FieldKindNodeList},
+			{Name: "optionals", Kind: FieldKindNodeList},
+			{Name: "rest", Kind: FieldKindOptionalNode},
+			{Name: "posts", Kind: FieldKindNodeList},
+			{Name: "keywords", Kind: FieldKindNodeList},
+			{Name: "keyword_rest", Kind: FieldKindOptionalNode},
+			{Name: "block", Kind: FieldKindOptionalNode, NodeType: NodeTypeBlockParameterNode},
+		},
+	},
+	NodeTypeParenthesesNode: {
+		Type: NodeTypeParenthesesNode,
+		Name: "ParenthesesNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parenthesesNodeFlagsInfo},
+			{Name: "body", Kind: FieldKindOptionalNode},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypePinnedExpressionNode: {
+		Type: NodeTypePinnedExpressionNode,
+		Name: "PinnedExpressionNode",
+		Fields: []FieldInfo{
+			{Name: "expression", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "lparen_loc", Kind: FieldKindLocation},
+			{Name: "rparen_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypePinnedVariableNode: {
+		Type: NodeTypePinnedVariableNode,
+		Name: "PinnedVariableNode",
+		Fields: []FieldInfo{
+			{Name: "variable", Kind: FieldKindNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypePostExecutionNode: {
+		Type: NodeTypePostExecutionNode,
+		Name: "PostExecutionNode",
+		Fields: []FieldInfo{
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypePreExecutionNode: {
+		Type: NodeTypePreExecutionNode,
+		Name: "PreExecutionNode",
+		Fields: []FieldInfo{
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeProgramNode: {
+		Type: NodeTypeProgramNode,
+		Name: "ProgramNode",
+		Fields: []FieldInfo{
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "statements", Kind: FieldKindNode, NodeType: NodeTypeStatementsNode},
+		},
+	},
+	NodeTypeRangeNode: {
+		Type: NodeTypeRangeNode,
+		Name: "RangeNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: rangeFlagsInfo},
+			{Name: "left", Kind: FieldKindOptionalNode},
+			{Name: "right", Kind: FieldKindOptionalNode},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeRationalNode: {
+		Type: NodeTypeRationalNode,
+		Name: "RationalNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: integerBaseFlagsInfo},
+			{Name: "numerator", Kind: FieldKindInteger},
+			{Name: "denominator", Kind: FieldKindInteger},
+		},
+	},
+	NodeTypeRedoNode: {
+		Type:   NodeTypeRedoNode,
+		Name:   "RedoNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeRegularExpressionNode: {
+		Type: NodeTypeRegularExpressionNode,
+		Name: "RegularExpressionNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: regularExpressionFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "content_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "unescaped", Kind: FieldKindString},
+		},
+	},
+	NodeTypeRequiredKeywordParameterNode: {
+		Type: NodeTypeRequiredKeywordParameterNode,
+		Name: "RequiredKeywordParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindConstant},
+			{Name: "name_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeRequiredParameterNode: {
+		Type: NodeTypeRequiredParameterNode,
+		Name: "RequiredParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindConstant},
+		},
+	},
+	NodeTypeRescueModifierNode: {
+		Type: NodeTypeRescueModifierNode,
+		Name: "RescueModifierNode",
+		Fields: []FieldInfo{
+			{Name: "expression", Kind: FieldKindNode},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "rescue_expression", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeRescueNode: {
+		Type: NodeTypeRescueNode,
+		Name: "RescueNode",
+		Fields: []FieldInfo{
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "exceptions", Kind: FieldKindNodeList},
+			{Name: "operator_loc", Kind: FieldKindOptionalLocation},
+			{Name: "reference", Kind: FieldKindOptionalNode},
+			{Name: "then_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "subsequent", Kind: FieldKindOptionalNode, NodeType: NodeTypeRescueNode},
+		},
+	},
+	NodeTypeRestParameterNode: {
+		Type: NodeTypeRestParameterNode,
+		Name: "RestParameterNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: parameterFlagsInfo},
+			{Name: "name", Kind: FieldKindOptionalConstant},
+			{Name: "name_loc", Kind: FieldKindOptionalLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeRetryNode: {
+		Type:   NodeTypeRetryNode,
+		Name:   "RetryNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeReturnNode: {
+		Type: NodeTypeReturnNode,
+		Name: "ReturnNode",
+		Fields: []FieldInfo{
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+		},
+	},
+	NodeTypeSelfNode: {
+		Type:   NodeTypeSelfNode,
+		Name:   "SelfNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeShareableConstantNode: {
+		Type: NodeTypeShareableConstantNode,
+		Name: "ShareableConstantNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: shareableConstantNodeFlagsInfo},
+			{Name: "write", Kind: FieldKindNode},
+		},
+	},
+	NodeTypeSingletonClassNode: {
+		Type: NodeTypeSingletonClassNode,
+		Name: "SingletonClassNode",
+		Fields: []FieldInfo{
+			{Name: "locals", Kind: FieldKindConstantList},
+			{Name: "class_keyword_loc", Kind: FieldKindLocation},
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "expression", Kind: FieldKindNode},
+			{Name: "body", Kind: FieldKindOptionalNode},
+			{Name: "end_keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeSourceEncodingNode: {
+		Type:   NodeTypeSourceEncodingNode,
+		Name:   "SourceEncodingNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeSourceFileNode: {
+		Type: NodeTypeSourceFileNode,
+		Name: "SourceFileNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: stringFlagsInfo},
+			{Name: "filepath", Kind: FieldKindString},
+		},
+	},
+	NodeTypeSourceLineNode: {
+		Type:   NodeTypeSourceLineNode,
+		Name:   "SourceLineNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeSplatNode: {
+		Type: NodeTypeSplatNode,
+		Name: "SplatNode",
+		Fields: []FieldInfo{
+			{Name: "operator_loc", Kind: FieldKindLocation},
+			{Name: "expression", Kind: FieldKindOptionalNode},
+		},
+	},
+	NodeTypeStatementsNode: {
+		Type: NodeTypeStatementsNode,
+		Name: "StatementsNode",
+		Fields: []FieldInfo{
+			{Name: "body", Kind: FieldKindNodeList},
+		},
+	},
+	NodeTypeStringNode: {
+		Type: NodeTypeStringNode,
+		Name: "StringNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: stringFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "content_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+			{Name: "unescaped", Kind: FieldKindString},
+		},
+	},
+	NodeTypeSuperNode: {
+		Type: NodeTypeSuperNode,
+		Name: "SuperNode",
+		Fields: []FieldInfo{
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "lparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "rparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "block", Kind: FieldKindOptionalNode},
+		},
+	},
+	NodeTypeSymbolNode: {
+		Type: NodeTypeSymbolNode,
+		Name: "SymbolNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: symbolFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindOptionalLocation},
+			{Name: "value_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+			{Name: "unescaped", Kind: FieldKindString},
+		},
+	},
+	NodeTypeTrueNode: {
+		Type:   NodeTypeTrueNode,
+		Name:   "TrueNode",
+		Fields: []FieldInfo{},
+	},
+	NodeTypeUndefNode: {
+		Type: NodeTypeUndefNode,
+		Name: "UndefNode",
+		Fields: []FieldInfo{
+			{Name: "names", Kind: FieldKindNodeList},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+		},
+	},
+	NodeTypeUnlessNode: {
+		Type: NodeTypeUnlessNode,
+		Name: "UnlessNode",
+		Fields: []FieldInfo{
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "predicate", Kind: FieldKindNode},
+			{Name: "then_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+			{Name: "else_clause", Kind: FieldKindOptionalNode, NodeType: NodeTypeElseNode},
+			{Name: "end_keyword_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+	NodeTypeUntilNode: {
+		Type: NodeTypeUntilNode,
+		Name: "UntilNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: loopFlagsInfo},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "do_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+			{Name: "predicate", Kind: FieldKindNode},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+		},
+	},
+	NodeTypeWhenNode: {
+		Type: NodeTypeWhenNode,
+		Name: "WhenNode",
+		Fields: []FieldInfo{
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "conditions", Kind: FieldKindNodeList},
+			{Name: "then_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+		},
+	},
+	NodeTypeWhileNode: {
+		Type: NodeTypeWhileNode,
+		Name: "WhileNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: loopFlagsInfo},
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "do_keyword_loc", Kind: FieldKindOptionalLocation},
+			{Name: "closing_loc", Kind: FieldKindOptionalLocation},
+			{Name: "predicate", Kind: FieldKindNode},
+			{Name: "statements", Kind: FieldKindOptionalNode, NodeType: NodeTypeStatementsNode},
+		},
+	},
+	NodeTypeXStringNode: {
+		Type: NodeTypeXStringNode,
+		Name: "XStringNode",
+		Fields: []FieldInfo{
+			{Name: "flags", Kind: FieldKindFlags, Flags: encodingFlagsInfo},
+			{Name: "opening_loc", Kind: FieldKindLocation},
+			{Name: "content_loc", Kind: FieldKindLocation},
+			{Name: "closing_loc", Kind: FieldKindLocation},
+			{Name: "unescaped", Kind: FieldKindString},
+		},
+	},
+	NodeTypeYieldNode: {
+		Type: NodeTypeYieldNode,
+		Name: "YieldNode",
+		Fields: []FieldInfo{
+			{Name: "keyword_loc", Kind: FieldKindLocation},
+			{Name: "lparen_loc", Kind: FieldKindOptionalLocation},
+			{Name: "arguments", Kind: FieldKindOptionalNode, NodeType: NodeTypeArgumentsNode},
+			{Name: "rparen_loc", Kind: FieldKindOptionalLocation},
+		},
+	},
+}
@@ -8,6 +8,10 @@
 
 package parser
 
+import (
+	"encoding/json"
+)
+
 // Flags for arguments nodes.
 const (
 	ArgumentsNodeFlagsCONTAINS_FORWARDING      = 1 << 2
@@ -17,11 +21,37 @@ const (
 	ArgumentsNodeFlagsCONTAINS_MULTIPLE_SPLATS = 1 << 6
 )
 
+// ArgumentsNodeFlags is the set of flags for arguments nodes.
+type ArgumentsNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f ArgumentsNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f ArgumentsNodeFlags) String() string {
+	return formatFlags(uint32(f), argumentsNodeFlagsInfo)
+}
+
 // Flags for array nodes.
 const (
 	ArrayNodeFlagsCONTAINS_SPLAT = 1 << 2
 )
 
+// ArrayNodeFlags is the set of flags for array nodes.
+type ArrayNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f ArrayNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f ArrayNodeFlags) String() string {
+	return formatFlags(uint32(f), arrayNodeFlagsInfo)
+}
+
 // Flags for call nodes.
 const (
 	CallNodeFlagsSAFE_NAVIGATION   = 1 << 2
@@ -30,12 +60,38 @@ const (
 	CallNodeFlagsIGNORE_VISIBILITY = 1 << 5
 )
 
+// CallNodeFlags is the set of flags for call nodes.
+type CallNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f CallNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f CallNodeFlags) String() string {
+	return formatFlags(uint32(f), callNodeFlagsInfo)
+}
+
 // Flags for nodes that have unescaped content.
 const (
 	EncodingFlagsFORCED_UTF8_ENCODING   = 1 << 2
 	EncodingFlagsFORCED_BINARY_ENCODING = 1 << 3
 )
 
+// EncodingFlags is the set of flags for nodes that have unescaped content.
+type EncodingFlags uint32
+
+// Bits returns the raw flag bits.
+func (f EncodingFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f EncodingFlags) String() string {
+	return formatFlags(uint32(f), encodingFlagsInfo)
+}
+
 // Flags for integer nodes that correspond to the base of the integer.
 const (
 	IntegerBaseFlagsBINARY      = 1 << 2
@@ -44,37 +100,128 @@ const (
 	IntegerBaseFlagsHEXADECIMAL = 1 << 5
 )
 
+// IntegerBaseFlags is the set of flags for integer nodes that correspond to the base of the integer.
+type IntegerBaseFlags uint32
+
+// Bits returns the raw flag bits.
+func (f IntegerBaseFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f IntegerBaseFlags) String() string {
+	return formatFlags(uint32(f), integerBaseFlagsInfo)
+}
+
 // Flags for interpolated string nodes that indicated mutability if they are also marked as literals.
 const (
 	InterpolatedStringNodeFlagsFROZEN  = 1 << 2
 	InterpolatedStringNodeFlagsMUTABLE = 1 << 3
 )
 
+// InterpolatedStringNodeFlags is the set of flags for interpolated string nodes that indicated mutability if they are also marked as literals.
+type InterpolatedStringNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f InterpolatedStringNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f InterpolatedStringNodeFlags) String() string {
+	return formatFlags(uint32(f), interpolatedStringNodeFlagsInfo)
+}
+
 // Flags for keyword hash nodes.
 const (
 	KeywordHashNodeFlagsSYMBOL_KEYS = 1 << 2
 )
 
+// KeywordHashNodeFlags is the set of flags for keyword hash nodes.
+type KeywordHashNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f KeywordHashNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f KeywordHashNodeFlags) String() string {
+	return formatFlags(uint32(f), keywordHashNodeFlagsInfo)
+}
+
 // Flags for while and until loop nodes.
 const (
 	LoopFlagsBEGIN_MODIFIER = 1 << 2
 )
 
+// LoopFlags is the set of flags for while and until loop nodes.
+type LoopFlags uint32
+
+// Bits returns the raw flag bits.
+func (f LoopFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f LoopFlags) String() string {
+	return formatFlags(uint32(f), loopFlagsInfo)
+}
+
 // Flags for parameter nodes.
 const (
 	ParameterFlagsREPEATED_PARAMETER = 1 << 2
 )
 
+// ParameterFlags is the set of flags for parameter nodes.
+type ParameterFlags uint32
+
+// Bits returns the raw flag bits.
+func (f ParameterFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f ParameterFlags) String() string {
+	return formatFlags(uint32(f), parameterFlagsInfo)
+}
+
 // Flags for parentheses nodes.
 const (
 	ParenthesesNodeFlagsMULTIPLE_STATEMENTS = 1 << 2
 )
 
+// ParenthesesNodeFlags is the set of flags for parentheses nodes.
+type ParenthesesNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f ParenthesesNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f ParenthesesNodeFlags) String() string {
+	return formatFlags(uint32(f), parenthesesNodeFlagsInfo)
+}
+
 // Flags for range and flip-flop nodes.
 const (
 	RangeFlagsEXCLUDE_END = 1 << 2
 )
 
+// RangeFlags is the set of flags for range and flip-flop nodes.
+type RangeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f RangeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f RangeFlags) String() string {
+	return formatFlags(uint32(f), rangeFlagsInfo)
+}
+
 // Flags for regular expression and match last line nodes.
 const (
 	RegularExpressionFlagsIGNORE_CASE              = 1 << 2
@@ -90,6 +237,19 @@ const (
 	RegularExpressionFlagsFORCED_US_ASCII_ENCODING = 1 << 12
 )
 
+// RegularExpressionFlags is the set of flags for regular expression and match last line nodes.
+type RegularExpressionFlags uint32
+
+// Bits returns the raw flag bits.
+func (f RegularExpressionFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f RegularExpressionFlags) String() string {
+	return formatFlags(uint32(f), regularExpressionFlagsInfo)
+}
+
 // Flags for shareable constant nodes.
 const (
 	ShareableConstantNodeFlagsLITERAL                 = 1 << 2
@@ -97,6 +257,19 @@ const (
 	ShareableConstantNodeFlagsEXPERIMENTAL_COPY       = 1 << 4
 )
 
+// ShareableConstantNodeFlags is the set of flags for shareable constant nodes.
+type ShareableConstantNodeFlags uint32
+
+// Bits returns the raw flag bits.
+func (f ShareableConstantNodeFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f ShareableConstantNodeFlags) String() string {
+	return formatFlags(uint32(f), shareableConstantNodeFlagsInfo)
+}
+
 // Flags for string nodes.
 const (
 	StringFlagsFORCED_UTF8_ENCODING   = 1 << 2
@@ -105,6 +278,19 @@ const (
 	StringFlagsMUTABLE                = 1 << 5
 )
 
+// StringFlags is the set of flags for string nodes.
+type StringFlags uint32
+
+// Bits returns the raw flag bits.
+func (f StringFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f StringFlags) String() string {
+	return formatFlags(uint32(f), stringFlagsInfo)
+}
+
 // Flags for symbol nodes.
 const (
 	SymbolFlagsFORCED_UTF8_ENCODING     = 1 << 2
@@ -112,6 +298,19 @@ const (
 	SymbolFlagsFORCED_US_ASCII_ENCODING = 1 << 4
 )
 
+// SymbolFlags is the set of flags for symbol nodes.
+type SymbolFlags uint32
+
+// Bits returns the raw flag bits.
+func (f SymbolFlags) Bits() uint32 {
+	return uint32(f)
+}
+
+// String returns the names of the set flags separated by "|".
+func (f SymbolFlags) String() string {
+	return formatFlags(uint32(f), symbolFlagsInfo)
+}
+
 // Location represents a location in the source code.
 type Location struct {
 	StartOffset int `json:"startOffset"`
@@ -133,6 +332,13 @@ type Node interface {
 	ToJSON() map[string]interface{}
 	GetLocation() Location
 	GetNodeID() int
+	Type() NodeType
+	Flags() FlagSet
+	IsNewline() bool
+	IsStaticLiteral() bool
+	NamedChildren() []NamedChild
+	SetChild(name string, child Node) error
+	SetChildList(name string, children []Node) error
 }
 
 // Represents the use of the `alias` keyword to alias a global variable.
@@ -170,6 +376,26 @@ func (n *AliasGlobalVariableNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *AliasGlobalVariableNode) Type() NodeType {
+	return NodeTypeAliasGlobalVariableNode
+}
+
+// Flags returns the flags set on this node.
+func (n *AliasGlobalVariableNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *AliasGlobalVariableNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *AliasGlobalVariableNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *AliasGlobalVariableNode) Accept(visitor Visitor) {
 	visitor.VisitAliasGlobalVariableNode(n)
@@ -191,6 +417,40 @@ func (n *AliasGlobalVariableNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *AliasGlobalVariableNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "new_name", Kind: FieldKindNode, Node: n.NewName},
+		{Name: "old_name", Kind: FieldKindNode, Node: n.OldName},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *AliasGlobalVariableNode) SetChild(name string, child Node) error {
+	switch name {
+	case "new_name":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.NewName = value
+	case "old_name":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.OldName = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *AliasGlobalVariableNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *AliasGlobalVariableNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -203,6 +463,15 @@ func (n *AliasGlobalVariableNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *AliasGlobalVariableNode) MarshalJSON() ([]byte, error) {
+	type node AliasGlobalVariableNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `alias` keyword to alias a method.
 //
 //	alias foo bar
@@ -238,6 +507,26 @@ func (n *AliasMethodNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *AliasMethodNode) Type() NodeType {
+	return NodeTypeAliasMethodNode
+}
+
+// Flags returns the flags set on this node.
+func (n *AliasMethodNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *AliasMethodNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *AliasMethodNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *AliasMethodNode) Accept(visitor Visitor) {
 	visitor.VisitAliasMethodNode(n)
@@ -259,6 +548,40 @@ func (n *AliasMethodNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *AliasMethodNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "new_name", Kind: FieldKindNode, Node: n.NewName},
+		{Name: "old_name", Kind: FieldKindNode, Node: n.OldName},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *AliasMethodNode) SetChild(name string, child Node) error {
+	switch name {
+	case "new_name":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.NewName = value
+	case "old_name":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.OldName = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *AliasMethodNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *AliasMethodNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -271,6 +594,15 @@ func (n *AliasMethodNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *AliasMethodNode) MarshalJSON() ([]byte, error) {
+	type node AliasMethodNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an alternation pattern in pattern matching.
 //
 //	foo => bar | baz
@@ -306,6 +638,26 @@ func (n *AlternationPatternNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *AlternationPatternNode) Type() NodeType {
+	return NodeTypeAlternationPatternNode
+}
+
+// Flags returns the flags set on this node.
+func (n *AlternationPatternNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *AlternationPatternNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *AlternationPatternNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *AlternationPatternNode) Accept(visitor Visitor) {
 	visitor.VisitAlternationPatternNode(n)
@@ -327,6 +679,40 @@ func (n *AlternationPatternNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *AlternationPatternNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "left", Kind: FieldKindNode, Node: n.Left},
+		{Name: "right", Kind: FieldKindNode, Node: n.Right},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *AlternationPatternNode) SetChild(name string, child Node) error {
+	switch name {
+	case "left":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Left = value
+	case "right":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Right = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *AlternationPatternNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *AlternationPatternNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -339,6 +725,15 @@ func (n *AlternationPatternNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *AlternationPatternNode) MarshalJSON() ([]byte, error) {
+	type node AlternationPatternNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&` operator or the `and` keyword.
 //
 //	left and right
@@ -374,6 +769,26 @@ func (n *AndNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *AndNode) Type() NodeType {
+	return NodeTypeAndNode
+}
+
+// Flags returns the flags set on this node.
+func (n *AndNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *AndNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *AndNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *AndNode) Accept(visitor Visitor) {
 	visitor.VisitAndNode(n)
@@ -395,6 +810,40 @@ func (n *AndNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *AndNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "left", Kind: FieldKindNode, Node: n.Left},
+		{Name: "right", Kind: FieldKindNode, Node: n.Right},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *AndNode) SetChild(name string, child Node) error {
+	switch name {
+	case "left":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Left = value
+	case "right":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Right = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *AndNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *AndNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -407,6 +856,15 @@ func (n *AndNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *AndNode) MarshalJSON() ([]byte, error) {
+	type node AndNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a set of arguments to a method or a keyword.
 //
 //	return foo, bar, baz
@@ -438,6 +896,26 @@ func (n *ArgumentsNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ArgumentsNode) Type() NodeType {
+	return NodeTypeArgumentsNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ArgumentsNode) Flags() FlagSet {
+	return ArgumentsNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ArgumentsNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ArgumentsNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsCONTAINS_FORWARDING returns true if this node has the CONTAINS_FORWARDING flag.
 func (n *ArgumentsNode) IsCONTAINS_FORWARDING() bool {
 	return (n.flags & ArgumentsNodeFlagsCONTAINS_FORWARDING) != 0
@@ -482,6 +960,29 @@ func (n *ArgumentsNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ArgumentsNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "arguments", Kind: FieldKindNodeList, Nodes: n.Arguments},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ArgumentsNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ArgumentsNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "arguments":
+		n.Arguments = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ArgumentsNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -492,6 +993,15 @@ func (n *ArgumentsNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ArgumentsNode) MarshalJSON() ([]byte, error) {
+	type node ArgumentsNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an array literal. This can be a regular array using brackets or a special array using % like %w or %i.
 //
 //	[1, 2, 3]
@@ -527,6 +1037,26 @@ func (n *ArrayNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ArrayNode) Type() NodeType {
+	return NodeTypeArrayNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ArrayNode) Flags() FlagSet {
+	return ArrayNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ArrayNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ArrayNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsCONTAINS_SPLAT returns true if this node has the CONTAINS_SPLAT flag.
 func (n *ArrayNode) IsCONTAINS_SPLAT() bool {
 	return (n.flags & ArrayNodeFlagsCONTAINS_SPLAT) != 0
@@ -551,6 +1081,29 @@ func (n *ArrayNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ArrayNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "elements", Kind: FieldKindNodeList, Nodes: n.Elements},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ArrayNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ArrayNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "elements":
+		n.Elements = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ArrayNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -563,6 +1116,15 @@ func (n *ArrayNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ArrayNode) MarshalJSON() ([]byte, error) {
+	type node ArrayNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an array pattern in pattern matching.
 //
 //	foo in 1, 2
@@ -616,6 +1178,26 @@ func (n *ArrayPatternNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ArrayPatternNode) Type() NodeType {
+	return NodeTypeArrayPatternNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ArrayPatternNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ArrayPatternNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ArrayPatternNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ArrayPatternNode) Accept(visitor Visitor) {
 	visitor.VisitArrayPatternNode(n)
@@ -649,6 +1231,50 @@ func (n *ArrayPatternNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ArrayPatternNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "constant", Kind: FieldKindOptionalNode, Node: n.Constant},
+		{Name: "requireds", Kind: FieldKindNodeList, Nodes: n.Requireds},
+		{Name: "rest", Kind: FieldKindOptionalNode, Node: n.Rest},
+		{Name: "posts", Kind: FieldKindNodeList, Nodes: n.Posts},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ArrayPatternNode) SetChild(name string, child Node) error {
+	switch name {
+	case "constant":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Constant = value
+	case "rest":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Rest = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ArrayPatternNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "requireds":
+		n.Requireds = children
+	case "posts":
+		n.Posts = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ArrayPatternNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -664,6 +1290,15 @@ func (n *ArrayPatternNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ArrayPatternNode) MarshalJSON() ([]byte, error) {
+	type node ArrayPatternNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a hash key/value pair.
 //
 //	{ a => b }
@@ -699,6 +1334,26 @@ func (n *AssocNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *AssocNode) Type() NodeType {
+	return NodeTypeAssocNode
+}
+
+// Flags returns the flags set on this node.
+func (n *AssocNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *AssocNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *AssocNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *AssocNode) Accept(visitor Visitor) {
 	visitor.VisitAssocNode(n)
@@ -720,6 +1375,40 @@ func (n *AssocNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *AssocNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "key", Kind: FieldKindNode, Node: n.Key},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *AssocNode) SetChild(name string, child Node) error {
+	switch name {
+	case "key":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Key = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *AssocNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *AssocNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -732,6 +1421,15 @@ func (n *AssocNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *AssocNode) MarshalJSON() ([]byte, error) {
+	type node AssocNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a splat in a hash literal.
 //
 //	{ **foo }
@@ -765,6 +1463,26 @@ func (n *AssocSplatNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *AssocSplatNode) Type() NodeType {
+	return NodeTypeAssocSplatNode
+}
+
+// Flags returns the flags set on this node.
+func (n *AssocSplatNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *AssocSplatNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *AssocSplatNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *AssocSplatNode) Accept(visitor Visitor) {
 	visitor.VisitAssocSplatNode(n)
@@ -788,6 +1506,33 @@ func (n *AssocSplatNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *AssocSplatNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindOptionalNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *AssocSplatNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *AssocSplatNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *AssocSplatNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -799,6 +1544,15 @@ func (n *AssocSplatNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *AssocSplatNode) MarshalJSON() ([]byte, error) {
+	type node AssocSplatNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents reading a reference to a field in the previous match.
 //
 //	$'
@@ -830,6 +1584,26 @@ func (n *BackReferenceReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BackReferenceReadNode) Type() NodeType {
+	return NodeTypeBackReferenceReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BackReferenceReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BackReferenceReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BackReferenceReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *BackReferenceReadNode) Accept(visitor Visitor) {
 	visitor.VisitBackReferenceReadNode(n)
@@ -847,6 +1621,21 @@ func (n *BackReferenceReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BackReferenceReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BackReferenceReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BackReferenceReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BackReferenceReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -857,6 +1646,15 @@ func (n *BackReferenceReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BackReferenceReadNode) MarshalJSON() ([]byte, error) {
+	type node BackReferenceReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a begin statement.
 //
 //	begin
@@ -900,6 +1698,26 @@ func (n *BeginNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BeginNode) Type() NodeType {
+	return NodeTypeBeginNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BeginNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BeginNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BeginNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *BeginNode) Accept(visitor Visitor) {
 	visitor.VisitBeginNode(n)
@@ -941,6 +1759,54 @@ func (n *BeginNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BeginNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+		{Name: "rescue_clause", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.RescueClause)},
+		{Name: "else_clause", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.ElseClause)},
+		{Name: "ensure_clause", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.EnsureClause)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BeginNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	case "rescue_clause":
+		value, err := castChild[*RescueNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.RescueClause = value
+	case "else_clause":
+		value, err := castChild[*ElseNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.ElseClause = value
+	case "ensure_clause":
+		value, err := castChild[*EnsureNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.EnsureClause = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BeginNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BeginNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -956,6 +1822,15 @@ func (n *BeginNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BeginNode) MarshalJSON() ([]byte, error) {
+	type node BeginNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a block argument using `&`.
 //
 //	bar(&args)
@@ -989,6 +1864,26 @@ func (n *BlockArgumentNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BlockArgumentNode) Type() NodeType {
+	return NodeTypeBlockArgumentNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BlockArgumentNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BlockArgumentNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BlockArgumentNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *BlockArgumentNode) Accept(visitor Visitor) {
 	visitor.VisitBlockArgumentNode(n)
@@ -1012,6 +1907,33 @@ func (n *BlockArgumentNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BlockArgumentNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "expression", Kind: FieldKindOptionalNode, Node: n.Expression},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BlockArgumentNode) SetChild(name string, child Node) error {
+	switch name {
+	case "expression":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Expression = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BlockArgumentNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BlockArgumentNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1023,6 +1945,15 @@ func (n *BlockArgumentNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BlockArgumentNode) MarshalJSON() ([]byte, error) {
+	type node BlockArgumentNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a block local variable.
 //
 //	a { |; b| }
@@ -1054,6 +1985,26 @@ func (n *BlockLocalVariableNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BlockLocalVariableNode) Type() NodeType {
+	return NodeTypeBlockLocalVariableNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BlockLocalVariableNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BlockLocalVariableNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BlockLocalVariableNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *BlockLocalVariableNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -1076,6 +2027,21 @@ func (n *BlockLocalVariableNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BlockLocalVariableNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BlockLocalVariableNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BlockLocalVariableNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BlockLocalVariableNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1086,6 +2052,15 @@ func (n *BlockLocalVariableNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BlockLocalVariableNode) MarshalJSON() ([]byte, error) {
+	type node BlockLocalVariableNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a block of ruby code.
 //
 //	[1, 2, 3].each { |i| puts x }
@@ -1125,6 +2100,26 @@ func (n *BlockNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BlockNode) Type() NodeType {
+	return NodeTypeBlockNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BlockNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BlockNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BlockNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *BlockNode) Accept(visitor Visitor) {
 	visitor.VisitBlockNode(n)
@@ -1154,6 +2149,40 @@ func (n *BlockNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BlockNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parameters", Kind: FieldKindOptionalNode, Node: n.Parameters},
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BlockNode) SetChild(name string, child Node) error {
+	switch name {
+	case "parameters":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Parameters = value
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BlockNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BlockNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1168,6 +2197,15 @@ func (n *BlockNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BlockNode) MarshalJSON() ([]byte, error) {
+	type node BlockNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a block parameter of a method, block, or lambda definition.
 //
 //	def a(&b)
@@ -1204,6 +2242,26 @@ func (n *BlockParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BlockParameterNode) Type() NodeType {
+	return NodeTypeBlockParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BlockParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BlockParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BlockParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *BlockParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -1226,6 +2284,21 @@ func (n *BlockParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BlockParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BlockParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BlockParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BlockParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1238,6 +2311,15 @@ func (n *BlockParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BlockParameterNode) MarshalJSON() ([]byte, error) {
+	type node BlockParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a block's parameters declaration.
 //
 //	-> (a, b = 1; local) { }
@@ -1279,6 +2361,26 @@ func (n *BlockParametersNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BlockParametersNode) Type() NodeType {
+	return NodeTypeBlockParametersNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BlockParametersNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BlockParametersNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BlockParametersNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *BlockParametersNode) Accept(visitor Visitor) {
 	visitor.VisitBlockParametersNode(n)
@@ -1304,6 +2406,40 @@ func (n *BlockParametersNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BlockParametersNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parameters", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Parameters)},
+		{Name: "locals", Kind: FieldKindNodeList, Nodes: n.Locals},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BlockParametersNode) SetChild(name string, child Node) error {
+	switch name {
+	case "parameters":
+		value, err := castChild[*ParametersNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Parameters = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BlockParametersNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "locals":
+		n.Locals = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BlockParametersNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1317,6 +2453,15 @@ func (n *BlockParametersNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BlockParametersNode) MarshalJSON() ([]byte, error) {
+	type node BlockParametersNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `break` keyword.
 //
 //	break foo
@@ -1350,6 +2495,26 @@ func (n *BreakNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *BreakNode) Type() NodeType {
+	return NodeTypeBreakNode
+}
+
+// Flags returns the flags set on this node.
+func (n *BreakNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *BreakNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *BreakNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *BreakNode) Accept(visitor Visitor) {
 	visitor.VisitBreakNode(n)
@@ -1373,6 +2538,33 @@ func (n *BreakNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *BreakNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *BreakNode) SetChild(name string, child Node) error {
+	switch name {
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *BreakNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *BreakNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1384,6 +2576,15 @@ func (n *BreakNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *BreakNode) MarshalJSON() ([]byte, error) {
+	type node BreakNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator on a call.
 //
 //	foo.bar &&= value
@@ -1427,6 +2628,26 @@ func (n *CallAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CallAndWriteNode) Type() NodeType {
+	return NodeTypeCallAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CallAndWriteNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CallAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CallAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *CallAndWriteNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -1472,6 +2693,40 @@ func (n *CallAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CallAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CallAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CallAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CallAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1488,6 +2743,15 @@ func (n *CallAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CallAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node CallAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a method call, in all of the various forms that can take.
 //
 //	foo
@@ -1538,14 +2802,34 @@ func NewCallNode(nodeID int, location Location, flags uint32, receiver Node, cal
 	}
 }
 
-// GetLocation returns the location of this node.
-func (n *CallNode) GetLocation() Location {
-	return n.Location
+// GetLocation returns the location of this node.
+func (n *CallNode) GetLocation() Location {
+	return n.Location
+}
+
+// GetNodeID returns the node ID of this node.
+func (n *CallNode) GetNodeID() int {
+	return n.NodeID
+}
+
+// Type returns the type of this node.
+func (n *CallNode) Type() NodeType {
+	return NodeTypeCallNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CallNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CallNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
 }
 
-// GetNodeID returns the node ID of this node.
-func (n *CallNode) GetNodeID() int {
-	return n.NodeID
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CallNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
 }
 
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
@@ -1603,6 +2887,47 @@ func (n *CallNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CallNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: n.Block},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CallNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	case "block":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CallNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CallNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1620,6 +2945,15 @@ func (n *CallNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CallNode) MarshalJSON() ([]byte, error) {
+	type node CallNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of an assignment operator on a call.
 //
 //	foo.bar += baz
@@ -1665,6 +2999,26 @@ func (n *CallOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CallOperatorWriteNode) Type() NodeType {
+	return NodeTypeCallOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CallOperatorWriteNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CallOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CallOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *CallOperatorWriteNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -1710,6 +3064,40 @@ func (n *CallOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CallOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CallOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CallOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CallOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1727,6 +3115,15 @@ func (n *CallOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CallOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node CallOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator on a call.
 //
 //	foo.bar ||= value
@@ -1770,6 +3167,26 @@ func (n *CallOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CallOrWriteNode) Type() NodeType {
+	return NodeTypeCallOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CallOrWriteNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CallOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CallOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *CallOrWriteNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -1815,6 +3232,40 @@ func (n *CallOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CallOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CallOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CallOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CallOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1831,6 +3282,15 @@ func (n *CallOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CallOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node CallOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a method call.
 //
 //	foo.bar, = 1
@@ -1876,6 +3336,26 @@ func (n *CallTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CallTargetNode) Type() NodeType {
+	return NodeTypeCallTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CallTargetNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CallTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CallTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *CallTargetNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -1915,6 +3395,33 @@ func (n *CallTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CallTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindNode, Node: n.Receiver},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CallTargetNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CallTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CallTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1928,6 +3435,15 @@ func (n *CallTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CallTargetNode) MarshalJSON() ([]byte, error) {
+	type node CallTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a local variable in pattern matching.
 //
 //	foo => [bar => baz]
@@ -1963,6 +3479,26 @@ func (n *CapturePatternNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CapturePatternNode) Type() NodeType {
+	return NodeTypeCapturePatternNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CapturePatternNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CapturePatternNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CapturePatternNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *CapturePatternNode) Accept(visitor Visitor) {
 	visitor.VisitCapturePatternNode(n)
@@ -1984,6 +3520,40 @@ func (n *CapturePatternNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CapturePatternNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+		{Name: "target", Kind: FieldKindNode, Node: nodeOrNil(n.Target)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CapturePatternNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	case "target":
+		value, err := castChild[*LocalVariableTargetNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Target = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CapturePatternNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CapturePatternNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -1996,6 +3566,15 @@ func (n *CapturePatternNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CapturePatternNode) MarshalJSON() ([]byte, error) {
+	type node CapturePatternNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of a case statement for pattern matching.
 //
 //	case true
@@ -2037,6 +3616,26 @@ func (n *CaseMatchNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CaseMatchNode) Type() NodeType {
+	return NodeTypeCaseMatchNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CaseMatchNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CaseMatchNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CaseMatchNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *CaseMatchNode) Accept(visitor Visitor) {
 	visitor.VisitCaseMatchNode(n)
@@ -2068,6 +3667,47 @@ func (n *CaseMatchNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CaseMatchNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "predicate", Kind: FieldKindOptionalNode, Node: n.Predicate},
+		{Name: "conditions", Kind: FieldKindNodeList, Nodes: n.Conditions},
+		{Name: "else_clause", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.ElseClause)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CaseMatchNode) SetChild(name string, child Node) error {
+	switch name {
+	case "predicate":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Predicate = value
+	case "else_clause":
+		value, err := castChild[*ElseNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.ElseClause = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CaseMatchNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "conditions":
+		n.Conditions = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CaseMatchNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2082,6 +3722,15 @@ func (n *CaseMatchNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CaseMatchNode) MarshalJSON() ([]byte, error) {
+	type node CaseMatchNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of a case statement.
 //
 //	case true
@@ -2123,6 +3772,26 @@ func (n *CaseNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *CaseNode) Type() NodeType {
+	return NodeTypeCaseNode
+}
+
+// Flags returns the flags set on this node.
+func (n *CaseNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *CaseNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *CaseNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *CaseNode) Accept(visitor Visitor) {
 	visitor.VisitCaseNode(n)
@@ -2154,6 +3823,47 @@ func (n *CaseNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *CaseNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "predicate", Kind: FieldKindOptionalNode, Node: n.Predicate},
+		{Name: "conditions", Kind: FieldKindNodeList, Nodes: n.Conditions},
+		{Name: "else_clause", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.ElseClause)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *CaseNode) SetChild(name string, child Node) error {
+	switch name {
+	case "predicate":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Predicate = value
+	case "else_clause":
+		value, err := castChild[*ElseNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.ElseClause = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *CaseNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "conditions":
+		n.Conditions = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *CaseNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2168,6 +3878,15 @@ func (n *CaseNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *CaseNode) MarshalJSON() ([]byte, error) {
+	type node CaseNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a class declaration involving the `class` keyword.
 //
 //	class Foo end
@@ -2213,6 +3932,26 @@ func (n *ClassNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassNode) Type() NodeType {
+	return NodeTypeClassNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassNode) Accept(visitor Visitor) {
 	visitor.VisitClassNode(n)
@@ -2244,6 +3983,47 @@ func (n *ClassNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "constant_path", Kind: FieldKindNode, Node: n.ConstantPath},
+		{Name: "superclass", Kind: FieldKindOptionalNode, Node: n.Superclass},
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassNode) SetChild(name string, child Node) error {
+	switch name {
+	case "constant_path":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.ConstantPath = value
+	case "superclass":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Superclass = value
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2261,6 +4041,15 @@ func (n *ClassNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassNode) MarshalJSON() ([]byte, error) {
+	type node ClassNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator for assignment to a class variable.
 //
 //	@@target &&= value
@@ -2298,6 +4087,26 @@ func (n *ClassVariableAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassVariableAndWriteNode) Type() NodeType {
+	return NodeTypeClassVariableAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassVariableAndWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassVariableAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassVariableAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassVariableAndWriteNode) Accept(visitor Visitor) {
 	visitor.VisitClassVariableAndWriteNode(n)
@@ -2317,6 +4126,33 @@ func (n *ClassVariableAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassVariableAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassVariableAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassVariableAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassVariableAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2330,6 +4166,15 @@ func (n *ClassVariableAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassVariableAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node ClassVariableAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a class variable using an operator that isn't `=`.
 //
 //	@@target += value
@@ -2369,6 +4214,26 @@ func (n *ClassVariableOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassVariableOperatorWriteNode) Type() NodeType {
+	return NodeTypeClassVariableOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassVariableOperatorWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassVariableOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassVariableOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassVariableOperatorWriteNode) Accept(visitor Visitor) {
 	visitor.VisitClassVariableOperatorWriteNode(n)
@@ -2388,6 +4253,33 @@ func (n *ClassVariableOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassVariableOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassVariableOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2402,6 +4294,15 @@ func (n *ClassVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node ClassVariableOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator for assignment to a class variable.
 //
 //	@@target ||= value
@@ -2439,6 +4340,26 @@ func (n *ClassVariableOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassVariableOrWriteNode) Type() NodeType {
+	return NodeTypeClassVariableOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassVariableOrWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassVariableOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassVariableOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassVariableOrWriteNode) Accept(visitor Visitor) {
 	visitor.VisitClassVariableOrWriteNode(n)
@@ -2458,6 +4379,33 @@ func (n *ClassVariableOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassVariableOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassVariableOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassVariableOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassVariableOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2471,6 +4419,15 @@ func (n *ClassVariableOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassVariableOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node ClassVariableOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents referencing a class variable.
 //
 //	@@foo
@@ -2502,6 +4459,26 @@ func (n *ClassVariableReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassVariableReadNode) Type() NodeType {
+	return NodeTypeClassVariableReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassVariableReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassVariableReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassVariableReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassVariableReadNode) Accept(visitor Visitor) {
 	visitor.VisitClassVariableReadNode(n)
@@ -2519,6 +4496,21 @@ func (n *ClassVariableReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassVariableReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassVariableReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassVariableReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassVariableReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2529,6 +4521,15 @@ func (n *ClassVariableReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassVariableReadNode) MarshalJSON() ([]byte, error) {
+	type node ClassVariableReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a class variable in a context that doesn't have an explicit value.
 //
 //	@@foo, @@bar = baz
@@ -2560,6 +4561,26 @@ func (n *ClassVariableTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassVariableTargetNode) Type() NodeType {
+	return NodeTypeClassVariableTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassVariableTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassVariableTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassVariableTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassVariableTargetNode) Accept(visitor Visitor) {
 	visitor.VisitClassVariableTargetNode(n)
@@ -2577,6 +4598,21 @@ func (n *ClassVariableTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassVariableTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassVariableTargetNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassVariableTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassVariableTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2587,6 +4623,15 @@ func (n *ClassVariableTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassVariableTargetNode) MarshalJSON() ([]byte, error) {
+	type node ClassVariableTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a class variable.
 //
 //	@@foo = 1
@@ -2624,6 +4669,26 @@ func (n *ClassVariableWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ClassVariableWriteNode) Type() NodeType {
+	return NodeTypeClassVariableWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ClassVariableWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ClassVariableWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ClassVariableWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ClassVariableWriteNode) Accept(visitor Visitor) {
 	visitor.VisitClassVariableWriteNode(n)
@@ -2643,6 +4708,33 @@ func (n *ClassVariableWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ClassVariableWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ClassVariableWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ClassVariableWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ClassVariableWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2656,6 +4748,15 @@ func (n *ClassVariableWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ClassVariableWriteNode) MarshalJSON() ([]byte, error) {
+	type node ClassVariableWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator for assignment to a constant.
 //
 //	Target &&= value
@@ -2693,6 +4794,26 @@ func (n *ConstantAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantAndWriteNode) Type() NodeType {
+	return NodeTypeConstantAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantAndWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantAndWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantAndWriteNode(n)
@@ -2712,6 +4833,33 @@ func (n *ConstantAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2725,6 +4873,15 @@ func (n *ConstantAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a constant using an operator that isn't `=`.
 //
 //	Target += value
@@ -2764,6 +4921,26 @@ func (n *ConstantOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantOperatorWriteNode) Type() NodeType {
+	return NodeTypeConstantOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantOperatorWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantOperatorWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantOperatorWriteNode(n)
@@ -2783,6 +4960,33 @@ func (n *ConstantOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2797,6 +5001,15 @@ func (n *ConstantOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator for assignment to a constant.
 //
 //	Target ||= value
@@ -2834,6 +5047,26 @@ func (n *ConstantOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantOrWriteNode) Type() NodeType {
+	return NodeTypeConstantOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantOrWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantOrWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantOrWriteNode(n)
@@ -2853,6 +5086,33 @@ func (n *ConstantOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2866,6 +5126,15 @@ func (n *ConstantOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator for assignment to a constant path.
 //
 //	Parent::Child &&= value
@@ -2901,6 +5170,26 @@ func (n *ConstantPathAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantPathAndWriteNode) Type() NodeType {
+	return NodeTypeConstantPathAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantPathAndWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantPathAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantPathAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantPathAndWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantPathAndWriteNode(n)
@@ -2922,6 +5211,40 @@ func (n *ConstantPathAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantPathAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "target", Kind: FieldKindNode, Node: nodeOrNil(n.Target)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantPathAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "target":
+		value, err := castChild[*ConstantPathNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Target = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantPathAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantPathAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -2934,6 +5257,15 @@ func (n *ConstantPathAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantPathAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantPathAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents accessing a constant through a path of `::` operators.
 //
 //	Foo::Bar
@@ -2971,6 +5303,26 @@ func (n *ConstantPathNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantPathNode) Type() NodeType {
+	return NodeTypeConstantPathNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantPathNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantPathNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantPathNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantPathNode) Accept(visitor Visitor) {
 	visitor.VisitConstantPathNode(n)
@@ -2994,6 +5346,33 @@ func (n *ConstantPathNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantPathNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parent", Kind: FieldKindOptionalNode, Node: n.Parent},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantPathNode) SetChild(name string, child Node) error {
+	switch name {
+	case "parent":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Parent = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantPathNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantPathNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3007,6 +5386,15 @@ func (n *ConstantPathNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantPathNode) MarshalJSON() ([]byte, error) {
+	type node ConstantPathNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a constant path using an operator that isn't `=`.
 //
 //	Parent::Child += value
@@ -3044,6 +5432,26 @@ func (n *ConstantPathOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantPathOperatorWriteNode) Type() NodeType {
+	return NodeTypeConstantPathOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantPathOperatorWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantPathOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantPathOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantPathOperatorWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantPathOperatorWriteNode(n)
@@ -3065,6 +5473,40 @@ func (n *ConstantPathOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantPathOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "target", Kind: FieldKindNode, Node: nodeOrNil(n.Target)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantPathOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "target":
+		value, err := castChild[*ConstantPathNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Target = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantPathOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantPathOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3078,6 +5520,15 @@ func (n *ConstantPathOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantPathOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantPathOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator for assignment to a constant path.
 //
 //	Parent::Child ||= value
@@ -3113,6 +5564,26 @@ func (n *ConstantPathOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantPathOrWriteNode) Type() NodeType {
+	return NodeTypeConstantPathOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantPathOrWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantPathOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantPathOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantPathOrWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantPathOrWriteNode(n)
@@ -3134,6 +5605,40 @@ func (n *ConstantPathOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantPathOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "target", Kind: FieldKindNode, Node: nodeOrNil(n.Target)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantPathOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "target":
+		value, err := castChild[*ConstantPathNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Target = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantPathOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantPathOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3146,6 +5651,15 @@ func (n *ConstantPathOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantPathOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantPathOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a constant path in a context that doesn't have an explicit value.
 //
 //	Foo::Foo, Bar::Bar = baz
@@ -3183,6 +5697,26 @@ func (n *ConstantPathTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantPathTargetNode) Type() NodeType {
+	return NodeTypeConstantPathTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantPathTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantPathTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantPathTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantPathTargetNode) Accept(visitor Visitor) {
 	visitor.VisitConstantPathTargetNode(n)
@@ -3206,6 +5740,33 @@ func (n *ConstantPathTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantPathTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parent", Kind: FieldKindOptionalNode, Node: n.Parent},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantPathTargetNode) SetChild(name string, child Node) error {
+	switch name {
+	case "parent":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Parent = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantPathTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantPathTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3219,6 +5780,15 @@ func (n *ConstantPathTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantPathTargetNode) MarshalJSON() ([]byte, error) {
+	type node ConstantPathTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a constant path.
 //
 //	::Foo = 1
@@ -3260,6 +5830,26 @@ func (n *ConstantPathWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantPathWriteNode) Type() NodeType {
+	return NodeTypeConstantPathWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantPathWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantPathWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantPathWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantPathWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantPathWriteNode(n)
@@ -3281,6 +5871,40 @@ func (n *ConstantPathWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantPathWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "target", Kind: FieldKindNode, Node: nodeOrNil(n.Target)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantPathWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "target":
+		value, err := castChild[*ConstantPathNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Target = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantPathWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantPathWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3293,6 +5917,15 @@ func (n *ConstantPathWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantPathWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantPathWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents referencing a constant.
 //
 //	Foo
@@ -3324,6 +5957,26 @@ func (n *ConstantReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantReadNode) Type() NodeType {
+	return NodeTypeConstantReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantReadNode) Accept(visitor Visitor) {
 	visitor.VisitConstantReadNode(n)
@@ -3341,6 +5994,21 @@ func (n *ConstantReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3351,6 +6019,15 @@ func (n *ConstantReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantReadNode) MarshalJSON() ([]byte, error) {
+	type node ConstantReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a constant in a context that doesn't have an explicit value.
 //
 //	Foo, Bar = baz
@@ -3382,6 +6059,26 @@ func (n *ConstantTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantTargetNode) Type() NodeType {
+	return NodeTypeConstantTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantTargetNode) Accept(visitor Visitor) {
 	visitor.VisitConstantTargetNode(n)
@@ -3399,6 +6096,21 @@ func (n *ConstantTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantTargetNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3409,6 +6121,15 @@ func (n *ConstantTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantTargetNode) MarshalJSON() ([]byte, error) {
+	type node ConstantTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a constant.
 //
 //	Foo = 1
@@ -3446,6 +6167,26 @@ func (n *ConstantWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ConstantWriteNode) Type() NodeType {
+	return NodeTypeConstantWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ConstantWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ConstantWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ConstantWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ConstantWriteNode) Accept(visitor Visitor) {
 	visitor.VisitConstantWriteNode(n)
@@ -3465,6 +6206,33 @@ func (n *ConstantWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ConstantWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ConstantWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ConstantWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ConstantWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3478,6 +6246,15 @@ func (n *ConstantWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ConstantWriteNode) MarshalJSON() ([]byte, error) {
+	type node ConstantWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a method definition.
 //
 //	def method
@@ -3532,6 +6309,26 @@ func (n *DefNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *DefNode) Type() NodeType {
+	return NodeTypeDefNode
+}
+
+// Flags returns the flags set on this node.
+func (n *DefNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *DefNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *DefNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *DefNode) Accept(visitor Visitor) {
 	visitor.VisitDefNode(n)
@@ -3567,6 +6364,47 @@ func (n *DefNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *DefNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "parameters", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Parameters)},
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *DefNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "parameters":
+		value, err := castChild[*ParametersNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Parameters = value
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *DefNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *DefNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3588,6 +6426,15 @@ func (n *DefNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *DefNode) MarshalJSON() ([]byte, error) {
+	type node DefNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `defined?` keyword.
 //
 //	defined?(a)
@@ -3625,6 +6472,26 @@ func (n *DefinedNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *DefinedNode) Type() NodeType {
+	return NodeTypeDefinedNode
+}
+
+// Flags returns the flags set on this node.
+func (n *DefinedNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *DefinedNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *DefinedNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *DefinedNode) Accept(visitor Visitor) {
 	visitor.VisitDefinedNode(n)
@@ -3644,6 +6511,33 @@ func (n *DefinedNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *DefinedNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *DefinedNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *DefinedNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *DefinedNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3657,6 +6551,15 @@ func (n *DefinedNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *DefinedNode) MarshalJSON() ([]byte, error) {
+	type node DefinedNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an `else` clause in a `case`, `if`, or `unless` statement.
 //
 //	if a then b else c end
@@ -3692,6 +6595,26 @@ func (n *ElseNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ElseNode) Type() NodeType {
+	return NodeTypeElseNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ElseNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ElseNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ElseNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ElseNode) Accept(visitor Visitor) {
 	visitor.VisitElseNode(n)
@@ -3715,6 +6638,33 @@ func (n *ElseNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ElseNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ElseNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ElseNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ElseNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3727,6 +6677,15 @@ func (n *ElseNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ElseNode) MarshalJSON() ([]byte, error) {
+	type node ElseNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an interpolated set of statements.
 //
 //	"foo #{bar}"
@@ -3762,6 +6721,26 @@ func (n *EmbeddedStatementsNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *EmbeddedStatementsNode) Type() NodeType {
+	return NodeTypeEmbeddedStatementsNode
+}
+
+// Flags returns the flags set on this node.
+func (n *EmbeddedStatementsNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *EmbeddedStatementsNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *EmbeddedStatementsNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *EmbeddedStatementsNode) Accept(visitor Visitor) {
 	visitor.VisitEmbeddedStatementsNode(n)
@@ -3785,6 +6764,33 @@ func (n *EmbeddedStatementsNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *EmbeddedStatementsNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *EmbeddedStatementsNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *EmbeddedStatementsNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *EmbeddedStatementsNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3797,6 +6803,15 @@ func (n *EmbeddedStatementsNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *EmbeddedStatementsNode) MarshalJSON() ([]byte, error) {
+	type node EmbeddedStatementsNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an interpolated variable.
 //
 //	"foo #@bar"
@@ -3830,6 +6845,26 @@ func (n *EmbeddedVariableNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *EmbeddedVariableNode) Type() NodeType {
+	return NodeTypeEmbeddedVariableNode
+}
+
+// Flags returns the flags set on this node.
+func (n *EmbeddedVariableNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *EmbeddedVariableNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *EmbeddedVariableNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *EmbeddedVariableNode) Accept(visitor Visitor) {
 	visitor.VisitEmbeddedVariableNode(n)
@@ -3849,6 +6884,33 @@ func (n *EmbeddedVariableNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *EmbeddedVariableNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "variable", Kind: FieldKindNode, Node: n.Variable},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *EmbeddedVariableNode) SetChild(name string, child Node) error {
+	switch name {
+	case "variable":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Variable = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *EmbeddedVariableNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *EmbeddedVariableNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3860,6 +6922,15 @@ func (n *EmbeddedVariableNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *EmbeddedVariableNode) MarshalJSON() ([]byte, error) {
+	type node EmbeddedVariableNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an `ensure` clause in a `begin` statement.
 //
 //	begin
@@ -3899,6 +6970,26 @@ func (n *EnsureNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *EnsureNode) Type() NodeType {
+	return NodeTypeEnsureNode
+}
+
+// Flags returns the flags set on this node.
+func (n *EnsureNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *EnsureNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *EnsureNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *EnsureNode) Accept(visitor Visitor) {
 	visitor.VisitEnsureNode(n)
@@ -3922,6 +7013,33 @@ func (n *EnsureNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *EnsureNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *EnsureNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *EnsureNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *EnsureNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3934,6 +7052,15 @@ func (n *EnsureNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *EnsureNode) MarshalJSON() ([]byte, error) {
+	type node EnsureNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the literal `false` keyword.
 //
 //	false
@@ -3963,6 +7090,26 @@ func (n *FalseNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *FalseNode) Type() NodeType {
+	return NodeTypeFalseNode
+}
+
+// Flags returns the flags set on this node.
+func (n *FalseNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *FalseNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *FalseNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *FalseNode) Accept(visitor Visitor) {
 	visitor.VisitFalseNode(n)
@@ -3980,6 +7127,21 @@ func (n *FalseNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *FalseNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *FalseNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *FalseNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *FalseNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -3989,6 +7151,15 @@ func (n *FalseNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *FalseNode) MarshalJSON() ([]byte, error) {
+	type node FalseNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a find pattern in pattern matching.
 //
 //	foo in *bar, baz, *qux
@@ -4036,6 +7207,26 @@ func (n *FindPatternNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *FindPatternNode) Type() NodeType {
+	return NodeTypeFindPatternNode
+}
+
+// Flags returns the flags set on this node.
+func (n *FindPatternNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *FindPatternNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *FindPatternNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *FindPatternNode) Accept(visitor Visitor) {
 	visitor.VisitFindPatternNode(n)
@@ -4065,6 +7256,54 @@ func (n *FindPatternNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *FindPatternNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "constant", Kind: FieldKindOptionalNode, Node: n.Constant},
+		{Name: "left", Kind: FieldKindNode, Node: nodeOrNil(n.Left)},
+		{Name: "requireds", Kind: FieldKindNodeList, Nodes: n.Requireds},
+		{Name: "right", Kind: FieldKindNode, Node: n.Right},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *FindPatternNode) SetChild(name string, child Node) error {
+	switch name {
+	case "constant":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Constant = value
+	case "left":
+		value, err := castChild[*SplatNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Left = value
+	case "right":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Right = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *FindPatternNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "requireds":
+		n.Requireds = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *FindPatternNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4080,6 +7319,15 @@ func (n *FindPatternNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *FindPatternNode) MarshalJSON() ([]byte, error) {
+	type node FindPatternNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `..` or `...` operators to create flip flops.
 //
 //	baz if foo .. bar
@@ -4115,6 +7363,26 @@ func (n *FlipFlopNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *FlipFlopNode) Type() NodeType {
+	return NodeTypeFlipFlopNode
+}
+
+// Flags returns the flags set on this node.
+func (n *FlipFlopNode) Flags() FlagSet {
+	return RangeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *FlipFlopNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *FlipFlopNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsEXCLUDE_END returns true if this node has the EXCLUDE_END flag.
 func (n *FlipFlopNode) IsEXCLUDE_END() bool {
 	return (n.flags & RangeFlagsEXCLUDE_END) != 0
@@ -4149,6 +7417,40 @@ func (n *FlipFlopNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *FlipFlopNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "left", Kind: FieldKindOptionalNode, Node: n.Left},
+		{Name: "right", Kind: FieldKindOptionalNode, Node: n.Right},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *FlipFlopNode) SetChild(name string, child Node) error {
+	switch name {
+	case "left":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Left = value
+	case "right":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Right = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *FlipFlopNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *FlipFlopNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4161,6 +7463,15 @@ func (n *FlipFlopNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *FlipFlopNode) MarshalJSON() ([]byte, error) {
+	type node FlipFlopNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a floating point number literal.
 //
 //	1.0
@@ -4192,6 +7503,26 @@ func (n *FloatNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *FloatNode) Type() NodeType {
+	return NodeTypeFloatNode
+}
+
+// Flags returns the flags set on this node.
+func (n *FloatNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *FloatNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *FloatNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *FloatNode) Accept(visitor Visitor) {
 	visitor.VisitFloatNode(n)
@@ -4209,6 +7540,21 @@ func (n *FloatNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *FloatNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *FloatNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *FloatNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *FloatNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4219,6 +7565,15 @@ func (n *FloatNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *FloatNode) MarshalJSON() ([]byte, error) {
+	type node FloatNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `for` keyword.
 //
 //	for i in a end
@@ -4262,6 +7617,26 @@ func (n *ForNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ForNode) Type() NodeType {
+	return NodeTypeForNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ForNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ForNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ForNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ForNode) Accept(visitor Visitor) {
 	visitor.VisitForNode(n)
@@ -4289,6 +7664,47 @@ func (n *ForNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ForNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "index", Kind: FieldKindNode, Node: n.Index},
+		{Name: "collection", Kind: FieldKindNode, Node: n.Collection},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ForNode) SetChild(name string, child Node) error {
+	switch name {
+	case "index":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Index = value
+	case "collection":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Collection = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ForNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ForNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4305,6 +7721,15 @@ func (n *ForNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ForNode) MarshalJSON() ([]byte, error) {
+	type node ForNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents forwarding all arguments to this method to another method.
 //
 //	def foo(...)
@@ -4336,6 +7761,26 @@ func (n *ForwardingArgumentsNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ForwardingArgumentsNode) Type() NodeType {
+	return NodeTypeForwardingArgumentsNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ForwardingArgumentsNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ForwardingArgumentsNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ForwardingArgumentsNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ForwardingArgumentsNode) Accept(visitor Visitor) {
 	visitor.VisitForwardingArgumentsNode(n)
@@ -4353,6 +7798,21 @@ func (n *ForwardingArgumentsNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ForwardingArgumentsNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ForwardingArgumentsNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ForwardingArgumentsNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ForwardingArgumentsNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4362,6 +7822,15 @@ func (n *ForwardingArgumentsNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ForwardingArgumentsNode) MarshalJSON() ([]byte, error) {
+	type node ForwardingArgumentsNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the forwarding parameter in a method, block, or lambda declaration.
 //
 //	def foo(...)
@@ -4392,6 +7861,26 @@ func (n *ForwardingParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ForwardingParameterNode) Type() NodeType {
+	return NodeTypeForwardingParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ForwardingParameterNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ForwardingParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ForwardingParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ForwardingParameterNode) Accept(visitor Visitor) {
 	visitor.VisitForwardingParameterNode(n)
@@ -4409,6 +7898,21 @@ func (n *ForwardingParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ForwardingParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ForwardingParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ForwardingParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ForwardingParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4418,6 +7922,15 @@ func (n *ForwardingParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ForwardingParameterNode) MarshalJSON() ([]byte, error) {
+	type node ForwardingParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `super` keyword without parentheses or arguments.
 //
 //	super
@@ -4449,6 +7962,26 @@ func (n *ForwardingSuperNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ForwardingSuperNode) Type() NodeType {
+	return NodeTypeForwardingSuperNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ForwardingSuperNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ForwardingSuperNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ForwardingSuperNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ForwardingSuperNode) Accept(visitor Visitor) {
 	visitor.VisitForwardingSuperNode(n)
@@ -4472,6 +8005,33 @@ func (n *ForwardingSuperNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ForwardingSuperNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "block", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Block)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ForwardingSuperNode) SetChild(name string, child Node) error {
+	switch name {
+	case "block":
+		value, err := castChild[*BlockNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ForwardingSuperNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ForwardingSuperNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4482,6 +8042,15 @@ func (n *ForwardingSuperNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ForwardingSuperNode) MarshalJSON() ([]byte, error) {
+	type node ForwardingSuperNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator for assignment to a global variable.
 //
 //	$target &&= value
@@ -4519,6 +8088,26 @@ func (n *GlobalVariableAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *GlobalVariableAndWriteNode) Type() NodeType {
+	return NodeTypeGlobalVariableAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *GlobalVariableAndWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *GlobalVariableAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *GlobalVariableAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *GlobalVariableAndWriteNode) Accept(visitor Visitor) {
 	visitor.VisitGlobalVariableAndWriteNode(n)
@@ -4538,6 +8127,33 @@ func (n *GlobalVariableAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *GlobalVariableAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *GlobalVariableAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *GlobalVariableAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *GlobalVariableAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4551,6 +8167,15 @@ func (n *GlobalVariableAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *GlobalVariableAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node GlobalVariableAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a global variable using an operator that isn't `=`.
 //
 //	$target += value
@@ -4590,6 +8215,26 @@ func (n *GlobalVariableOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *GlobalVariableOperatorWriteNode) Type() NodeType {
+	return NodeTypeGlobalVariableOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *GlobalVariableOperatorWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *GlobalVariableOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *GlobalVariableOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *GlobalVariableOperatorWriteNode) Accept(visitor Visitor) {
 	visitor.VisitGlobalVariableOperatorWriteNode(n)
@@ -4609,6 +8254,33 @@ func (n *GlobalVariableOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *GlobalVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *GlobalVariableOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *GlobalVariableOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *GlobalVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4623,6 +8295,15 @@ func (n *GlobalVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *GlobalVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node GlobalVariableOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator for assignment to a global variable.
 //
 //	$target ||= value
@@ -4660,6 +8341,26 @@ func (n *GlobalVariableOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *GlobalVariableOrWriteNode) Type() NodeType {
+	return NodeTypeGlobalVariableOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *GlobalVariableOrWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *GlobalVariableOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *GlobalVariableOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *GlobalVariableOrWriteNode) Accept(visitor Visitor) {
 	visitor.VisitGlobalVariableOrWriteNode(n)
@@ -4679,6 +8380,33 @@ func (n *GlobalVariableOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *GlobalVariableOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *GlobalVariableOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *GlobalVariableOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *GlobalVariableOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4692,6 +8420,15 @@ func (n *GlobalVariableOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *GlobalVariableOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node GlobalVariableOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents referencing a global variable.
 //
 //	$foo
@@ -4723,6 +8460,26 @@ func (n *GlobalVariableReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *GlobalVariableReadNode) Type() NodeType {
+	return NodeTypeGlobalVariableReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *GlobalVariableReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *GlobalVariableReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *GlobalVariableReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *GlobalVariableReadNode) Accept(visitor Visitor) {
 	visitor.VisitGlobalVariableReadNode(n)
@@ -4740,6 +8497,21 @@ func (n *GlobalVariableReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *GlobalVariableReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *GlobalVariableReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *GlobalVariableReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *GlobalVariableReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4750,6 +8522,15 @@ func (n *GlobalVariableReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *GlobalVariableReadNode) MarshalJSON() ([]byte, error) {
+	type node GlobalVariableReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a global variable in a context that doesn't have an explicit value.
 //
 //	$foo, $bar = baz
@@ -4781,6 +8562,26 @@ func (n *GlobalVariableTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *GlobalVariableTargetNode) Type() NodeType {
+	return NodeTypeGlobalVariableTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *GlobalVariableTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *GlobalVariableTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *GlobalVariableTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *GlobalVariableTargetNode) Accept(visitor Visitor) {
 	visitor.VisitGlobalVariableTargetNode(n)
@@ -4798,6 +8599,21 @@ func (n *GlobalVariableTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *GlobalVariableTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *GlobalVariableTargetNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *GlobalVariableTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *GlobalVariableTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4808,6 +8624,15 @@ func (n *GlobalVariableTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *GlobalVariableTargetNode) MarshalJSON() ([]byte, error) {
+	type node GlobalVariableTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a global variable.
 //
 //	$foo = 1
@@ -4845,6 +8670,26 @@ func (n *GlobalVariableWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *GlobalVariableWriteNode) Type() NodeType {
+	return NodeTypeGlobalVariableWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *GlobalVariableWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *GlobalVariableWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *GlobalVariableWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *GlobalVariableWriteNode) Accept(visitor Visitor) {
 	visitor.VisitGlobalVariableWriteNode(n)
@@ -4864,6 +8709,33 @@ func (n *GlobalVariableWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *GlobalVariableWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *GlobalVariableWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *GlobalVariableWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *GlobalVariableWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4877,6 +8749,15 @@ func (n *GlobalVariableWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *GlobalVariableWriteNode) MarshalJSON() ([]byte, error) {
+	type node GlobalVariableWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a hash literal.
 //
 //	{ a => b }
@@ -4912,6 +8793,26 @@ func (n *HashNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *HashNode) Type() NodeType {
+	return NodeTypeHashNode
+}
+
+// Flags returns the flags set on this node.
+func (n *HashNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *HashNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *HashNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *HashNode) Accept(visitor Visitor) {
 	visitor.VisitHashNode(n)
@@ -4931,6 +8832,29 @@ func (n *HashNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *HashNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "elements", Kind: FieldKindNodeList, Nodes: n.Elements},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *HashNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *HashNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "elements":
+		n.Elements = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *HashNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -4943,6 +8867,15 @@ func (n *HashNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *HashNode) MarshalJSON() ([]byte, error) {
+	type node HashNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a hash pattern in pattern matching.
 //
 //	foo => { a: 1, b: 2 }
@@ -4985,6 +8918,26 @@ func (n *HashPatternNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *HashPatternNode) Type() NodeType {
+	return NodeTypeHashPatternNode
+}
+
+// Flags returns the flags set on this node.
+func (n *HashPatternNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *HashPatternNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *HashPatternNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *HashPatternNode) Accept(visitor Visitor) {
 	visitor.VisitHashPatternNode(n)
@@ -5016,6 +8969,47 @@ func (n *HashPatternNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *HashPatternNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "constant", Kind: FieldKindOptionalNode, Node: n.Constant},
+		{Name: "elements", Kind: FieldKindNodeList, Nodes: n.Elements},
+		{Name: "rest", Kind: FieldKindOptionalNode, Node: n.Rest},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *HashPatternNode) SetChild(name string, child Node) error {
+	switch name {
+	case "constant":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Constant = value
+	case "rest":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Rest = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *HashPatternNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "elements":
+		n.Elements = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *HashPatternNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5030,6 +9024,15 @@ func (n *HashPatternNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *HashPatternNode) MarshalJSON() ([]byte, error) {
+	type node HashPatternNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `if` keyword, either in the block form or the modifier form, or a ternary expression.
 //
 //	bar if foo
@@ -5077,6 +9080,26 @@ func (n *IfNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *IfNode) Type() NodeType {
+	return NodeTypeIfNode
+}
+
+// Flags returns the flags set on this node.
+func (n *IfNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *IfNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *IfNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *IfNode) Accept(visitor Visitor) {
 	visitor.VisitIfNode(n)
@@ -5108,6 +9131,47 @@ func (n *IfNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *IfNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "predicate", Kind: FieldKindNode, Node: n.Predicate},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+		{Name: "subsequent", Kind: FieldKindOptionalNode, Node: n.Subsequent},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *IfNode) SetChild(name string, child Node) error {
+	switch name {
+	case "predicate":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Predicate = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	case "subsequent":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Subsequent = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *IfNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *IfNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5123,6 +9187,15 @@ func (n *IfNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *IfNode) MarshalJSON() ([]byte, error) {
+	type node IfNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an imaginary number literal.
 //
 //	1.0i
@@ -5154,6 +9227,26 @@ func (n *ImaginaryNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ImaginaryNode) Type() NodeType {
+	return NodeTypeImaginaryNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ImaginaryNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ImaginaryNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ImaginaryNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ImaginaryNode) Accept(visitor Visitor) {
 	visitor.VisitImaginaryNode(n)
@@ -5173,6 +9266,33 @@ func (n *ImaginaryNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ImaginaryNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "numeric", Kind: FieldKindNode, Node: n.Numeric},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ImaginaryNode) SetChild(name string, child Node) error {
+	switch name {
+	case "numeric":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Numeric = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ImaginaryNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ImaginaryNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5183,6 +9303,15 @@ func (n *ImaginaryNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ImaginaryNode) MarshalJSON() ([]byte, error) {
+	type node ImaginaryNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a node that is implicitly being added to the tree but doesn't correspond directly to a node in the source.
 //
 //	{ foo: }
@@ -5220,6 +9349,26 @@ func (n *ImplicitNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ImplicitNode) Type() NodeType {
+	return NodeTypeImplicitNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ImplicitNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ImplicitNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ImplicitNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ImplicitNode) Accept(visitor Visitor) {
 	visitor.VisitImplicitNode(n)
@@ -5239,6 +9388,33 @@ func (n *ImplicitNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ImplicitNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ImplicitNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ImplicitNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ImplicitNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5249,6 +9425,15 @@ func (n *ImplicitNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ImplicitNode) MarshalJSON() ([]byte, error) {
+	type node ImplicitNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents using a trailing comma to indicate an implicit rest parameter.
 //
 //	foo { |bar,| }
@@ -5287,6 +9472,26 @@ func (n *ImplicitRestNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ImplicitRestNode) Type() NodeType {
+	return NodeTypeImplicitRestNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ImplicitRestNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ImplicitRestNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ImplicitRestNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ImplicitRestNode) Accept(visitor Visitor) {
 	visitor.VisitImplicitRestNode(n)
@@ -5304,6 +9509,21 @@ func (n *ImplicitRestNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ImplicitRestNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ImplicitRestNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ImplicitRestNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ImplicitRestNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5313,6 +9533,15 @@ func (n *ImplicitRestNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ImplicitRestNode) MarshalJSON() ([]byte, error) {
+	type node ImplicitRestNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `in` keyword in a case statement.
 //
 //	case a; in b then c end
@@ -5350,6 +9579,26 @@ func (n *InNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InNode) Type() NodeType {
+	return NodeTypeInNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InNode) Accept(visitor Visitor) {
 	visitor.VisitInNode(n)
@@ -5375,6 +9624,40 @@ func (n *InNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "pattern", Kind: FieldKindNode, Node: n.Pattern},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InNode) SetChild(name string, child Node) error {
+	switch name {
+	case "pattern":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Pattern = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5388,6 +9671,15 @@ func (n *InNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InNode) MarshalJSON() ([]byte, error) {
+	type node InNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator on a call to the `[]` method.
 //
 //	foo.bar[baz] &&= value
@@ -5433,6 +9725,26 @@ func (n *IndexAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *IndexAndWriteNode) Type() NodeType {
+	return NodeTypeIndexAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *IndexAndWriteNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *IndexAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *IndexAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *IndexAndWriteNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -5490,6 +9802,54 @@ func (n *IndexAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *IndexAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Block)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *IndexAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	case "block":
+		value, err := castChild[*BlockArgumentNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *IndexAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *IndexAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5507,6 +9867,15 @@ func (n *IndexAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *IndexAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node IndexAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of an assignment operator on a call to `[]`.
 //
 //	foo.bar[baz] += value
@@ -5554,6 +9923,26 @@ func (n *IndexOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *IndexOperatorWriteNode) Type() NodeType {
+	return NodeTypeIndexOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *IndexOperatorWriteNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *IndexOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *IndexOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *IndexOperatorWriteNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -5611,6 +10000,54 @@ func (n *IndexOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *IndexOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Block)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *IndexOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	case "block":
+		value, err := castChild[*BlockArgumentNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *IndexOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *IndexOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5629,6 +10066,15 @@ func (n *IndexOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *IndexOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node IndexOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator on a call to `[]`.
 //
 //	foo.bar[baz] ||= value
@@ -5674,6 +10120,26 @@ func (n *IndexOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *IndexOrWriteNode) Type() NodeType {
+	return NodeTypeIndexOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *IndexOrWriteNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *IndexOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *IndexOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *IndexOrWriteNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -5731,6 +10197,54 @@ func (n *IndexOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *IndexOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindOptionalNode, Node: n.Receiver},
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Block)},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *IndexOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	case "block":
+		value, err := castChild[*BlockArgumentNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *IndexOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *IndexOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5748,6 +10262,15 @@ func (n *IndexOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *IndexOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node IndexOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to an index.
 //
 //	foo[bar], = 1
@@ -5795,6 +10318,26 @@ func (n *IndexTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *IndexTargetNode) Type() NodeType {
+	return NodeTypeIndexTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *IndexTargetNode) Flags() FlagSet {
+	return CallNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *IndexTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *IndexTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSAFE_NAVIGATION returns true if this node has the SAFE_NAVIGATION flag.
 func (n *IndexTargetNode) IsSAFE_NAVIGATION() bool {
 	return (n.flags & CallNodeFlagsSAFE_NAVIGATION) != 0
@@ -5846,6 +10389,47 @@ func (n *IndexTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *IndexTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "receiver", Kind: FieldKindNode, Node: n.Receiver},
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Block)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *IndexTargetNode) SetChild(name string, child Node) error {
+	switch name {
+	case "receiver":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Receiver = value
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	case "block":
+		value, err := castChild[*BlockArgumentNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *IndexTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *IndexTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5860,6 +10444,15 @@ func (n *IndexTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *IndexTargetNode) MarshalJSON() ([]byte, error) {
+	type node IndexTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator for assignment to an instance variable.
 //
 //	@target &&= value
@@ -5897,6 +10490,26 @@ func (n *InstanceVariableAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InstanceVariableAndWriteNode) Type() NodeType {
+	return NodeTypeInstanceVariableAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InstanceVariableAndWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InstanceVariableAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InstanceVariableAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InstanceVariableAndWriteNode) Accept(visitor Visitor) {
 	visitor.VisitInstanceVariableAndWriteNode(n)
@@ -5916,6 +10529,33 @@ func (n *InstanceVariableAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InstanceVariableAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InstanceVariableAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InstanceVariableAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InstanceVariableAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -5929,6 +10569,15 @@ func (n *InstanceVariableAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InstanceVariableAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node InstanceVariableAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to an instance variable using an operator that isn't `=`.
 //
 //	@target += value
@@ -5968,6 +10617,26 @@ func (n *InstanceVariableOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InstanceVariableOperatorWriteNode) Type() NodeType {
+	return NodeTypeInstanceVariableOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InstanceVariableOperatorWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InstanceVariableOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InstanceVariableOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InstanceVariableOperatorWriteNode) Accept(visitor Visitor) {
 	visitor.VisitInstanceVariableOperatorWriteNode(n)
@@ -5987,6 +10656,33 @@ func (n *InstanceVariableOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InstanceVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InstanceVariableOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InstanceVariableOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InstanceVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6001,6 +10697,15 @@ func (n *InstanceVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InstanceVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node InstanceVariableOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator for assignment to an instance variable.
 //
 //	@target ||= value
@@ -6038,6 +10743,26 @@ func (n *InstanceVariableOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InstanceVariableOrWriteNode) Type() NodeType {
+	return NodeTypeInstanceVariableOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InstanceVariableOrWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InstanceVariableOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InstanceVariableOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InstanceVariableOrWriteNode) Accept(visitor Visitor) {
 	visitor.VisitInstanceVariableOrWriteNode(n)
@@ -6057,6 +10782,33 @@ func (n *InstanceVariableOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InstanceVariableOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InstanceVariableOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InstanceVariableOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InstanceVariableOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6070,6 +10822,15 @@ func (n *InstanceVariableOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InstanceVariableOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node InstanceVariableOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents referencing an instance variable.
 //
 //	@foo
@@ -6101,6 +10862,26 @@ func (n *InstanceVariableReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InstanceVariableReadNode) Type() NodeType {
+	return NodeTypeInstanceVariableReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InstanceVariableReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InstanceVariableReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InstanceVariableReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InstanceVariableReadNode) Accept(visitor Visitor) {
 	visitor.VisitInstanceVariableReadNode(n)
@@ -6118,6 +10899,21 @@ func (n *InstanceVariableReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InstanceVariableReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InstanceVariableReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InstanceVariableReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InstanceVariableReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6128,6 +10924,15 @@ func (n *InstanceVariableReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InstanceVariableReadNode) MarshalJSON() ([]byte, error) {
+	type node InstanceVariableReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to an instance variable in a context that doesn't have an explicit value.
 //
 //	@foo, @bar = baz
@@ -6159,6 +10964,26 @@ func (n *InstanceVariableTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InstanceVariableTargetNode) Type() NodeType {
+	return NodeTypeInstanceVariableTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InstanceVariableTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InstanceVariableTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InstanceVariableTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InstanceVariableTargetNode) Accept(visitor Visitor) {
 	visitor.VisitInstanceVariableTargetNode(n)
@@ -6176,6 +11001,21 @@ func (n *InstanceVariableTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InstanceVariableTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InstanceVariableTargetNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InstanceVariableTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InstanceVariableTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6186,6 +11026,15 @@ func (n *InstanceVariableTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InstanceVariableTargetNode) MarshalJSON() ([]byte, error) {
+	type node InstanceVariableTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to an instance variable.
 //
 //	@foo = 1
@@ -6223,6 +11072,26 @@ func (n *InstanceVariableWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InstanceVariableWriteNode) Type() NodeType {
+	return NodeTypeInstanceVariableWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InstanceVariableWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InstanceVariableWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InstanceVariableWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InstanceVariableWriteNode) Accept(visitor Visitor) {
 	visitor.VisitInstanceVariableWriteNode(n)
@@ -6242,6 +11111,33 @@ func (n *InstanceVariableWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InstanceVariableWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InstanceVariableWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InstanceVariableWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InstanceVariableWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6255,6 +11151,15 @@ func (n *InstanceVariableWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InstanceVariableWriteNode) MarshalJSON() ([]byte, error) {
+	type node InstanceVariableWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an integer number literal.
 //
 //	1
@@ -6286,6 +11191,26 @@ func (n *IntegerNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *IntegerNode) Type() NodeType {
+	return NodeTypeIntegerNode
+}
+
+// Flags returns the flags set on this node.
+func (n *IntegerNode) Flags() FlagSet {
+	return IntegerBaseFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *IntegerNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *IntegerNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsBINARY returns true if this node has the BINARY flag.
 func (n *IntegerNode) IsBINARY() bool {
 	return (n.flags & IntegerBaseFlagsBINARY) != 0
@@ -6323,6 +11248,21 @@ func (n *IntegerNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *IntegerNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *IntegerNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *IntegerNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *IntegerNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6333,6 +11273,15 @@ func (n *IntegerNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *IntegerNode) MarshalJSON() ([]byte, error) {
+	type node IntegerNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a regular expression literal that contains interpolation that is being used in the predicate of a conditional to implicitly match against the last line read by an IO object.
 //
 //	if /foo #{bar} baz/ then end
@@ -6368,6 +11317,26 @@ func (n *InterpolatedMatchLastLineNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InterpolatedMatchLastLineNode) Type() NodeType {
+	return NodeTypeInterpolatedMatchLastLineNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InterpolatedMatchLastLineNode) Flags() FlagSet {
+	return RegularExpressionFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InterpolatedMatchLastLineNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InterpolatedMatchLastLineNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsIGNORE_CASE returns true if this node has the IGNORE_CASE flag.
 func (n *InterpolatedMatchLastLineNode) IsIGNORE_CASE() bool {
 	return (n.flags & RegularExpressionFlagsIGNORE_CASE) != 0
@@ -6442,6 +11411,29 @@ func (n *InterpolatedMatchLastLineNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InterpolatedMatchLastLineNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parts", Kind: FieldKindNodeList, Nodes: n.Parts},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InterpolatedMatchLastLineNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InterpolatedMatchLastLineNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "parts":
+		n.Parts = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InterpolatedMatchLastLineNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6454,6 +11446,15 @@ func (n *InterpolatedMatchLastLineNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InterpolatedMatchLastLineNode) MarshalJSON() ([]byte, error) {
+	type node InterpolatedMatchLastLineNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a regular expression literal that contains interpolation.
 //
 //	/foo #{bar} baz/
@@ -6489,6 +11490,26 @@ func (n *InterpolatedRegularExpressionNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InterpolatedRegularExpressionNode) Type() NodeType {
+	return NodeTypeInterpolatedRegularExpressionNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InterpolatedRegularExpressionNode) Flags() FlagSet {
+	return RegularExpressionFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InterpolatedRegularExpressionNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InterpolatedRegularExpressionNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsIGNORE_CASE returns true if this node has the IGNORE_CASE flag.
 func (n *InterpolatedRegularExpressionNode) IsIGNORE_CASE() bool {
 	return (n.flags & RegularExpressionFlagsIGNORE_CASE) != 0
@@ -6563,6 +11584,29 @@ func (n *InterpolatedRegularExpressionNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InterpolatedRegularExpressionNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parts", Kind: FieldKindNodeList, Nodes: n.Parts},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InterpolatedRegularExpressionNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InterpolatedRegularExpressionNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "parts":
+		n.Parts = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InterpolatedRegularExpressionNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6575,6 +11619,15 @@ func (n *InterpolatedRegularExpressionNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InterpolatedRegularExpressionNode) MarshalJSON() ([]byte, error) {
+	type node InterpolatedRegularExpressionNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a string literal that contains interpolation.
 //
 //	"foo #{bar} baz"
@@ -6610,6 +11663,26 @@ func (n *InterpolatedStringNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InterpolatedStringNode) Type() NodeType {
+	return NodeTypeInterpolatedStringNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InterpolatedStringNode) Flags() FlagSet {
+	return InterpolatedStringNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InterpolatedStringNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InterpolatedStringNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsFROZEN returns true if this node has the FROZEN flag.
 func (n *InterpolatedStringNode) IsFROZEN() bool {
 	return (n.flags & InterpolatedStringNodeFlagsFROZEN) != 0
@@ -6639,6 +11712,29 @@ func (n *InterpolatedStringNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InterpolatedStringNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parts", Kind: FieldKindNodeList, Nodes: n.Parts},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InterpolatedStringNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InterpolatedStringNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "parts":
+		n.Parts = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InterpolatedStringNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6651,6 +11747,15 @@ func (n *InterpolatedStringNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InterpolatedStringNode) MarshalJSON() ([]byte, error) {
+	type node InterpolatedStringNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a symbol literal that contains interpolation.
 //
 //	:"foo #{bar} baz"
@@ -6686,6 +11791,26 @@ func (n *InterpolatedSymbolNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InterpolatedSymbolNode) Type() NodeType {
+	return NodeTypeInterpolatedSymbolNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InterpolatedSymbolNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InterpolatedSymbolNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InterpolatedSymbolNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InterpolatedSymbolNode) Accept(visitor Visitor) {
 	visitor.VisitInterpolatedSymbolNode(n)
@@ -6705,6 +11830,29 @@ func (n *InterpolatedSymbolNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InterpolatedSymbolNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parts", Kind: FieldKindNodeList, Nodes: n.Parts},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InterpolatedSymbolNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InterpolatedSymbolNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "parts":
+		n.Parts = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InterpolatedSymbolNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6717,6 +11865,15 @@ func (n *InterpolatedSymbolNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InterpolatedSymbolNode) MarshalJSON() ([]byte, error) {
+	type node InterpolatedSymbolNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an xstring literal that contains interpolation.
 //
 //	`foo #{bar} baz`
@@ -6752,6 +11909,26 @@ func (n *InterpolatedXStringNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *InterpolatedXStringNode) Type() NodeType {
+	return NodeTypeInterpolatedXStringNode
+}
+
+// Flags returns the flags set on this node.
+func (n *InterpolatedXStringNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *InterpolatedXStringNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *InterpolatedXStringNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *InterpolatedXStringNode) Accept(visitor Visitor) {
 	visitor.VisitInterpolatedXStringNode(n)
@@ -6771,6 +11948,29 @@ func (n *InterpolatedXStringNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *InterpolatedXStringNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parts", Kind: FieldKindNodeList, Nodes: n.Parts},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *InterpolatedXStringNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *InterpolatedXStringNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "parts":
+		n.Parts = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *InterpolatedXStringNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6783,6 +11983,15 @@ func (n *InterpolatedXStringNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *InterpolatedXStringNode) MarshalJSON() ([]byte, error) {
+	type node InterpolatedXStringNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents reading from the implicit `it` local variable.
 //
 //	-> { it }
@@ -6812,6 +12021,26 @@ func (n *ItLocalVariableReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ItLocalVariableReadNode) Type() NodeType {
+	return NodeTypeItLocalVariableReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ItLocalVariableReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ItLocalVariableReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ItLocalVariableReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ItLocalVariableReadNode) Accept(visitor Visitor) {
 	visitor.VisitItLocalVariableReadNode(n)
@@ -6829,6 +12058,21 @@ func (n *ItLocalVariableReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ItLocalVariableReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ItLocalVariableReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ItLocalVariableReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ItLocalVariableReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6838,6 +12082,15 @@ func (n *ItLocalVariableReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ItLocalVariableReadNode) MarshalJSON() ([]byte, error) {
+	type node ItLocalVariableReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an implicit set of parameters through the use of the `it` keyword within a block or lambda.
 //
 //	-> { it + it }
@@ -6867,6 +12120,26 @@ func (n *ItParametersNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ItParametersNode) Type() NodeType {
+	return NodeTypeItParametersNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ItParametersNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ItParametersNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ItParametersNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ItParametersNode) Accept(visitor Visitor) {
 	visitor.VisitItParametersNode(n)
@@ -6884,6 +12157,21 @@ func (n *ItParametersNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ItParametersNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ItParametersNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ItParametersNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ItParametersNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6893,6 +12181,15 @@ func (n *ItParametersNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ItParametersNode) MarshalJSON() ([]byte, error) {
+	type node ItParametersNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a hash literal without opening and closing braces.
 //
 //	foo(a: b)
@@ -6924,6 +12221,26 @@ func (n *KeywordHashNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *KeywordHashNode) Type() NodeType {
+	return NodeTypeKeywordHashNode
+}
+
+// Flags returns the flags set on this node.
+func (n *KeywordHashNode) Flags() FlagSet {
+	return KeywordHashNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *KeywordHashNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *KeywordHashNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsSYMBOL_KEYS returns true if this node has the SYMBOL_KEYS flag.
 func (n *KeywordHashNode) IsSYMBOL_KEYS() bool {
 	return (n.flags & KeywordHashNodeFlagsSYMBOL_KEYS) != 0
@@ -6948,6 +12265,29 @@ func (n *KeywordHashNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *KeywordHashNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "elements", Kind: FieldKindNodeList, Nodes: n.Elements},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *KeywordHashNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *KeywordHashNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "elements":
+		n.Elements = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *KeywordHashNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -6958,6 +12298,15 @@ func (n *KeywordHashNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *KeywordHashNode) MarshalJSON() ([]byte, error) {
+	type node KeywordHashNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a keyword rest parameter to a method, block, or lambda definition.
 //
 //	def a(**b)
@@ -6994,6 +12343,26 @@ func (n *KeywordRestParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *KeywordRestParameterNode) Type() NodeType {
+	return NodeTypeKeywordRestParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *KeywordRestParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *KeywordRestParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *KeywordRestParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *KeywordRestParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -7016,6 +12385,21 @@ func (n *KeywordRestParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *KeywordRestParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *KeywordRestParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *KeywordRestParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *KeywordRestParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7028,6 +12412,15 @@ func (n *KeywordRestParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *KeywordRestParameterNode) MarshalJSON() ([]byte, error) {
+	type node KeywordRestParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents using a lambda literal (not the lambda method call).
 //
 //	->(value) { value * 2 }
@@ -7069,6 +12462,26 @@ func (n *LambdaNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LambdaNode) Type() NodeType {
+	return NodeTypeLambdaNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LambdaNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LambdaNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LambdaNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LambdaNode) Accept(visitor Visitor) {
 	visitor.VisitLambdaNode(n)
@@ -7098,6 +12511,40 @@ func (n *LambdaNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LambdaNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "parameters", Kind: FieldKindOptionalNode, Node: n.Parameters},
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LambdaNode) SetChild(name string, child Node) error {
+	switch name {
+	case "parameters":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Parameters = value
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LambdaNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LambdaNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7113,6 +12560,15 @@ func (n *LambdaNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LambdaNode) MarshalJSON() ([]byte, error) {
+	type node LambdaNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `&&=` operator for assignment to a local variable.
 //
 //	target &&= value
@@ -7152,6 +12608,26 @@ func (n *LocalVariableAndWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LocalVariableAndWriteNode) Type() NodeType {
+	return NodeTypeLocalVariableAndWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LocalVariableAndWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LocalVariableAndWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LocalVariableAndWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LocalVariableAndWriteNode) Accept(visitor Visitor) {
 	visitor.VisitLocalVariableAndWriteNode(n)
@@ -7171,6 +12647,33 @@ func (n *LocalVariableAndWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LocalVariableAndWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LocalVariableAndWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LocalVariableAndWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LocalVariableAndWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7185,6 +12688,15 @@ func (n *LocalVariableAndWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LocalVariableAndWriteNode) MarshalJSON() ([]byte, error) {
+	type node LocalVariableAndWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents assigning to a local variable using an operator that isn't `=`.
 //
 //	target += value
@@ -7226,6 +12738,26 @@ func (n *LocalVariableOperatorWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LocalVariableOperatorWriteNode) Type() NodeType {
+	return NodeTypeLocalVariableOperatorWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LocalVariableOperatorWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LocalVariableOperatorWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LocalVariableOperatorWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LocalVariableOperatorWriteNode) Accept(visitor Visitor) {
 	visitor.VisitLocalVariableOperatorWriteNode(n)
@@ -7245,6 +12777,33 @@ func (n *LocalVariableOperatorWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LocalVariableOperatorWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LocalVariableOperatorWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LocalVariableOperatorWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LocalVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7260,6 +12819,15 @@ func (n *LocalVariableOperatorWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LocalVariableOperatorWriteNode) MarshalJSON() ([]byte, error) {
+	type node LocalVariableOperatorWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||=` operator for assignment to a local variable.
 //
 //	target ||= value
@@ -7299,6 +12867,26 @@ func (n *LocalVariableOrWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LocalVariableOrWriteNode) Type() NodeType {
+	return NodeTypeLocalVariableOrWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LocalVariableOrWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LocalVariableOrWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LocalVariableOrWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LocalVariableOrWriteNode) Accept(visitor Visitor) {
 	visitor.VisitLocalVariableOrWriteNode(n)
@@ -7318,6 +12906,33 @@ func (n *LocalVariableOrWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LocalVariableOrWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LocalVariableOrWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LocalVariableOrWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LocalVariableOrWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7332,6 +12947,15 @@ func (n *LocalVariableOrWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LocalVariableOrWriteNode) MarshalJSON() ([]byte, error) {
+	type node LocalVariableOrWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents reading a local variable. Note that this requires that a local variable of the same name has already been written to in the same scope, otherwise it is parsed as a method call.
 //
 //	foo
@@ -7365,6 +12989,26 @@ func (n *LocalVariableReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LocalVariableReadNode) Type() NodeType {
+	return NodeTypeLocalVariableReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LocalVariableReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LocalVariableReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LocalVariableReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LocalVariableReadNode) Accept(visitor Visitor) {
 	visitor.VisitLocalVariableReadNode(n)
@@ -7382,6 +13026,21 @@ func (n *LocalVariableReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LocalVariableReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LocalVariableReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LocalVariableReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LocalVariableReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7393,6 +13052,15 @@ func (n *LocalVariableReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LocalVariableReadNode) MarshalJSON() ([]byte, error) {
+	type node LocalVariableReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a local variable in a context that doesn't have an explicit value.
 //
 //	foo, bar = baz
@@ -7426,6 +13094,26 @@ func (n *LocalVariableTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LocalVariableTargetNode) Type() NodeType {
+	return NodeTypeLocalVariableTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LocalVariableTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LocalVariableTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LocalVariableTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LocalVariableTargetNode) Accept(visitor Visitor) {
 	visitor.VisitLocalVariableTargetNode(n)
@@ -7443,6 +13131,21 @@ func (n *LocalVariableTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LocalVariableTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LocalVariableTargetNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LocalVariableTargetNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LocalVariableTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7454,6 +13157,15 @@ func (n *LocalVariableTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LocalVariableTargetNode) MarshalJSON() ([]byte, error) {
+	type node LocalVariableTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing to a local variable.
 //
 //	foo = 1
@@ -7493,6 +13205,26 @@ func (n *LocalVariableWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *LocalVariableWriteNode) Type() NodeType {
+	return NodeTypeLocalVariableWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *LocalVariableWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *LocalVariableWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *LocalVariableWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *LocalVariableWriteNode) Accept(visitor Visitor) {
 	visitor.VisitLocalVariableWriteNode(n)
@@ -7512,6 +13244,33 @@ func (n *LocalVariableWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *LocalVariableWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *LocalVariableWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *LocalVariableWriteNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *LocalVariableWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7526,6 +13285,15 @@ func (n *LocalVariableWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *LocalVariableWriteNode) MarshalJSON() ([]byte, error) {
+	type node LocalVariableWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a regular expression literal used in the predicate of a conditional to implicitly match against the last line read by an IO object.
 //
 //	if /foo/i then end
@@ -7563,6 +13331,26 @@ func (n *MatchLastLineNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MatchLastLineNode) Type() NodeType {
+	return NodeTypeMatchLastLineNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MatchLastLineNode) Flags() FlagSet {
+	return RegularExpressionFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MatchLastLineNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MatchLastLineNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsIGNORE_CASE returns true if this node has the IGNORE_CASE flag.
 func (n *MatchLastLineNode) IsIGNORE_CASE() bool {
 	return (n.flags & RegularExpressionFlagsIGNORE_CASE) != 0
@@ -7635,6 +13423,21 @@ func (n *MatchLastLineNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MatchLastLineNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MatchLastLineNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MatchLastLineNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MatchLastLineNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7648,6 +13451,15 @@ func (n *MatchLastLineNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MatchLastLineNode) MarshalJSON() ([]byte, error) {
+	type node MatchLastLineNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the modifier `in` operator.
 //
 //	foo in bar
@@ -7683,6 +13495,26 @@ func (n *MatchPredicateNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MatchPredicateNode) Type() NodeType {
+	return NodeTypeMatchPredicateNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MatchPredicateNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MatchPredicateNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MatchPredicateNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *MatchPredicateNode) Accept(visitor Visitor) {
 	visitor.VisitMatchPredicateNode(n)
@@ -7704,6 +13536,40 @@ func (n *MatchPredicateNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MatchPredicateNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+		{Name: "pattern", Kind: FieldKindNode, Node: n.Pattern},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MatchPredicateNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	case "pattern":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Pattern = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MatchPredicateNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MatchPredicateNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7716,6 +13582,15 @@ func (n *MatchPredicateNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MatchPredicateNode) MarshalJSON() ([]byte, error) {
+	type node MatchPredicateNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `=>` operator.
 //
 //	foo => bar
@@ -7751,6 +13626,26 @@ func (n *MatchRequiredNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MatchRequiredNode) Type() NodeType {
+	return NodeTypeMatchRequiredNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MatchRequiredNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MatchRequiredNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MatchRequiredNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *MatchRequiredNode) Accept(visitor Visitor) {
 	visitor.VisitMatchRequiredNode(n)
@@ -7772,6 +13667,40 @@ func (n *MatchRequiredNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MatchRequiredNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+		{Name: "pattern", Kind: FieldKindNode, Node: n.Pattern},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MatchRequiredNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	case "pattern":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Pattern = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MatchRequiredNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MatchRequiredNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7784,6 +13713,15 @@ func (n *MatchRequiredNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MatchRequiredNode) MarshalJSON() ([]byte, error) {
+	type node MatchRequiredNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents writing local variables using a regular expression match with named capture groups.
 //
 //	/(?<foo>bar)/ =~ baz
@@ -7817,6 +13755,26 @@ func (n *MatchWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MatchWriteNode) Type() NodeType {
+	return NodeTypeMatchWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MatchWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MatchWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MatchWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *MatchWriteNode) Accept(visitor Visitor) {
 	visitor.VisitMatchWriteNode(n)
@@ -7838,6 +13796,40 @@ func (n *MatchWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MatchWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "call", Kind: FieldKindNode, Node: nodeOrNil(n.Call)},
+		{Name: "targets", Kind: FieldKindNodeList, Nodes: n.Targets},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MatchWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "call":
+		value, err := castChild[*CallNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Call = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MatchWriteNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "targets":
+		n.Targets = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MatchWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7849,6 +13841,15 @@ func (n *MatchWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MatchWriteNode) MarshalJSON() ([]byte, error) {
+	type node MatchWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a node that is missing from the source and results in a syntax error.
 type MissingNode struct {
 	NodeID   int      `json:"nodeID"`
@@ -7875,6 +13876,26 @@ func (n *MissingNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MissingNode) Type() NodeType {
+	return NodeTypeMissingNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MissingNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MissingNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MissingNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *MissingNode) Accept(visitor Visitor) {
 	visitor.VisitMissingNode(n)
@@ -7892,6 +13913,21 @@ func (n *MissingNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MissingNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MissingNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MissingNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MissingNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7901,6 +13937,15 @@ func (n *MissingNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MissingNode) MarshalJSON() ([]byte, error) {
+	type node MissingNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a module declaration involving the `module` keyword.
 //
 //	module Foo end
@@ -7942,6 +13987,26 @@ func (n *ModuleNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ModuleNode) Type() NodeType {
+	return NodeTypeModuleNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ModuleNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ModuleNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ModuleNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ModuleNode) Accept(visitor Visitor) {
 	visitor.VisitModuleNode(n)
@@ -7967,6 +14032,40 @@ func (n *ModuleNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ModuleNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "constant_path", Kind: FieldKindNode, Node: n.ConstantPath},
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ModuleNode) SetChild(name string, child Node) error {
+	switch name {
+	case "constant_path":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.ConstantPath = value
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ModuleNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ModuleNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -7982,6 +14081,15 @@ func (n *ModuleNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ModuleNode) MarshalJSON() ([]byte, error) {
+	type node ModuleNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a multi-target expression.
 //
 //	a, (b, c) = 1, 2, 3
@@ -8026,6 +14134,26 @@ func (n *MultiTargetNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MultiTargetNode) Type() NodeType {
+	return NodeTypeMultiTargetNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MultiTargetNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MultiTargetNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MultiTargetNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *MultiTargetNode) Accept(visitor Visitor) {
 	visitor.VisitMultiTargetNode(n)
@@ -8053,6 +14181,43 @@ func (n *MultiTargetNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MultiTargetNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "lefts", Kind: FieldKindNodeList, Nodes: n.Lefts},
+		{Name: "rest", Kind: FieldKindOptionalNode, Node: n.Rest},
+		{Name: "rights", Kind: FieldKindNodeList, Nodes: n.Rights},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MultiTargetNode) SetChild(name string, child Node) error {
+	switch name {
+	case "rest":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Rest = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MultiTargetNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "lefts":
+		n.Lefts = children
+	case "rights":
+		n.Rights = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MultiTargetNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8067,6 +14232,15 @@ func (n *MultiTargetNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MultiTargetNode) MarshalJSON() ([]byte, error) {
+	type node MultiTargetNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a write to a multi-target expression.
 //
 //	a, b, c = 1, 2, 3
@@ -8110,6 +14284,26 @@ func (n *MultiWriteNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *MultiWriteNode) Type() NodeType {
+	return NodeTypeMultiWriteNode
+}
+
+// Flags returns the flags set on this node.
+func (n *MultiWriteNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *MultiWriteNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *MultiWriteNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *MultiWriteNode) Accept(visitor Visitor) {
 	visitor.VisitMultiWriteNode(n)
@@ -8139,6 +14333,50 @@ func (n *MultiWriteNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *MultiWriteNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "lefts", Kind: FieldKindNodeList, Nodes: n.Lefts},
+		{Name: "rest", Kind: FieldKindOptionalNode, Node: n.Rest},
+		{Name: "rights", Kind: FieldKindNodeList, Nodes: n.Rights},
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *MultiWriteNode) SetChild(name string, child Node) error {
+	switch name {
+	case "rest":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Rest = value
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *MultiWriteNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "lefts":
+		n.Lefts = children
+	case "rights":
+		n.Rights = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *MultiWriteNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8155,6 +14393,15 @@ func (n *MultiWriteNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *MultiWriteNode) MarshalJSON() ([]byte, error) {
+	type node MultiWriteNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `next` keyword.
 //
 //	next 1
@@ -8188,6 +14435,26 @@ func (n *NextNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *NextNode) Type() NodeType {
+	return NodeTypeNextNode
+}
+
+// Flags returns the flags set on this node.
+func (n *NextNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *NextNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *NextNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *NextNode) Accept(visitor Visitor) {
 	visitor.VisitNextNode(n)
@@ -8211,6 +14478,33 @@ func (n *NextNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *NextNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *NextNode) SetChild(name string, child Node) error {
+	switch name {
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *NextNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *NextNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8222,6 +14516,15 @@ func (n *NextNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *NextNode) MarshalJSON() ([]byte, error) {
+	type node NextNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `nil` keyword.
 //
 //	nil
@@ -8251,6 +14554,26 @@ func (n *NilNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *NilNode) Type() NodeType {
+	return NodeTypeNilNode
+}
+
+// Flags returns the flags set on this node.
+func (n *NilNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *NilNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *NilNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *NilNode) Accept(visitor Visitor) {
 	visitor.VisitNilNode(n)
@@ -8268,6 +14591,21 @@ func (n *NilNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *NilNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *NilNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *NilNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *NilNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8277,6 +14615,15 @@ func (n *NilNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *NilNode) MarshalJSON() ([]byte, error) {
+	type node NilNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of `**nil` inside method arguments.
 //
 //	def a(**nil)
@@ -8311,6 +14658,26 @@ func (n *NoKeywordsParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *NoKeywordsParameterNode) Type() NodeType {
+	return NodeTypeNoKeywordsParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *NoKeywordsParameterNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *NoKeywordsParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *NoKeywordsParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *NoKeywordsParameterNode) Accept(visitor Visitor) {
 	visitor.VisitNoKeywordsParameterNode(n)
@@ -8328,6 +14695,21 @@ func (n *NoKeywordsParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *NoKeywordsParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *NoKeywordsParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *NoKeywordsParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *NoKeywordsParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8339,6 +14721,15 @@ func (n *NoKeywordsParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *NoKeywordsParameterNode) MarshalJSON() ([]byte, error) {
+	type node NoKeywordsParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an implicit set of parameters through the use of numbered parameters within a block or lambda.
 //
 //	-> { _1 + _2 }
@@ -8370,6 +14761,26 @@ func (n *NumberedParametersNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *NumberedParametersNode) Type() NodeType {
+	return NodeTypeNumberedParametersNode
+}
+
+// Flags returns the flags set on this node.
+func (n *NumberedParametersNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *NumberedParametersNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *NumberedParametersNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *NumberedParametersNode) Accept(visitor Visitor) {
 	visitor.VisitNumberedParametersNode(n)
@@ -8387,6 +14798,21 @@ func (n *NumberedParametersNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *NumberedParametersNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *NumberedParametersNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *NumberedParametersNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *NumberedParametersNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8397,6 +14823,15 @@ func (n *NumberedParametersNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *NumberedParametersNode) MarshalJSON() ([]byte, error) {
+	type node NumberedParametersNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents reading a numbered reference to a capture in the previous match.
 //
 //	$1
@@ -8428,6 +14863,26 @@ func (n *NumberedReferenceReadNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *NumberedReferenceReadNode) Type() NodeType {
+	return NodeTypeNumberedReferenceReadNode
+}
+
+// Flags returns the flags set on this node.
+func (n *NumberedReferenceReadNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *NumberedReferenceReadNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *NumberedReferenceReadNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *NumberedReferenceReadNode) Accept(visitor Visitor) {
 	visitor.VisitNumberedReferenceReadNode(n)
@@ -8445,6 +14900,21 @@ func (n *NumberedReferenceReadNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *NumberedReferenceReadNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *NumberedReferenceReadNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *NumberedReferenceReadNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *NumberedReferenceReadNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8455,6 +14925,15 @@ func (n *NumberedReferenceReadNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *NumberedReferenceReadNode) MarshalJSON() ([]byte, error) {
+	type node NumberedReferenceReadNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an optional keyword parameter to a method, block, or lambda definition.
 //
 //	def a(b: 1)
@@ -8491,6 +14970,26 @@ func (n *OptionalKeywordParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *OptionalKeywordParameterNode) Type() NodeType {
+	return NodeTypeOptionalKeywordParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *OptionalKeywordParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *OptionalKeywordParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *OptionalKeywordParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *OptionalKeywordParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -8515,6 +15014,33 @@ func (n *OptionalKeywordParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *OptionalKeywordParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *OptionalKeywordParameterNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *OptionalKeywordParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *OptionalKeywordParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8527,6 +15053,15 @@ func (n *OptionalKeywordParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *OptionalKeywordParameterNode) MarshalJSON() ([]byte, error) {
+	type node OptionalKeywordParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an optional parameter to a method, block, or lambda definition.
 //
 //	def a(b = 1)
@@ -8565,6 +15100,26 @@ func (n *OptionalParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *OptionalParameterNode) Type() NodeType {
+	return NodeTypeOptionalParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *OptionalParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *OptionalParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *OptionalParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *OptionalParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -8589,6 +15144,33 @@ func (n *OptionalParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *OptionalParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "value", Kind: FieldKindNode, Node: n.Value},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *OptionalParameterNode) SetChild(name string, child Node) error {
+	switch name {
+	case "value":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Value = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *OptionalParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *OptionalParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8602,6 +15184,15 @@ func (n *OptionalParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *OptionalParameterNode) MarshalJSON() ([]byte, error) {
+	type node OptionalParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `||` operator or the `or` keyword.
 //
 //	left or right
@@ -8637,6 +15228,26 @@ func (n *OrNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *OrNode) Type() NodeType {
+	return NodeTypeOrNode
+}
+
+// Flags returns the flags set on this node.
+func (n *OrNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *OrNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *OrNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *OrNode) Accept(visitor Visitor) {
 	visitor.VisitOrNode(n)
@@ -8658,6 +15269,40 @@ func (n *OrNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *OrNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "left", Kind: FieldKindNode, Node: n.Left},
+		{Name: "right", Kind: FieldKindNode, Node: n.Right},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *OrNode) SetChild(name string, child Node) error {
+	switch name {
+	case "left":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Left = value
+	case "right":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Right = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *OrNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *OrNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8670,6 +15315,15 @@ func (n *OrNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *OrNode) MarshalJSON() ([]byte, error) {
+	type node OrNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the list of parameters on a method, block, or lambda definition.
 //
 //	def a(b, c, d)
@@ -8714,6 +15368,26 @@ func (n *ParametersNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ParametersNode) Type() NodeType {
+	return NodeTypeParametersNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ParametersNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ParametersNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ParametersNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ParametersNode) Accept(visitor Visitor) {
 	visitor.VisitParametersNode(n)
@@ -8757,6 +15431,63 @@ func (n *ParametersNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ParametersNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "requireds", Kind: FieldKindNodeList, Nodes: n.Requireds},
+		{Name: "optionals", Kind: FieldKindNodeList, Nodes: n.Optionals},
+		{Name: "rest", Kind: FieldKindOptionalNode, Node: n.Rest},
+		{Name: "posts", Kind: FieldKindNodeList, Nodes: n.Posts},
+		{Name: "keywords", Kind: FieldKindNodeList, Nodes: n.Keywords},
+		{Name: "keyword_rest", Kind: FieldKindOptionalNode, Node: n.KeywordRest},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Block)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ParametersNode) SetChild(name string, child Node) error {
+	switch name {
+	case "rest":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Rest = value
+	case "keyword_rest":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.KeywordRest = value
+	case "block":
+		value, err := castChild[*BlockParameterNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ParametersNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "requireds":
+		n.Requireds = children
+	case "optionals":
+		n.Optionals = children
+	case "posts":
+		n.Posts = children
+	case "keywords":
+		n.Keywords = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ParametersNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8773,6 +15504,15 @@ func (n *ParametersNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ParametersNode) MarshalJSON() ([]byte, error) {
+	type node ParametersNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a parenthesized expression
 //
 //	(10 + 34)
@@ -8808,6 +15548,26 @@ func (n *ParenthesesNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ParenthesesNode) Type() NodeType {
+	return NodeTypeParenthesesNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ParenthesesNode) Flags() FlagSet {
+	return ParenthesesNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ParenthesesNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ParenthesesNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsMULTIPLE_STATEMENTS returns true if this node has the MULTIPLE_STATEMENTS flag.
 func (n *ParenthesesNode) IsMULTIPLE_STATEMENTS() bool {
 	return (n.flags & ParenthesesNodeFlagsMULTIPLE_STATEMENTS) != 0
@@ -8836,6 +15596,33 @@ func (n *ParenthesesNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ParenthesesNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ParenthesesNode) SetChild(name string, child Node) error {
+	switch name {
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ParenthesesNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ParenthesesNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8848,6 +15635,15 @@ func (n *ParenthesesNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ParenthesesNode) MarshalJSON() ([]byte, error) {
+	type node ParenthesesNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `^` operator for pinning an expression in a pattern matching expression.
 //
 //	foo in ^(bar)
@@ -8885,6 +15681,26 @@ func (n *PinnedExpressionNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *PinnedExpressionNode) Type() NodeType {
+	return NodeTypePinnedExpressionNode
+}
+
+// Flags returns the flags set on this node.
+func (n *PinnedExpressionNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *PinnedExpressionNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *PinnedExpressionNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *PinnedExpressionNode) Accept(visitor Visitor) {
 	visitor.VisitPinnedExpressionNode(n)
@@ -8904,6 +15720,33 @@ func (n *PinnedExpressionNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *PinnedExpressionNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "expression", Kind: FieldKindNode, Node: n.Expression},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *PinnedExpressionNode) SetChild(name string, child Node) error {
+	switch name {
+	case "expression":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Expression = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *PinnedExpressionNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *PinnedExpressionNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8917,6 +15760,15 @@ func (n *PinnedExpressionNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *PinnedExpressionNode) MarshalJSON() ([]byte, error) {
+	type node PinnedExpressionNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `^` operator for pinning a variable in a pattern matching expression.
 //
 //	foo in ^bar
@@ -8950,6 +15802,26 @@ func (n *PinnedVariableNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *PinnedVariableNode) Type() NodeType {
+	return NodeTypePinnedVariableNode
+}
+
+// Flags returns the flags set on this node.
+func (n *PinnedVariableNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *PinnedVariableNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *PinnedVariableNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *PinnedVariableNode) Accept(visitor Visitor) {
 	visitor.VisitPinnedVariableNode(n)
@@ -8969,6 +15841,33 @@ func (n *PinnedVariableNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *PinnedVariableNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "variable", Kind: FieldKindNode, Node: n.Variable},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *PinnedVariableNode) SetChild(name string, child Node) error {
+	switch name {
+	case "variable":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Variable = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *PinnedVariableNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *PinnedVariableNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -8980,6 +15879,15 @@ func (n *PinnedVariableNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *PinnedVariableNode) MarshalJSON() ([]byte, error) {
+	type node PinnedVariableNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `END` keyword.
 //
 //	END { foo }
@@ -9017,6 +15925,26 @@ func (n *PostExecutionNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *PostExecutionNode) Type() NodeType {
+	return NodeTypePostExecutionNode
+}
+
+// Flags returns the flags set on this node.
+func (n *PostExecutionNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *PostExecutionNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *PostExecutionNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *PostExecutionNode) Accept(visitor Visitor) {
 	visitor.VisitPostExecutionNode(n)
@@ -9040,6 +15968,33 @@ func (n *PostExecutionNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *PostExecutionNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *PostExecutionNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *PostExecutionNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *PostExecutionNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9053,6 +16008,15 @@ func (n *PostExecutionNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *PostExecutionNode) MarshalJSON() ([]byte, error) {
+	type node PostExecutionNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `BEGIN` keyword.
 //
 //	BEGIN { foo }
@@ -9090,6 +16054,26 @@ func (n *PreExecutionNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *PreExecutionNode) Type() NodeType {
+	return NodeTypePreExecutionNode
+}
+
+// Flags returns the flags set on this node.
+func (n *PreExecutionNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *PreExecutionNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *PreExecutionNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *PreExecutionNode) Accept(visitor Visitor) {
 	visitor.VisitPreExecutionNode(n)
@@ -9113,6 +16097,33 @@ func (n *PreExecutionNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *PreExecutionNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *PreExecutionNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *PreExecutionNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *PreExecutionNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9126,6 +16137,15 @@ func (n *PreExecutionNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *PreExecutionNode) MarshalJSON() ([]byte, error) {
+	type node PreExecutionNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // The top level node of any parse tree.
 type ProgramNode struct {
 	NodeID     int      `json:"nodeID"`
@@ -9156,6 +16176,26 @@ func (n *ProgramNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ProgramNode) Type() NodeType {
+	return NodeTypeProgramNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ProgramNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ProgramNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ProgramNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ProgramNode) Accept(visitor Visitor) {
 	visitor.VisitProgramNode(n)
@@ -9175,6 +16215,33 @@ func (n *ProgramNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ProgramNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "statements", Kind: FieldKindNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ProgramNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ProgramNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ProgramNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9186,6 +16253,15 @@ func (n *ProgramNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ProgramNode) MarshalJSON() ([]byte, error) {
+	type node ProgramNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `..` or `...` operators.
 //
 //	1..2
@@ -9224,6 +16300,26 @@ func (n *RangeNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RangeNode) Type() NodeType {
+	return NodeTypeRangeNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RangeNode) Flags() FlagSet {
+	return RangeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RangeNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RangeNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsEXCLUDE_END returns true if this node has the EXCLUDE_END flag.
 func (n *RangeNode) IsEXCLUDE_END() bool {
 	return (n.flags & RangeFlagsEXCLUDE_END) != 0
@@ -9258,6 +16354,40 @@ func (n *RangeNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RangeNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "left", Kind: FieldKindOptionalNode, Node: n.Left},
+		{Name: "right", Kind: FieldKindOptionalNode, Node: n.Right},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RangeNode) SetChild(name string, child Node) error {
+	switch name {
+	case "left":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Left = value
+	case "right":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Right = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RangeNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RangeNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9270,6 +16400,15 @@ func (n *RangeNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RangeNode) MarshalJSON() ([]byte, error) {
+	type node RangeNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a rational number literal.
 //
 //	1.0r
@@ -9303,6 +16442,26 @@ func (n *RationalNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RationalNode) Type() NodeType {
+	return NodeTypeRationalNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RationalNode) Flags() FlagSet {
+	return IntegerBaseFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RationalNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RationalNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsBINARY returns true if this node has the BINARY flag.
 func (n *RationalNode) IsBINARY() bool {
 	return (n.flags & IntegerBaseFlagsBINARY) != 0
@@ -9340,6 +16499,21 @@ func (n *RationalNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RationalNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RationalNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RationalNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RationalNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9351,6 +16525,15 @@ func (n *RationalNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RationalNode) MarshalJSON() ([]byte, error) {
+	type node RationalNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `redo` keyword.
 //
 //	redo
@@ -9380,6 +16563,26 @@ func (n *RedoNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RedoNode) Type() NodeType {
+	return NodeTypeRedoNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RedoNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RedoNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RedoNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *RedoNode) Accept(visitor Visitor) {
 	visitor.VisitRedoNode(n)
@@ -9397,6 +16600,21 @@ func (n *RedoNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RedoNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RedoNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RedoNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RedoNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9406,6 +16624,15 @@ func (n *RedoNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RedoNode) MarshalJSON() ([]byte, error) {
+	type node RedoNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a regular expression literal with no interpolation.
 //
 //	/foo/i
@@ -9443,6 +16670,26 @@ func (n *RegularExpressionNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RegularExpressionNode) Type() NodeType {
+	return NodeTypeRegularExpressionNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RegularExpressionNode) Flags() FlagSet {
+	return RegularExpressionFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RegularExpressionNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RegularExpressionNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsIGNORE_CASE returns true if this node has the IGNORE_CASE flag.
 func (n *RegularExpressionNode) IsIGNORE_CASE() bool {
 	return (n.flags & RegularExpressionFlagsIGNORE_CASE) != 0
@@ -9515,6 +16762,21 @@ func (n *RegularExpressionNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RegularExpressionNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RegularExpressionNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RegularExpressionNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RegularExpressionNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9528,6 +16790,15 @@ func (n *RegularExpressionNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RegularExpressionNode) MarshalJSON() ([]byte, error) {
+	type node RegularExpressionNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a required keyword parameter to a method, block, or lambda definition.
 //
 //	def a(b: )
@@ -9562,6 +16833,26 @@ func (n *RequiredKeywordParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RequiredKeywordParameterNode) Type() NodeType {
+	return NodeTypeRequiredKeywordParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RequiredKeywordParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RequiredKeywordParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RequiredKeywordParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *RequiredKeywordParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -9584,6 +16875,21 @@ func (n *RequiredKeywordParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RequiredKeywordParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RequiredKeywordParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RequiredKeywordParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RequiredKeywordParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9595,6 +16901,15 @@ func (n *RequiredKeywordParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RequiredKeywordParameterNode) MarshalJSON() ([]byte, error) {
+	type node RequiredKeywordParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a required parameter to a method, block, or lambda definition.
 //
 //	def a(b)
@@ -9627,6 +16942,26 @@ func (n *RequiredParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RequiredParameterNode) Type() NodeType {
+	return NodeTypeRequiredParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RequiredParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RequiredParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RequiredParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *RequiredParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -9649,6 +16984,21 @@ func (n *RequiredParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RequiredParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RequiredParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RequiredParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RequiredParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9659,6 +17009,15 @@ func (n *RequiredParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RequiredParameterNode) MarshalJSON() ([]byte, error) {
+	type node RequiredParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an expression modified with a rescue.
 //
 //	foo rescue nil
@@ -9694,6 +17053,26 @@ func (n *RescueModifierNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RescueModifierNode) Type() NodeType {
+	return NodeTypeRescueModifierNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RescueModifierNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RescueModifierNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RescueModifierNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *RescueModifierNode) Accept(visitor Visitor) {
 	visitor.VisitRescueModifierNode(n)
@@ -9715,6 +17094,40 @@ func (n *RescueModifierNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RescueModifierNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "expression", Kind: FieldKindNode, Node: n.Expression},
+		{Name: "rescue_expression", Kind: FieldKindNode, Node: n.RescueExpression},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RescueModifierNode) SetChild(name string, child Node) error {
+	switch name {
+	case "expression":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Expression = value
+	case "rescue_expression":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.RescueExpression = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RescueModifierNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RescueModifierNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9727,6 +17140,15 @@ func (n *RescueModifierNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RescueModifierNode) MarshalJSON() ([]byte, error) {
+	type node RescueModifierNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a rescue statement.
 //
 //	begin
@@ -9775,6 +17197,26 @@ func (n *RescueNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RescueNode) Type() NodeType {
+	return NodeTypeRescueNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RescueNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RescueNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RescueNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *RescueNode) Accept(visitor Visitor) {
 	visitor.VisitRescueNode(n)
@@ -9812,6 +17254,54 @@ func (n *RescueNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RescueNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "exceptions", Kind: FieldKindNodeList, Nodes: n.Exceptions},
+		{Name: "reference", Kind: FieldKindOptionalNode, Node: n.Reference},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+		{Name: "subsequent", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Subsequent)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RescueNode) SetChild(name string, child Node) error {
+	switch name {
+	case "reference":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Reference = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	case "subsequent":
+		value, err := castChild[*RescueNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Subsequent = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RescueNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "exceptions":
+		n.Exceptions = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RescueNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9828,6 +17318,15 @@ func (n *RescueNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RescueNode) MarshalJSON() ([]byte, error) {
+	type node RescueNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a rest parameter to a method, block, or lambda definition.
 //
 //	def a(*b)
@@ -9864,6 +17363,26 @@ func (n *RestParameterNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RestParameterNode) Type() NodeType {
+	return NodeTypeRestParameterNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RestParameterNode) Flags() FlagSet {
+	return ParameterFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RestParameterNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RestParameterNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsREPEATED_PARAMETER returns true if this node has the REPEATED_PARAMETER flag.
 func (n *RestParameterNode) IsREPEATED_PARAMETER() bool {
 	return (n.flags & ParameterFlagsREPEATED_PARAMETER) != 0
@@ -9886,6 +17405,21 @@ func (n *RestParameterNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RestParameterNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RestParameterNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RestParameterNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RestParameterNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9898,6 +17432,15 @@ func (n *RestParameterNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RestParameterNode) MarshalJSON() ([]byte, error) {
+	type node RestParameterNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `retry` keyword.
 //
 //	retry
@@ -9927,6 +17470,26 @@ func (n *RetryNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *RetryNode) Type() NodeType {
+	return NodeTypeRetryNode
+}
+
+// Flags returns the flags set on this node.
+func (n *RetryNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *RetryNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *RetryNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *RetryNode) Accept(visitor Visitor) {
 	visitor.VisitRetryNode(n)
@@ -9944,6 +17507,21 @@ func (n *RetryNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *RetryNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *RetryNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *RetryNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *RetryNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -9953,6 +17531,15 @@ func (n *RetryNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *RetryNode) MarshalJSON() ([]byte, error) {
+	type node RetryNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `return` keyword.
 //
 //	return 1
@@ -9986,6 +17573,26 @@ func (n *ReturnNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ReturnNode) Type() NodeType {
+	return NodeTypeReturnNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ReturnNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ReturnNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ReturnNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *ReturnNode) Accept(visitor Visitor) {
 	visitor.VisitReturnNode(n)
@@ -10009,6 +17616,33 @@ func (n *ReturnNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ReturnNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ReturnNode) SetChild(name string, child Node) error {
+	switch name {
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ReturnNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ReturnNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10020,6 +17654,15 @@ func (n *ReturnNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ReturnNode) MarshalJSON() ([]byte, error) {
+	type node ReturnNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the `self` keyword.
 //
 //	self
@@ -10049,6 +17692,26 @@ func (n *SelfNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SelfNode) Type() NodeType {
+	return NodeTypeSelfNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SelfNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SelfNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SelfNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *SelfNode) Accept(visitor Visitor) {
 	visitor.VisitSelfNode(n)
@@ -10066,6 +17729,21 @@ func (n *SelfNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SelfNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SelfNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SelfNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SelfNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10075,6 +17753,15 @@ func (n *SelfNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SelfNode) MarshalJSON() ([]byte, error) {
+	type node SelfNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // This node wraps a constant write to indicate that when the value is written, it should have its shareability state modified.
 //
 //	# shareable_constant_value: literal
@@ -10107,6 +17794,26 @@ func (n *ShareableConstantNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *ShareableConstantNode) Type() NodeType {
+	return NodeTypeShareableConstantNode
+}
+
+// Flags returns the flags set on this node.
+func (n *ShareableConstantNode) Flags() FlagSet {
+	return ShareableConstantNodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *ShareableConstantNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *ShareableConstantNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsLITERAL returns true if this node has the LITERAL flag.
 func (n *ShareableConstantNode) IsLITERAL() bool {
 	return (n.flags & ShareableConstantNodeFlagsLITERAL) != 0
@@ -10141,6 +17848,33 @@ func (n *ShareableConstantNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *ShareableConstantNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "write", Kind: FieldKindNode, Node: n.Write},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *ShareableConstantNode) SetChild(name string, child Node) error {
+	switch name {
+	case "write":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Write = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *ShareableConstantNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *ShareableConstantNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10151,6 +17885,15 @@ func (n *ShareableConstantNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *ShareableConstantNode) MarshalJSON() ([]byte, error) {
+	type node ShareableConstantNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a singleton class declaration involving the `class` keyword.
 //
 //	class << self end
@@ -10192,6 +17935,26 @@ func (n *SingletonClassNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SingletonClassNode) Type() NodeType {
+	return NodeTypeSingletonClassNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SingletonClassNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SingletonClassNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SingletonClassNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *SingletonClassNode) Accept(visitor Visitor) {
 	visitor.VisitSingletonClassNode(n)
@@ -10217,6 +17980,40 @@ func (n *SingletonClassNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SingletonClassNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "expression", Kind: FieldKindNode, Node: n.Expression},
+		{Name: "body", Kind: FieldKindOptionalNode, Node: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SingletonClassNode) SetChild(name string, child Node) error {
+	switch name {
+	case "expression":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Expression = value
+	case "body":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Body = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SingletonClassNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SingletonClassNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10232,6 +18029,15 @@ func (n *SingletonClassNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SingletonClassNode) MarshalJSON() ([]byte, error) {
+	type node SingletonClassNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `__ENCODING__` keyword.
 //
 //	__ENCODING__
@@ -10261,6 +18067,26 @@ func (n *SourceEncodingNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SourceEncodingNode) Type() NodeType {
+	return NodeTypeSourceEncodingNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SourceEncodingNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SourceEncodingNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SourceEncodingNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *SourceEncodingNode) Accept(visitor Visitor) {
 	visitor.VisitSourceEncodingNode(n)
@@ -10278,6 +18104,21 @@ func (n *SourceEncodingNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SourceEncodingNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SourceEncodingNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SourceEncodingNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SourceEncodingNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10287,6 +18128,15 @@ func (n *SourceEncodingNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SourceEncodingNode) MarshalJSON() ([]byte, error) {
+	type node SourceEncodingNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `__FILE__` keyword.
 //
 //	__FILE__
@@ -10313,9 +18163,29 @@ func (n *SourceFileNode) GetLocation() Location {
 	return n.Location
 }
 
-// GetNodeID returns the node ID of this node.
-func (n *SourceFileNode) GetNodeID() int {
-	return n.NodeID
+// GetNodeID returns the node ID of this node.
+func (n *SourceFileNode) GetNodeID() int {
+	return n.NodeID
+}
+
+// Type returns the type of this node.
+func (n *SourceFileNode) Type() NodeType {
+	return NodeTypeSourceFileNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SourceFileNode) Flags() FlagSet {
+	return StringFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SourceFileNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SourceFileNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
 }
 
 // IsFORCED_UTF8_ENCODING returns true if this node has the FORCED_UTF8_ENCODING flag.
@@ -10355,6 +18225,21 @@ func (n *SourceFileNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SourceFileNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SourceFileNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SourceFileNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SourceFileNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10365,6 +18250,15 @@ func (n *SourceFileNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SourceFileNode) MarshalJSON() ([]byte, error) {
+	type node SourceFileNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `__LINE__` keyword.
 //
 //	__LINE__
@@ -10394,6 +18288,26 @@ func (n *SourceLineNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SourceLineNode) Type() NodeType {
+	return NodeTypeSourceLineNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SourceLineNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SourceLineNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SourceLineNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *SourceLineNode) Accept(visitor Visitor) {
 	visitor.VisitSourceLineNode(n)
@@ -10411,6 +18325,21 @@ func (n *SourceLineNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SourceLineNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SourceLineNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SourceLineNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SourceLineNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10420,6 +18349,15 @@ func (n *SourceLineNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SourceLineNode) MarshalJSON() ([]byte, error) {
+	type node SourceLineNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the splat operator.
 //
 //	[*a]
@@ -10453,6 +18391,26 @@ func (n *SplatNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SplatNode) Type() NodeType {
+	return NodeTypeSplatNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SplatNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SplatNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SplatNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *SplatNode) Accept(visitor Visitor) {
 	visitor.VisitSplatNode(n)
@@ -10476,6 +18434,33 @@ func (n *SplatNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SplatNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "expression", Kind: FieldKindOptionalNode, Node: n.Expression},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SplatNode) SetChild(name string, child Node) error {
+	switch name {
+	case "expression":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Expression = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SplatNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SplatNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10487,6 +18472,15 @@ func (n *SplatNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SplatNode) MarshalJSON() ([]byte, error) {
+	type node SplatNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a set of statements contained within some scope.
 //
 //	foo; bar; baz
@@ -10518,6 +18512,26 @@ func (n *StatementsNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *StatementsNode) Type() NodeType {
+	return NodeTypeStatementsNode
+}
+
+// Flags returns the flags set on this node.
+func (n *StatementsNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *StatementsNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *StatementsNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *StatementsNode) Accept(visitor Visitor) {
 	visitor.VisitStatementsNode(n)
@@ -10537,6 +18551,29 @@ func (n *StatementsNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *StatementsNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "body", Kind: FieldKindNodeList, Nodes: n.Body},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *StatementsNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *StatementsNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "body":
+		n.Body = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *StatementsNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10547,6 +18584,15 @@ func (n *StatementsNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *StatementsNode) MarshalJSON() ([]byte, error) {
+	type node StatementsNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a string literal, a string contained within a `%w` list, or plain string content within an interpolated string.
 //
 //	"foo"
@@ -10590,6 +18636,26 @@ func (n *StringNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *StringNode) Type() NodeType {
+	return NodeTypeStringNode
+}
+
+// Flags returns the flags set on this node.
+func (n *StringNode) Flags() FlagSet {
+	return StringFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *StringNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *StringNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsFORCED_UTF8_ENCODING returns true if this node has the FORCED_UTF8_ENCODING flag.
 func (n *StringNode) IsFORCED_UTF8_ENCODING() bool {
 	return (n.flags & StringFlagsFORCED_UTF8_ENCODING) != 0
@@ -10627,6 +18693,21 @@ func (n *StringNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *StringNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *StringNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *StringNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *StringNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10640,6 +18721,15 @@ func (n *StringNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *StringNode) MarshalJSON() ([]byte, error) {
+	type node StringNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `super` keyword with parentheses or arguments.
 //
 //	super()
@@ -10682,6 +18772,26 @@ func (n *SuperNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SuperNode) Type() NodeType {
+	return NodeTypeSuperNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SuperNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SuperNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SuperNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *SuperNode) Accept(visitor Visitor) {
 	visitor.VisitSuperNode(n)
@@ -10711,6 +18821,40 @@ func (n *SuperNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SuperNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+		{Name: "block", Kind: FieldKindOptionalNode, Node: n.Block},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SuperNode) SetChild(name string, child Node) error {
+	switch name {
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	case "block":
+		value, err := castChild[Node](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Block = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SuperNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SuperNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10725,6 +18869,15 @@ func (n *SuperNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SuperNode) MarshalJSON() ([]byte, error) {
+	type node SuperNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents a symbol literal or a symbol contained within a `%i` list.
 //
 //	:foo
@@ -10765,6 +18918,26 @@ func (n *SymbolNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *SymbolNode) Type() NodeType {
+	return NodeTypeSymbolNode
+}
+
+// Flags returns the flags set on this node.
+func (n *SymbolNode) Flags() FlagSet {
+	return SymbolFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *SymbolNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *SymbolNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsFORCED_UTF8_ENCODING returns true if this node has the FORCED_UTF8_ENCODING flag.
 func (n *SymbolNode) IsFORCED_UTF8_ENCODING() bool {
 	return (n.flags & SymbolFlagsFORCED_UTF8_ENCODING) != 0
@@ -10797,6 +18970,21 @@ func (n *SymbolNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *SymbolNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *SymbolNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *SymbolNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *SymbolNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10810,6 +18998,15 @@ func (n *SymbolNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *SymbolNode) MarshalJSON() ([]byte, error) {
+	type node SymbolNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the literal `true` keyword.
 //
 //	true
@@ -10839,6 +19036,26 @@ func (n *TrueNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *TrueNode) Type() NodeType {
+	return NodeTypeTrueNode
+}
+
+// Flags returns the flags set on this node.
+func (n *TrueNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *TrueNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *TrueNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *TrueNode) Accept(visitor Visitor) {
 	visitor.VisitTrueNode(n)
@@ -10856,6 +19073,21 @@ func (n *TrueNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *TrueNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *TrueNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *TrueNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *TrueNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10865,6 +19097,15 @@ func (n *TrueNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *TrueNode) MarshalJSON() ([]byte, error) {
+	type node TrueNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `undef` keyword.
 //
 //	undef :foo, :bar, :baz
@@ -10898,6 +19139,26 @@ func (n *UndefNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *UndefNode) Type() NodeType {
+	return NodeTypeUndefNode
+}
+
+// Flags returns the flags set on this node.
+func (n *UndefNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *UndefNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *UndefNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *UndefNode) Accept(visitor Visitor) {
 	visitor.VisitUndefNode(n)
@@ -10917,6 +19178,29 @@ func (n *UndefNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *UndefNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "names", Kind: FieldKindNodeList, Nodes: n.Names},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *UndefNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *UndefNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "names":
+		n.Names = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *UndefNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -10928,6 +19212,15 @@ func (n *UndefNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *UndefNode) MarshalJSON() ([]byte, error) {
+	type node UndefNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `unless` keyword, either in the block form or the modifier form.
 //
 //	bar unless foo
@@ -10972,6 +19265,26 @@ func (n *UnlessNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *UnlessNode) Type() NodeType {
+	return NodeTypeUnlessNode
+}
+
+// Flags returns the flags set on this node.
+func (n *UnlessNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *UnlessNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *UnlessNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *UnlessNode) Accept(visitor Visitor) {
 	visitor.VisitUnlessNode(n)
@@ -11003,6 +19316,47 @@ func (n *UnlessNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *UnlessNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "predicate", Kind: FieldKindNode, Node: n.Predicate},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+		{Name: "else_clause", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.ElseClause)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *UnlessNode) SetChild(name string, child Node) error {
+	switch name {
+	case "predicate":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Predicate = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	case "else_clause":
+		value, err := castChild[*ElseNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.ElseClause = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *UnlessNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *UnlessNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -11018,6 +19372,15 @@ func (n *UnlessNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *UnlessNode) MarshalJSON() ([]byte, error) {
+	type node UnlessNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `until` keyword, either in the block form or the modifier form.
 //
 //	bar until foo
@@ -11060,6 +19423,26 @@ func (n *UntilNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *UntilNode) Type() NodeType {
+	return NodeTypeUntilNode
+}
+
+// Flags returns the flags set on this node.
+func (n *UntilNode) Flags() FlagSet {
+	return LoopFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *UntilNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *UntilNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsBEGIN_MODIFIER returns true if this node has the BEGIN_MODIFIER flag.
 func (n *UntilNode) IsBEGIN_MODIFIER() bool {
 	return (n.flags & LoopFlagsBEGIN_MODIFIER) != 0
@@ -11090,6 +19473,40 @@ func (n *UntilNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *UntilNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "predicate", Kind: FieldKindNode, Node: n.Predicate},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *UntilNode) SetChild(name string, child Node) error {
+	switch name {
+	case "predicate":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Predicate = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *UntilNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *UntilNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -11104,6 +19521,15 @@ func (n *UntilNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *UntilNode) MarshalJSON() ([]byte, error) {
+	type node UntilNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `when` keyword within a case statement.
 //
 //	case true
@@ -11143,6 +19569,26 @@ func (n *WhenNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *WhenNode) Type() NodeType {
+	return NodeTypeWhenNode
+}
+
+// Flags returns the flags set on this node.
+func (n *WhenNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *WhenNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *WhenNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *WhenNode) Accept(visitor Visitor) {
 	visitor.VisitWhenNode(n)
@@ -11168,6 +19614,40 @@ func (n *WhenNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *WhenNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "conditions", Kind: FieldKindNodeList, Nodes: n.Conditions},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *WhenNode) SetChild(name string, child Node) error {
+	switch name {
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *WhenNode) SetChildList(name string, children []Node) error {
+	switch name {
+	case "conditions":
+		n.Conditions = children
+	default:
+		return unknownChildError(n, name, true)
+	}
+	return nil
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *WhenNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -11181,6 +19661,15 @@ func (n *WhenNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *WhenNode) MarshalJSON() ([]byte, error) {
+	type node WhenNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `while` keyword, either in the block form or the modifier form.
 //
 //	bar while foo
@@ -11223,6 +19712,26 @@ func (n *WhileNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *WhileNode) Type() NodeType {
+	return NodeTypeWhileNode
+}
+
+// Flags returns the flags set on this node.
+func (n *WhileNode) Flags() FlagSet {
+	return LoopFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *WhileNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *WhileNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsBEGIN_MODIFIER returns true if this node has the BEGIN_MODIFIER flag.
 func (n *WhileNode) IsBEGIN_MODIFIER() bool {
 	return (n.flags & LoopFlagsBEGIN_MODIFIER) != 0
@@ -11253,6 +19762,40 @@ func (n *WhileNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *WhileNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "predicate", Kind: FieldKindNode, Node: n.Predicate},
+		{Name: "statements", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Statements)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *WhileNode) SetChild(name string, child Node) error {
+	switch name {
+	case "predicate":
+		value, err := castChild[Node](n, name, child, true)
+		if err != nil {
+			return err
+		}
+		n.Predicate = value
+	case "statements":
+		value, err := castChild[*StatementsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Statements = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *WhileNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *WhileNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -11267,6 +19810,15 @@ func (n *WhileNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *WhileNode) MarshalJSON() ([]byte, error) {
+	type node WhileNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents an xstring literal with no interpolation.
 //
 //	`foo`
@@ -11304,6 +19856,26 @@ func (n *XStringNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *XStringNode) Type() NodeType {
+	return NodeTypeXStringNode
+}
+
+// Flags returns the flags set on this node.
+func (n *XStringNode) Flags() FlagSet {
+	return EncodingFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *XStringNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *XStringNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // IsFORCED_UTF8_ENCODING returns true if this node has the FORCED_UTF8_ENCODING flag.
 func (n *XStringNode) IsFORCED_UTF8_ENCODING() bool {
 	return (n.flags & EncodingFlagsFORCED_UTF8_ENCODING) != 0
@@ -11331,6 +19903,21 @@ func (n *XStringNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *XStringNode) NamedChildren() []NamedChild {
+	return []NamedChild{}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *XStringNode) SetChild(name string, child Node) error {
+	return unknownChildError(n, name, false)
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *XStringNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *XStringNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -11344,6 +19931,15 @@ func (n *XStringNode) ToJSON() map[string]interface{} {
 	}
 }
 
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *XStringNode) MarshalJSON() ([]byte, error) {
+	type node XStringNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
+
 // Represents the use of the `yield` keyword.
 //
 //	yield 1
@@ -11381,6 +19977,26 @@ func (n *YieldNode) GetNodeID() int {
 	return n.NodeID
 }
 
+// Type returns the type of this node.
+func (n *YieldNode) Type() NodeType {
+	return NodeTypeYieldNode
+}
+
+// Flags returns the flags set on this node.
+func (n *YieldNode) Flags() FlagSet {
+	return NodeFlags(n.flags)
+}
+
+// IsNewline returns true if this node has the NEWLINE flag.
+func (n *YieldNode) IsNewline() bool {
+	return (n.flags & NodeFlagsNEWLINE) != 0
+}
+
+// IsStaticLiteral returns true if this node has the STATIC_LITERAL flag.
+func (n *YieldNode) IsStaticLiteral() bool {
+	return (n.flags & NodeFlagsSTATIC_LITERAL) != 0
+}
+
 // Accept calls the appropriate visit method on the visitor.
 func (n *YieldNode) Accept(visitor Visitor) {
 	visitor.VisitYieldNode(n)
@@ -11404,6 +20020,33 @@ func (n *YieldNode) CompactChildNodes() []Node {
 	return nodes
 }
 
+// NamedChildren returns the node-valued fields of the current node, labeled with their field names.
+func (n *YieldNode) NamedChildren() []NamedChild {
+	return []NamedChild{
+		{Name: "arguments", Kind: FieldKindOptionalNode, Node: nodeOrNil(n.Arguments)},
+	}
+}
+
+// SetChild replaces the node held by the named node or optional node field.
+func (n *YieldNode) SetChild(name string, child Node) error {
+	switch name {
+	case "arguments":
+		value, err := castChild[*ArgumentsNode](n, name, child, false)
+		if err != nil {
+			return err
+		}
+		n.Arguments = value
+	default:
+		return unknownChildError(n, name, false)
+	}
+	return nil
+}
+
+// SetChildList replaces the nodes held by the named node list field.
+func (n *YieldNode) SetChildList(name string, children []Node) error {
+	return unknownChildError(n, name, true)
+}
+
 // ToJSON converts the node to a JSON-serializable map.
 func (n *YieldNode) ToJSON() map[string]interface{} {
 	return map[string]interface{}{
@@ -11416,3 +20059,12 @@ func (n *YieldNode) ToJSON() map[string]interface{} {
 		"rparen_loc":  n.RparenLoc,
 	}
 }
+
+// MarshalJSON encodes the node as JSON, including its flags.
+func (n *YieldNode) MarshalJSON() ([]byte, error) {
+	type node YieldNode
+	return json.Marshal(struct {
+		*node
+		Flags uint32 `json:"flags"`
+	}{(*node)(n), n.flags})
+}
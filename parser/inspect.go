@@ -0,0 +1,158 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+)
+
+// Inspect returns a tree representation of the node in the format of Ruby's
+// Prism::Node#inspect. Lines, columns and source slices are computed from the
+// given source; when source is nil, locations are printed as byte offset
+// ranges such as 0...20 instead.
+func Inspect(node Node, source *Source) string {
+	i := &inspector{source: source}
+	i.node(node, "", "")
+	return i.buffer.String()
+}
+
+// Inspect returns a tree representation of the parsed program in the format
+// of Ruby's Prism::Node#inspect.
+func (r *ParseResult) Inspect() string {
+	return Inspect(r.Value, r.Source)
+}
+
+// formatNode implements fmt.Formatter for nodes. The %v and %s verbs print
+// the same tree as Inspect, without a source.
+func formatNode(node Node, f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		_, _ = io.WriteString(f, Inspect(node, nil))
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(%s)", verb, node.Type())
+	}
+}
+
+type inspector struct {
+	source *Source
+	buffer strings.Builder
+}
+
+// node writes the header of the node after prefix, followed by its fields
+// indented with indent.
+func (i *inspector) node(node Node, prefix, indent string) {
+	i.buffer.WriteString(prefix)
+	i.buffer.WriteString("@ ")
+	i.buffer.WriteString(node.Type().String())
+	i.buffer.WriteString(" (location: ")
+	i.buffer.WriteString(i.locationRange(node.GetLocation()))
+	i.buffer.WriteString(")\n")
+	inspectFields(i, node, indent)
+}
+
+func (i *inspector) pointer(last bool) (string, string) {
+	if last {
+		return "└── ", "    "
+	}
+	return "├── ", "│   "
+}
+
+func (i *inspector) field(indent string, last bool, name, value string) {
+	pointer, _ := i.pointer(last)
+	i.buffer.WriteString(indent)
+	i.buffer.WriteString(pointer)
+	i.buffer.WriteString(name)
+	i.buffer.WriteString(": ")
+	i.buffer.WriteString(value)
+	i.buffer.WriteString("\n")
+}
+
+func (i *inspector) flags(indent string, last bool, bits uint32, infos []FlagInfo) {
+	names := flagNames(bits, infos)
+	if len(names) == 0 {
+		i.field(indent, last, "flags", "∅")
+		return
+	}
+	for index, name := range names {
+		names[index] = strings.ToLower(name)
+	}
+	i.field(indent, last, "flags", strings.Join(names, ", "))
+}
+
+func (i *inspector) child(indent string, last bool, name string, child Node) {
+	if child == nil {
+		i.field(indent, last, name, "∅")
+		return
+	}
+	pointer, preadd := i.pointer(last)
+	i.buffer.WriteString(indent)
+	i.buffer.WriteString(pointer)
+	i.buffer.WriteString(name)
+	i.buffer.WriteString(":\n")
+	i.node(child, indent+preadd, indent+preadd)
+}
+
+func (i *inspector) list(indent string, last bool, name string, children []Node) {
+	i.field(indent, last, name, fmt.Sprintf("(length: %d)", len(children)))
+	_, preadd := i.pointer(last)
+	for index, child := range children {
+		pointer, childPreadd := i.pointer(index == len(children)-1)
+		if child == nil {
+			i.buffer.WriteString(indent + preadd + pointer + "∅\n")
+			continue
+		}
+		i.node(child, indent+preadd+pointer, indent+preadd+childPreadd)
+	}
+}
+
+func (i *inspector) location(location *Location) string {
+	if location == nil {
+		return "∅"
+	}
+	if i.source == nil {
+		return i.locationRange(*location)
+	}
+	return i.locationRange(*location) + " = " + ruby.InspectString(string(i.source.Slice(*location)), true)
+}
+
+func (i *inspector) locationRange(location Location) string {
+	endOffset := location.StartOffset + location.Length
+	if i.source == nil {
+		return fmt.Sprintf("%d...%d", location.StartOffset, endOffset)
+	}
+	return i.position(location.StartOffset) + "-" + i.position(endOffset)
+}
+
+func (i *inspector) position(offset int) string {
+	line, err := i.source.Line(offset)
+	if err != nil {
+		return strconv.Itoa(offset)
+	}
+	column, err := i.source.Column(offset)
+	if err != nil {
+		return strconv.Itoa(offset)
+	}
+	return fmt.Sprintf("(%d,%d)", line, column)
+}
+
+func inspectRubyString(value RubyString) string {
+	return ruby.InspectString(value.Value, strings.EqualFold(value.Encoding, "utf-8"))
+}
+
+func inspectOptionalSymbol(name *string) string {
+	if name == nil {
+		return "∅"
+	}
+	return ruby.InspectSymbol(*name)
+}
+
+func inspectSymbols(names []string) string {
+	symbols := make([]string, len(names))
+	for index, name := range names {
+		symbols[index] = ruby.InspectSymbol(name)
+	}
+	return "[" + strings.Join(symbols, ", ") + "]"
+}
@@ -0,0 +1,134 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			"foo(1)",
+			`@ ProgramNode (location: (1,0)-(1,6))
+├── flags: ∅
+├── locals: []
+└── statements:
+    @ StatementsNode (location: (1,0)-(1,6))
+    ├── flags: ∅
+    └── body: (length: 1)
+        └── @ CallNode (location: (1,0)-(1,6))
+            ├── flags: newline, ignore_visibility
+            ├── receiver: ∅
+            ├── call_operator_loc: ∅
+            ├── name: :foo
+            ├── message_loc: (1,0)-(1,3) = "foo"
+            ├── opening_loc: (1,3)-(1,4) = "("
+            ├── arguments:
+            │   @ ArgumentsNode (location: (1,4)-(1,5))
+            │   ├── flags: ∅
+            │   └── arguments: (length: 1)
+            │       └── @ IntegerNode (location: (1,4)-(1,5))
+            │           ├── flags: static_literal, decimal
+            │           └── value: 1
+            ├── closing_loc: (1,5)-(1,6) = ")"
+            └── block: ∅
+`,
+		},
+		{
+			// The closing of the heredoc ends at the end of the source, on
+			// the line after the last newline.
+			"x = <<~E\n  a\nE\n",
+			`@ ProgramNode (location: (1,0)-(1,8))
+├── flags: ∅
+├── locals: [:x]
+└── statements:
+    @ StatementsNode (location: (1,0)-(1,8))
+    ├── flags: ∅
+    └── body: (length: 1)
+        └── @ LocalVariableWriteNode (location: (1,0)-(1,8))
+            ├── flags: newline
+            ├── name: :x
+            ├── depth: 0
+            ├── name_loc: (1,0)-(1,1) = "x"
+            ├── value:
+            │   @ StringNode (location: (1,4)-(1,8))
+            │   ├── flags: ∅
+            │   ├── opening_loc: (1,4)-(1,8) = "<<~E"
+            │   ├── content_loc: (2,0)-(3,0) = "  a\n"
+            │   ├── closing_loc: (3,0)-(4,0) = "E\n"
+            │   └── unescaped: "a\n"
+            └── operator_loc: (1,2)-(1,3) = "="
+`,
+		},
+		{
+			`:"a b" if @x`,
+			`@ ProgramNode (location: (1,0)-(1,12))
+├── flags: ∅
+├── locals: []
+└── statements:
+    @ StatementsNode (location: (1,0)-(1,12))
+    ├── flags: ∅
+    └── body: (length: 1)
+        └── @ IfNode (location: (1,0)-(1,12))
+            ├── flags: newline
+            ├── if_keyword_loc: (1,7)-(1,9) = "if"
+            ├── predicate:
+            │   @ InstanceVariableReadNode (location: (1,10)-(1,12))
+            │   ├── flags: ∅
+            │   └── name: :@x
+            ├── then_keyword_loc: ∅
+            ├── statements:
+            │   @ StatementsNode (location: (1,0)-(1,6))
+            │   ├── flags: ∅
+            │   └── body: (length: 1)
+            │       └── @ SymbolNode (location: (1,0)-(1,6))
+            │           ├── flags: newline, static_literal, forced_us_ascii_encoding
+            │           ├── opening_loc: (1,0)-(1,2) = ":\""
+            │           ├── value_loc: (1,2)-(1,5) = "a b"
+            │           ├── closing_loc: (1,5)-(1,6) = "\""
+            │           └── unescaped: "a b"
+            ├── subsequent: ∅
+            └── end_keyword_loc: ∅
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := parse(t, test.source).Inspect(); got != test.want {
+				t.Errorf("Inspect() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatNode(t *testing.T) {
+	node := statement(t, "[a, 1]")
+	want := `@ ArrayNode (location: 0...6)
+├── flags: newline
+├── elements: (length: 2)
+│   ├── @ CallNode (location: 1...2)
+│   │   ├── flags: variable_call, ignore_visibility
+│   │   ├── receiver: ∅
+│   │   ├── call_operator_loc: ∅
+│   │   ├── name: :a
+│   │   ├── message_loc: 1...2
+│   │   ├── opening_loc: ∅
+│   │   ├── arguments: ∅
+│   │   ├── closing_loc: ∅
+│   │   └── block: ∅
+│   └── @ IntegerNode (location: 4...5)
+│       ├── flags: static_literal, decimal
+│       └── value: 1
+├── opening_loc: 0...1
+└── closing_loc: 5...6
+`
+	if got := fmt.Sprintf("%v", node); got != want {
+		t.Errorf("%%v =\n%s\nwant\n%s", got, want)
+	}
+	if got := fmt.Sprintf("%d", node); got != "%!d(ArrayNode)" {
+		t.Errorf("%%d = %s", got)
+	}
+}
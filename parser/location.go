@@ -0,0 +1,12 @@
+package parser
+
+// GetLocation returns the location itself, so that a Location can be passed
+// wherever a node is accepted for its location.
+func (l Location) GetLocation() Location {
+	return l
+}
+
+// EndOffset returns the byte offset just past the end of the location.
+func (l Location) EndOffset() int {
+	return l.StartOffset + l.Length
+}
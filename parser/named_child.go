@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"fmt"
+)
+
+// NamedChild is a node-valued field of a node, labeled with its field name.
+// Optional fields that are not set are still reported, with a nil Node, so the
+// position of a child never depends on which other fields are present.
+type NamedChild struct {
+	Name string
+	Kind FieldKind
+	// Node is set for node and optional node fields.
+	Node Node
+	// Nodes is set for node list fields.
+	Nodes []Node
+}
+
+// nodeOrNil converts a typed node pointer to a Node, keeping nil pointers as a nil interface.
+func nodeOrNil[T any, P interface {
+	*T
+	Node
+}](node P) Node {
+	if node == nil {
+		return nil
+	}
+	return node
+}
+
+// castChild checks that child can be stored in the named field of parent.
+func castChild[T Node](parent Node, name string, child Node, required bool) (T, error) {
+	var zero T
+	if child == nil {
+		if required {
+			return zero, fmt.Errorf("%s.%s is required and cannot be nil", parent.Type(), name)
+		}
+		return zero, nil
+	}
+	value, ok := child.(T)
+	if !ok {
+		return zero, fmt.Errorf("%s.%s cannot hold a %s", parent.Type(), name, child.Type())
+	}
+	return value, nil
+}
+
+// unknownChildError reports that parent has no field with the given name and kind.
+func unknownChildError(parent Node, name string, list bool) error {
+	if list {
+		return fmt.Errorf("%s has no node list field named %q", parent.Type(), name)
+	}
+	return fmt.Errorf("%s has no node field named %q", parent.Type(), name)
+}
@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNamedChildren(t *testing.T) {
+	tests := []struct {
+		source string
+		want   []string
+	}{
+		{"foo.bar(1) { }", []string{"receiver=CallNode", "arguments=ArgumentsNode", "block=BlockNode"}},
+		{"bar", []string{"receiver=∅", "arguments=∅", "block=∅"}},
+		{"x = 1", []string{"value=IntegerNode"}},
+		{"[1, :a]", []string{"elements=[IntegerNode SymbolNode]"}},
+		{"if a then b else c end", []string{"predicate=CallNode", "statements=StatementsNode", "subsequent=ElseNode"}},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			var got []string
+			for _, child := range statement(t, test.source).NamedChildren() {
+				got = append(got, child.Name+"="+describe(child))
+			}
+			if strings.Join(got, " ") != strings.Join(test.want, " ") {
+				t.Errorf("NamedChildren() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func describe(child NamedChild) string {
+	if child.Kind == FieldKindNodeList {
+		var types []string
+		for _, node := range child.Nodes {
+			types = append(types, node.Type().String())
+		}
+		return "[" + strings.Join(types, " ") + "]"
+	}
+	if child.Node == nil {
+		return "∅"
+	}
+	return child.Node.Type().String()
+}
+
+func TestSetChild(t *testing.T) {
+	call := statement(t, "foo.bar(1)").(*CallNode)
+	integer := call.Arguments.Arguments[0]
+
+	if err := call.SetChild("receiver", integer); err != nil {
+		t.Fatal(err)
+	}
+	if call.Receiver != integer {
+		t.Errorf("receiver = %v, want the integer", call.Receiver)
+	}
+	if err := call.SetChild("receiver", nil); err != nil || call.Receiver != nil {
+		t.Errorf("SetChild(receiver, nil) = %v, receiver %v", err, call.Receiver)
+	}
+	if err := call.SetChild("arguments", nil); err != nil || call.Arguments != nil {
+		t.Errorf("SetChild(arguments, nil) = %v, arguments %v", err, call.Arguments)
+	}
+
+	errors := []struct {
+		name  string
+		child Node
+		want  string
+	}{
+		{"arguments", integer, "CallNode.arguments cannot hold a IntegerNode"},
+		{"nope", integer, `CallNode has no node field named "nope"`},
+	}
+	for _, test := range errors {
+		if err := call.SetChild(test.name, test.child); err == nil || err.Error() != test.want {
+			t.Errorf("SetChild(%s) = %v, want %s", test.name, err, test.want)
+		}
+	}
+
+	write := statement(t, "x = 1").(*LocalVariableWriteNode)
+	if err := write.SetChild("value", nil); err == nil || err.Error() != "LocalVariableWriteNode.value is required and cannot be nil" {
+		t.Errorf("SetChild(value, nil) = %v", err)
+	}
+}
+
+func TestSetChildList(t *testing.T) {
+	array := statement(t, "[1, 2]").(*ArrayNode)
+	elements := []Node{array.Elements[1]}
+	if err := array.SetChildList("elements", elements); err != nil {
+		t.Fatal(err)
+	}
+	if len(array.Elements) != 1 || array.Elements[0] != elements[0] {
+		t.Errorf("elements = %v, want %v", array.Elements, elements)
+	}
+	if err := array.SetChildList("nope", nil); err == nil || err.Error() != `ArrayNode has no node list field named "nope"` {
+		t.Errorf("SetChildList(nope) = %v", err)
+	}
+	call := statement(t, "foo").(*CallNode)
+	if err := call.SetChildList("receiver", nil); err == nil {
+		t.Error("SetChildList(receiver) on a node field succeeded")
+	}
+}
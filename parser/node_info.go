@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"fmt"
+)
+
+// FieldKind describes how the value of a node field is represented.
+type FieldKind uint8
+
+const (
+	FieldKindNode FieldKind = iota
+	FieldKindOptionalNode
+	FieldKindNodeList
+	FieldKindString
+	FieldKindConstant
+	FieldKindOptionalConstant
+	FieldKindConstantList
+	FieldKindLocation
+	FieldKindOptionalLocation
+	FieldKindUInt8
+	FieldKindUInt32
+	FieldKindInteger
+	FieldKindDouble
+	FieldKindFlags
+)
+
+var fieldKindNames = [...]string{
+	FieldKindNode:             "node",
+	FieldKindOptionalNode:     "node?",
+	FieldKindNodeList:         "node[]",
+	FieldKindString:           "string",
+	FieldKindConstant:         "constant",
+	FieldKindOptionalConstant: "constant?",
+	FieldKindConstantList:     "constant[]",
+	FieldKindLocation:         "location",
+	FieldKindOptionalLocation: "location?",
+	FieldKindUInt8:            "uint8",
+	FieldKindUInt32:           "uint32",
+	FieldKindInteger:          "integer",
+	FieldKindDouble:           "double",
+	FieldKindFlags:            "flags",
+}
+
+// String returns the name of the field kind as used in the prism config.
+func (k FieldKind) String() string {
+	if int(k) < len(fieldKindNames) {
+		return fieldKindNames[k]
+	}
+	return fmt.Sprintf("FieldKind(%d)", k)
+}
+
+// IsNode returns true if the field holds a node, an optional node or a list of nodes.
+func (k FieldKind) IsNode() bool {
+	return k == FieldKindNode || k == FieldKindOptionalNode || k == FieldKindNodeList
+}
+
+// FlagInfo describes a flag that can be set on a node.
+type FlagInfo struct {
+	Name string
+	Mask uint32
+}
+
+// FieldInfo describes a field of a node type.
+type FieldInfo struct {
+	// Name is the field name as used in the prism config and the JSON output.
+	Name string
+	Kind FieldKind
+	// NodeType is set when a node field can only hold a single node type.
+	NodeType NodeType
+	// Flags lists the flags that can be set when Kind is FieldKindFlags.
+	Flags []FlagInfo
+}
+
+// NodeInfo describes a node type and its fields, in declaration order.
+type NodeInfo struct {
+	Type   NodeType
+	Name   string
+	Fields []FieldInfo
+}
+
+// Field returns the field with the given name, or nil if there is none.
+func (i *NodeInfo) Field(name string) *FieldInfo {
+	for index := range i.Fields {
+		if i.Fields[index].Name == name {
+			return &i.Fields[index]
+		}
+	}
+	return nil
+}
+
+// Info returns the metadata of the node type, or nil if the type is unknown.
+func (t NodeType) Info() *NodeInfo {
+	if t == 0 || int(t) >= len(nodeInfos) {
+		return nil
+	}
+	return &nodeInfos[t]
+}
+
+// String returns the name of the node type, e.g. "CallNode".
+func (t NodeType) String() string {
+	if info := t.Info(); info != nil {
+		return info.Name
+	}
+	return fmt.Sprintf("NodeType(%d)", t)
+}
+
+// NodeTypes returns every known node type in declaration order.
+func NodeTypes() []NodeType {
+	types := make([]NodeType, 0, len(nodeInfos)-1)
+	for index := 1; index < len(nodeInfos); index++ {
+		types = append(types, NodeType(index))
+	}
+	return types
+}
+
+// LookupNodeType returns the node type with the given name, e.g. "CallNode".
+func LookupNodeType(name string) (NodeType, bool) {
+	for index := 1; index < len(nodeInfos); index++ {
+		if nodeInfos[index].Name == name {
+			return NodeType(index), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestNodeType(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"foo.bar", "CallNode"},
+		{"x = 1", "LocalVariableWriteNode"},
+		{"class Foo; end", "ClassNode"},
+		{"def foo; end", "DefNode"},
+		{"[1, 2]", "ArrayNode"},
+		{"{ a: 1 }", "HashNode"},
+		{`"a#{b}c"`, "InterpolatedStringNode"},
+		{"Foo::Bar", "ConstantPathNode"},
+		{"case x; in [a] then a; end", "CaseMatchNode"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			node := statement(t, test.source)
+			if got := node.Type().String(); got != test.want {
+				t.Errorf("Type() = %s, want %s", got, test.want)
+			}
+			if got, ok := LookupNodeType(test.want); !ok || got != node.Type() {
+				t.Errorf("LookupNodeType(%q) = %v, %v, want %v", test.want, got, ok, node.Type())
+			}
+			if info := node.Type().Info(); info == nil || info.Type != node.Type() || info.Name != test.want {
+				t.Errorf("Info() = %+v, want the info of %s", info, test.want)
+			}
+		})
+	}
+}
+
+func TestNodeTypes(t *testing.T) {
+	types := NodeTypes()
+	if len(types) == 0 {
+		t.Fatal("NodeTypes() is empty")
+	}
+	for _, nodeType := range types {
+		info := nodeType.Info()
+		if info == nil {
+			t.Fatalf("%d has no info", nodeType)
+		}
+		if got, ok := LookupNodeType(info.Name); !ok || got != nodeType {
+			t.Errorf("LookupNodeType(%q) = %v, %v, want %v", info.Name, got, ok, nodeType)
+		}
+	}
+	if got := NodeType(0).String(); got != "NodeType(0)" {
+		t.Errorf("NodeType(0).String() = %q", got)
+	}
+	if _, ok := LookupNodeType("NoSuchNode"); ok {
+		t.Error("LookupNodeType(NoSuchNode) found a type")
+	}
+}
+
+func TestNodeInfoFields(t *testing.T) {
+	tests := []struct {
+		nodeType NodeType
+		fields   []string
+		kinds    []FieldKind
+	}{
+		{
+			NodeTypeCallNode,
+			[]string{"flags", "receiver", "call_operator_loc", "name", "message_loc", "opening_loc", "arguments", "closing_loc", "block"},
+			[]FieldKind{FieldKindFlags, FieldKindOptionalNode, FieldKindOptionalLocation, FieldKindConstant, FieldKindOptionalLocation, FieldKindOptionalLocation, FieldKindOptionalNode, FieldKindOptionalLocation, FieldKindOptionalNode},
+		},
+		{
+			NodeTypeStatementsNode,
+			[]string{"body"},
+			[]FieldKind{FieldKindNodeList},
+		},
+		{
+			NodeTypeIntegerNode,
+			[]string{"flags", "value"},
+			[]FieldKind{FieldKindFlags, FieldKindInteger},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.nodeType.String(), func(t *testing.T) {
+			info := test.nodeType.Info()
+			if len(info.Fields) != len(test.fields) {
+				t.Fatalf("%d fields, want %d: %+v", len(info.Fields), len(test.fields), info.Fields)
+			}
+			for index, field := range info.Fields {
+				if field.Name != test.fields[index] || field.Kind != test.kinds[index] {
+					t.Errorf("field %d = %s %s, want %s %s", index, field.Name, field.Kind, test.fields[index], test.kinds[index])
+				}
+				if got := info.Field(field.Name); got == nil || got.Name != field.Name {
+					t.Errorf("Field(%q) = %v", field.Name, got)
+				}
+			}
+			if info.Field("nope") != nil {
+				t.Error(`Field("nope") is not nil`)
+			}
+		})
+	}
+	if field := NodeTypeCallNode.Info().Field("arguments"); field.NodeType != NodeTypeArgumentsNode {
+		t.Errorf("CallNode.arguments NodeType = %s, want ArgumentsNode", field.NodeType)
+	}
+}
@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+var (
+	testParser     *Parser
+	testParserErr  error
+	testParserOnce sync.Once
+)
+
+// parse parses source with a parser shared by the tests, and fails the test
+// if the source has syntax errors.
+func parse(t *testing.T, source string) *ParseResult {
+	t.Helper()
+	testParserOnce.Do(func() {
+		testParser, testParserErr = NewParser(context.Background())
+	})
+	if testParserErr != nil {
+		t.Fatalf("NewParser: %v", testParserErr)
+	}
+	result, err := testParser.Parse(context.Background(), []byte(source))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", source, err)
+	}
+	if len(result.Errors) > 0 {
+		t.Fatalf("Parse(%q): %s", source, result.Errors[0].Message)
+	}
+	return result
+}
+
+// statement returns the first statement of source.
+func statement(t *testing.T, source string) Node {
+	t.Helper()
+	body := parse(t, source).Value.Statements.Body
+	if len(body) == 0 {
+		t.Fatalf("%q has no statements", source)
+	}
+	return body[0]
+}
+
+// TestParseTwiceWithOptions checks that a parser given scopes and the
+// frozen string literal option parses several sources with them. The
+// options are read again for each parse, from memory an earlier parse has
+// used.
+func TestParseTwiceWithOptions(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewParser(ctx, WithScopes([][][]byte{{[]byte("a")}}), WithFrozenStringLiteral(true))
+	if err != nil {
+		t.Fatalf("NewParser: %v", err)
+	}
+	defer p.Close(ctx)
+	for _, source := range []string{"a\n'x'", "a\n'y' + 'z'"} {
+		result, err := p.Parse(ctx, []byte(source))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", source, err)
+		}
+		if len(result.Errors) > 0 {
+			t.Fatalf("Parse(%q): %s", source, result.Errors[0].Message)
+		}
+		body := result.Value.Statements.Body
+		if _, ok := body[0].(*LocalVariableReadNode); !ok {
+			t.Errorf("Parse(%q): a is a %T, want a local of the scope", source, body[0])
+		}
+		var str *StringNode
+		switch n := body[1].(type) {
+		case *StringNode:
+			str = n
+		case *CallNode:
+			str, _ = n.Receiver.(*StringNode)
+		}
+		if str == nil || !str.IsFROZEN() {
+			t.Errorf("Parse(%q): the string literal is not frozen", source)
+		}
+	}
+}
@@ -103,6 +103,9 @@ func serializeParserOptions(
 		output.WriteByte(0)
 	}
 
+	// freeze, which prism reads since 1.4
+	output.WriteByte(0)
+
 	// scopes
 	if _, err := output.Write(serializeInt(len(scopes))); err != nil {
 		return nil, fmt.Errorf("failed to serialize scopes length: %w", err)
@@ -111,6 +114,8 @@ func serializeParserOptions(
 		if _, err := output.Write(serializeInt(len(scope))); err != nil {
 			return nil, fmt.Errorf("failed to serialize scope length: %w", err)
 		}
+		// forwarding parameters of the scope, none
+		output.WriteByte(0)
 		for _, local := range scope {
 			if _, err := output.Write(serializeInt(len(local))); err != nil {
 				return nil, fmt.Errorf("failed to serialize local length: %w", err)
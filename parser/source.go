@@ -34,29 +34,41 @@ func (s *Source) Line(byteOffset int) (int, error) {
 	return s.StartLine + line, nil
 }
 
+// FindLine returns the 0-based index of the line of a byte offset. The end
+// of the source is on the last line, which after a trailing newline is an
+// empty line, as in Ruby's Prism::Source#line. Offsets past the end of the
+// source are clamped to its end; offsets before its start are an error.
 func (s *Source) FindLine(byteOffset int) (int, error) {
-	if byteOffset >= len(s.Bytes) {
-		byteOffset = len(s.Bytes) - 1
-	}
 	if byteOffset < 0 {
 		return 0, fmt.Errorf("byteOffset must be non-negative")
 	}
-	index := sort.Search(len(s.LineOffsets), func(i int) bool {
+	byteOffset = min(byteOffset, len(s.Bytes))
+	line := sort.Search(len(s.LineOffsets), func(i int) bool {
 		return s.LineOffsets[i] > byteOffset
-	})
-	if index > 0 && s.LineOffsets[index-1] == byteOffset {
-		index--
-	}
-	line := index - 1
-	if index == 0 || s.LineOffsets[line] > byteOffset {
-		line++
-	}
+	}) - 1
 	if line < 0 || line >= len(s.LineOffsets) {
 		return 0, fmt.Errorf("line index out of bounds")
 	}
 	return line, nil
 }
 
+// Column returns the 0-based column of a byte offset, counted in bytes from
+// the start of its line. Offsets are clamped like in FindLine.
+func (s *Source) Column(byteOffset int) (int, error) {
+	line, err := s.FindLine(byteOffset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to calculate column number: %w", err)
+	}
+	return min(byteOffset, len(s.Bytes)) - s.LineOffsets[line], nil
+}
+
+// Slice returns the bytes of a location, clamped to the source.
+func (s *Source) Slice(location Location) []byte {
+	start := min(max(location.StartOffset, 0), len(s.Bytes))
+	end := min(max(location.StartOffset+location.Length, start), len(s.Bytes))
+	return s.Bytes[start:end]
+}
+
 func (s *Source) LineCount() int {
 	return len(s.LineOffsets)
 }
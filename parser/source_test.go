@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestSourceLineAndColumn(t *testing.T) {
+	source := NewSource([]byte("abc\ndef\n"))
+	source.SetLineOffsets([]int{0, 4, 8})
+	tests := []struct {
+		offset int
+		line   int
+		column int
+	}{
+		{0, 1, 0},
+		{2, 1, 2},
+		{3, 1, 3},
+		{4, 2, 0},
+		{7, 2, 3},
+		// The end of the source is on the empty line after the newline.
+		{8, 3, 0},
+	}
+	for _, test := range tests {
+		line, err := source.Line(test.offset)
+		if err != nil || line != test.line {
+			t.Errorf("Line(%d) = %d, %v, want %d", test.offset, line, err, test.line)
+		}
+		column, err := source.Column(test.offset)
+		if err != nil || column != test.column {
+			t.Errorf("Column(%d) = %d, %v, want %d", test.offset, column, err, test.column)
+		}
+	}
+	// Offsets past the end are clamped to it.
+	for _, offset := range []int{9, 100} {
+		if line, err := source.Line(offset); err != nil || line != 3 {
+			t.Errorf("Line(%d) = %d, %v, want 3", offset, line, err)
+		}
+		if column, err := source.Column(offset); err != nil || column != 0 {
+			t.Errorf("Column(%d) = %d, %v, want 0", offset, column, err)
+		}
+	}
+	if line, err := source.FindLine(-1); err == nil {
+		t.Errorf("FindLine(-1) = %d, want an error", line)
+	}
+	if column, err := source.Column(-1); err == nil {
+		t.Errorf("Column(-1) = %d, want an error", column)
+	}
+}
+
+func TestSourceStartLine(t *testing.T) {
+	result := parse(t, "a\nb")
+	result.Source.SetStartLine(10)
+	if line, err := result.Source.Line(2); err != nil || line != 11 {
+		t.Errorf("Line(2) = %d, %v, want 11", line, err)
+	}
+	if count := result.Source.LineCount(); count != 2 {
+		t.Errorf("LineCount() = %d, want 2", count)
+	}
+}
+
+func TestSourceSlice(t *testing.T) {
+	source := NewSource([]byte("hello"))
+	tests := []struct {
+		start, length int
+		want          string
+	}{
+		{0, 5, "hello"},
+		{1, 3, "ell"},
+		{5, 0, ""},
+		{3, 10, "lo"},
+		{10, 2, ""},
+		{-2, 4, "he"},
+		{2, -1, ""},
+	}
+	for _, test := range tests {
+		if got := string(source.Slice(Location{StartOffset: test.start, Length: test.length})); got != test.want {
+			t.Errorf("Slice(%d, %d) = %q, want %q", test.start, test.length, got, test.want)
+		}
+	}
+}
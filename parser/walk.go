@@ -0,0 +1,13 @@
+package parser
+
+// Walk visits the tree rooted at node in pre-order: node first, then each of
+// its child nodes in field order. When visit returns false, the children of
+// the node it was given are skipped. Nil nodes are not visited.
+func Walk(node Node, visit func(Node) bool) {
+	if isNilNode(node) || !visit(node) {
+		return
+	}
+	for _, child := range node.CompactChildNodes() {
+		Walk(child, visit)
+	}
+}
@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	result := parse(t, "def f(a) = a + 1\nclass C; end")
+	var visited []string
+	Walk(result.Value, func(node Node) bool {
+		visited = append(visited, node.Type().String())
+		// The body of the class is skipped.
+		return node.Type() != NodeTypeClassNode
+	})
+	want := "ProgramNode StatementsNode DefNode ParametersNode RequiredParameterNode StatementsNode CallNode LocalVariableReadNode ArgumentsNode IntegerNode ClassNode"
+	if got := strings.Join(visited, " "); got != want {
+		t.Errorf("Walk() visited %s\nwant            %s", got, want)
+	}
+
+	calls := 0
+	Walk((*CallNode)(nil), func(Node) bool {
+		calls++
+		return true
+	})
+	Walk(nil, func(Node) bool {
+		calls++
+		return true
+	})
+	if calls != 0 {
+		t.Errorf("Walk() visited %d nil nodes", calls)
+	}
+}
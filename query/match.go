@@ -0,0 +1,418 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// element is a node with its place in the tree a query runs over.
+type element struct {
+	node   parser.Node
+	parent *element
+	// field is the name of the parent field holding the node.
+	field string
+	// position is the index of the element among its parent's children.
+	position int
+	children []*element
+}
+
+type tree struct {
+	root *element
+	// elements are in pre-order.
+	elements []*element
+	index    map[parser.Node]*element
+}
+
+func newTree(root parser.Node) *tree {
+	t := &tree{index: map[parser.Node]*element{}}
+	var visit func(node parser.Node, parent *element, field string) *element
+	visit = func(node parser.Node, parent *element, field string) *element {
+		e := &element{node: node, parent: parent, field: field}
+		if parent != nil {
+			e.position = len(parent.children)
+			parent.children = append(parent.children, e)
+		}
+		t.elements = append(t.elements, e)
+		t.index[node] = e
+		for _, child := range node.NamedChildren() {
+			if child.Kind == parser.FieldKindNodeList {
+				for _, n := range child.Nodes {
+					if n != nil {
+						visit(n, e, child.Name)
+					}
+				}
+			} else if child.Node != nil {
+				visit(child.Node, e, child.Name)
+			}
+		}
+		return e
+	}
+	t.root = visit(root, nil, "")
+	return t
+}
+
+// element returns the element of a node reached through a field path.
+func (t *tree) element(node parser.Node) *element {
+	if e, ok := t.index[node]; ok {
+		return e
+	}
+	return newTree(node).root
+}
+
+type capture struct {
+	name string
+	node parser.Node
+}
+
+type matcher struct {
+	tree *tree
+	// captures is a journal, truncated when a match fails.
+	captures []capture
+}
+
+func (m *matcher) result() map[string]parser.Node {
+	if len(m.captures) == 0 {
+		return nil
+	}
+	captures := map[string]parser.Node{}
+	for _, c := range m.captures {
+		captures[c.name] = c.node
+	}
+	return captures
+}
+
+// complex matches a selector from right to left, the rightmost compound
+// against e.
+func (m *matcher) complex(selector *complexSelector, e *element, scope *element) bool {
+	return m.from(selector, len(selector.compounds)-1, e, scope)
+}
+
+func (m *matcher) from(selector *complexSelector, index int, e *element, scope *element) bool {
+	mark := len(m.captures)
+	if !m.compound(selector.compounds[index], e, scope) {
+		return false
+	}
+	if index == 0 {
+		return true
+	}
+	switch selector.combinators[index-1] {
+	case descendant:
+		for p := e.parent; p != nil; p = p.parent {
+			if m.from(selector, index-1, p, scope) {
+				return true
+			}
+		}
+	case child:
+		if e.parent != nil && m.from(selector, index-1, e.parent, scope) {
+			return true
+		}
+	case adjacent:
+		if e.parent != nil && e.position > 0 && m.from(selector, index-1, e.parent.children[e.position-1], scope) {
+			return true
+		}
+	case sibling:
+		if e.parent != nil {
+			for _, s := range e.parent.children[:e.position] {
+				if m.from(selector, index-1, s, scope) {
+					return true
+				}
+			}
+		}
+	}
+	m.captures = m.captures[:mark]
+	return false
+}
+
+func (m *matcher) compound(c *compound, e *element, scope *element) bool {
+	if c.scope {
+		return e == scope
+	}
+	if c.word != "" && c.nodeType == 0 {
+		// A word that names no node type only matches text.
+		return false
+	}
+	if c.nodeType != 0 && e.node.Type() != c.nodeType {
+		return false
+	}
+	mark := len(m.captures)
+	for _, a := range c.attributes {
+		if !m.attribute(a, e) {
+			m.captures = m.captures[:mark]
+			return false
+		}
+	}
+	for _, p := range c.pseudos {
+		if !m.pseudo(p, e) {
+			m.captures = m.captures[:mark]
+			return false
+		}
+	}
+	if c.capture != "" {
+		m.captures = append(m.captures, capture{c.capture, e.node})
+	}
+	return true
+}
+
+func (m *matcher) pseudo(p *pseudo, e *element) bool {
+	switch p.name {
+	case "has":
+		for _, selector := range p.selectors {
+			for _, candidate := range related(e, selector.combinators[0]) {
+				if m.complex(selector, candidate, e) {
+					return true
+				}
+			}
+		}
+		return false
+	case "is":
+		for _, selector := range p.selectors {
+			if m.complex(selector, e, nil) {
+				return true
+			}
+		}
+		return false
+	case "not":
+		mark := len(m.captures)
+		defer func() { m.captures = m.captures[:mark] }()
+		for _, selector := range p.selectors {
+			if m.complex(selector, e, nil) {
+				return false
+			}
+		}
+		return true
+	case "root":
+		return e == m.tree.root
+	case "first-child":
+		return e.parent != nil && e.position == 0
+	case "last-child":
+		return e.parent != nil && e.position == len(e.parent.children)-1
+	case "nth-child":
+		return e.parent != nil && e.position == p.n-1
+	case "field":
+		return e.field == p.field
+	case "empty":
+		return len(e.children) == 0
+	}
+	return false
+}
+
+// related returns the elements a relative selector within :has can match:
+// the descendants of e, or for a selector starting with a sibling
+// combinator, the following siblings of e and their descendants.
+func related(e *element, first combinator) []*element {
+	var list []*element
+	var add func(e *element)
+	add = func(e *element) {
+		list = append(list, e)
+		for _, c := range e.children {
+			add(c)
+		}
+	}
+	if first == adjacent || first == sibling {
+		if e.parent != nil {
+			for _, s := range e.parent.children[e.position+1:] {
+				add(s)
+			}
+		}
+		return list
+	}
+	for _, c := range e.children {
+		add(c)
+	}
+	return list
+}
+
+func (m *matcher) attribute(a *attribute, e *element) bool {
+	v := resolve(e.node, a.path)
+	switch a.op {
+	case "":
+		return present(v)
+	case "=":
+		return m.equal(v, a.value)
+	case "!=":
+		mark := len(m.captures)
+		defer func() { m.captures = m.captures[:mark] }()
+		return !m.equal(v, a.value)
+	}
+	return compareText(v, func(text string) bool {
+		switch a.op {
+		case "^=":
+			return strings.HasPrefix(text, a.value.text)
+		case "$=":
+			return strings.HasSuffix(text, a.value.text)
+		}
+		return strings.Contains(text, a.value.text)
+	})
+}
+
+func (m *matcher) equal(v any, expected *value) bool {
+	switch v := v.(type) {
+	case []any:
+		for _, item := range v {
+			if m.equal(item, expected) {
+				return true
+			}
+		}
+		return false
+	case parser.Node:
+		return expected.selector != nil && m.compound(expected.selector, m.tree.element(v), nil)
+	}
+	return compareText(v, func(text string) bool {
+		if expected.regexp != nil {
+			return expected.regexp.MatchString(text)
+		}
+		return text == expected.text
+	})
+}
+
+// compareText applies a predicate to a text value, or to any text value of
+// a list.
+func compareText(v any, predicate func(string) bool) bool {
+	switch v := v.(type) {
+	case string:
+		return predicate(v)
+	case bool:
+		return predicate(strconv.FormatBool(v))
+	case []any:
+		for _, item := range v {
+			if compareText(item, predicate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func present(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case []any:
+		return len(v) > 0
+	case bool:
+		return v
+	}
+	return true
+}
+
+// resolve returns the value at a path from node: a node, a string, a bool
+// for flags and locations, a list of such values, or nil when the path
+// leads nowhere.
+func resolve(node parser.Node, path []string) any {
+	var current any = node
+	for _, segment := range path {
+		switch c := current.(type) {
+		case parser.Node:
+			current = field(c, segment)
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil {
+				return nil
+			}
+			if index < 0 {
+				index += len(c)
+			}
+			if index < 0 || index >= len(c) {
+				return nil
+			}
+			current = c[index]
+		default:
+			return nil
+		}
+		if current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+// field returns the value of the named field of node, using the generic
+// node metadata. Names that are not fields are looked up as flags.
+func field(node parser.Node, name string) any {
+	info := node.Type().Info()
+	if info == nil {
+		return nil
+	}
+	f := info.Field(name)
+	if f == nil {
+		return flag(node, name)
+	}
+	if f.Kind.IsNode() {
+		for _, child := range node.NamedChildren() {
+			if child.Name != name {
+				continue
+			}
+			if child.Kind == parser.FieldKindNodeList {
+				list := make([]any, 0, len(child.Nodes))
+				for _, n := range child.Nodes {
+					if n != nil {
+						list = append(list, n)
+					}
+				}
+				return list
+			}
+			if child.Node == nil {
+				return nil
+			}
+			return child.Node
+		}
+		return nil
+	}
+	v := node.ToJSON()[name]
+	switch f.Kind {
+	case parser.FieldKindString:
+		if s, ok := v.(parser.RubyString); ok {
+			return s.Value
+		}
+	case parser.FieldKindConstant:
+		return v
+	case parser.FieldKindOptionalConstant:
+		if s, ok := v.(*string); ok && s != nil {
+			return *s
+		}
+	case parser.FieldKindConstantList:
+		if names, ok := v.([]string); ok {
+			list := make([]any, len(names))
+			for index, n := range names {
+				list[index] = n
+			}
+			return list
+		}
+	case parser.FieldKindLocation:
+		return true
+	case parser.FieldKindOptionalLocation:
+		if location, ok := v.(*parser.Location); ok && location != nil {
+			return true
+		}
+	case parser.FieldKindFlags:
+		var list []any
+		for _, n := range flagNames(node) {
+			list = append(list, n)
+		}
+		return list
+	default:
+		return fmt.Sprint(v)
+	}
+	return nil
+}
+
+// flag reports whether the named flag is set, ignoring case.
+func flag(node parser.Node, name string) any {
+	for _, n := range flagNames(node) {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return nil
+}
+
+func flagNames(node parser.Node) []string {
+	names := node.Flags().String()
+	if names == "0" {
+		return nil
+	}
+	return strings.Split(names, "|")
+}
@@ -0,0 +1,408 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+type combinator byte
+
+const (
+	descendant combinator = ' '
+	child      combinator = '>'
+	adjacent   combinator = '+'
+	sibling    combinator = '~'
+)
+
+// complexSelector is a list of compound selectors joined by combinators,
+// from left to right: combinators[i] joins compounds[i] and compounds[i+1].
+type complexSelector struct {
+	compounds   []*compound
+	combinators []combinator
+}
+
+type compound struct {
+	// nodeType is the required node type, or 0 for any.
+	nodeType parser.NodeType
+	// word is the name the compound was written with when it is a single
+	// word, which values compare as text.
+	word string
+	// scope matches the node a relative selector within :has is relative
+	// to.
+	scope      bool
+	attributes []*attribute
+	pseudos    []*pseudo
+	capture    string
+}
+
+type attribute struct {
+	path  []string
+	op    string
+	value *value
+}
+
+type value struct {
+	text     string
+	regexp   *regexp.Regexp
+	selector *compound
+}
+
+type pseudo struct {
+	name      string
+	selectors []*complexSelector
+	n         int
+	field     string
+}
+
+type compiler struct {
+	pattern string
+	pos     int
+}
+
+func (c *compiler) errorf(format string, args ...any) error {
+	return &SyntaxError{Pattern: c.pattern, Offset: c.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (c *compiler) eof() bool {
+	return c.pos >= len(c.pattern)
+}
+
+func (c *compiler) peek() byte {
+	if c.eof() {
+		return 0
+	}
+	return c.pattern[c.pos]
+}
+
+func (c *compiler) skipSpace() bool {
+	start := c.pos
+	for !c.eof() && strings.IndexByte(" \t\r\n", c.peek()) >= 0 {
+		c.pos++
+	}
+	return c.pos > start
+}
+
+func (c *compiler) expect(b byte) error {
+	c.skipSpace()
+	if c.peek() != b {
+		if c.eof() {
+			return c.errorf("expected %q, found the end", b)
+		}
+		return c.errorf("expected %q, found %q", b, c.peek())
+	}
+	c.pos++
+	return nil
+}
+
+// selectorList compiles the whole pattern.
+func (c *compiler) selectorList() ([]*complexSelector, error) {
+	selectors, err := c.list(false)
+	if err != nil {
+		return nil, err
+	}
+	if !c.eof() {
+		return nil, c.errorf("unexpected %q", c.peek())
+	}
+	return selectors, nil
+}
+
+// list compiles selectors separated by commas, up to the end or a closing
+// parenthesis.
+func (c *compiler) list(relative bool) ([]*complexSelector, error) {
+	var selectors []*complexSelector
+	for {
+		selector, err := c.complex(relative)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		c.skipSpace()
+		if c.peek() != ',' {
+			return selectors, nil
+		}
+		c.pos++
+	}
+}
+
+func (c *compiler) complex(relative bool) (*complexSelector, error) {
+	c.skipSpace()
+	selector := &complexSelector{}
+	if relative {
+		// :has(> A) relates A to the node :has applies to.
+		selector.compounds = append(selector.compounds, &compound{scope: true})
+		selector.combinators = append(selector.combinators, descendant)
+		if b := c.peek(); b == '>' || b == '+' || b == '~' {
+			selector.combinators[0] = combinator(b)
+			c.pos++
+			c.skipSpace()
+		}
+	}
+	for {
+		compound, err := c.compound(false)
+		if err != nil {
+			return nil, err
+		}
+		selector.compounds = append(selector.compounds, compound)
+		space := c.skipSpace()
+		switch b := c.peek(); {
+		case b == '>' || b == '+' || b == '~':
+			selector.combinators = append(selector.combinators, combinator(b))
+			c.pos++
+			c.skipSpace()
+		case space && !c.eof() && b != ',' && b != ')':
+			selector.combinators = append(selector.combinators, descendant)
+		default:
+			return selector, nil
+		}
+	}
+}
+
+// compound compiles a compound selector. Within an attribute value, a
+// single word may name no node type, as it is also compared as text.
+func (c *compiler) compound(inValue bool) (*compound, error) {
+	start := c.pos
+	result := &compound{}
+	switch {
+	case c.peek() == '*':
+		c.pos++
+	case isIdentStart(c.peek()):
+		name := c.word()
+		nodeType, ok := lookupType(name)
+		if !ok && !inValue {
+			c.pos = start
+			return nil, c.errorf("unknown node type %q", name)
+		}
+		result.nodeType, result.word = nodeType, name
+	}
+	for !c.eof() {
+		var err error
+		switch c.peek() {
+		case '[':
+			var a *attribute
+			a, err = c.attribute()
+			result.attributes = append(result.attributes, a)
+		case ':':
+			var p *pseudo
+			p, err = c.pseudo()
+			result.pseudos = append(result.pseudos, p)
+		case '@':
+			c.pos++
+			if result.capture = c.ident(); result.capture == "" {
+				return nil, c.errorf("expected a capture name")
+			}
+			return c.finish(result, start)
+		default:
+			return c.finish(result, start)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.finish(result, start)
+}
+
+func (c *compiler) finish(r *compound, start int) (*compound, error) {
+	if c.pos == start {
+		if c.eof() {
+			return nil, c.errorf("expected a selector, found the end")
+		}
+		return nil, c.errorf("expected a selector, found %q", c.peek())
+	}
+	if r.word != "" && r.nodeType == 0 && (len(r.attributes) > 0 || len(r.pseudos) > 0 || r.capture != "") {
+		c.pos = start
+		return nil, c.errorf("unknown node type %q", r.word)
+	}
+	if len(r.attributes) > 0 || len(r.pseudos) > 0 || r.capture != "" {
+		// Only single words compare as text.
+		r.word = ""
+	}
+	return r, nil
+}
+
+func lookupType(name string) (parser.NodeType, bool) {
+	if nodeType, ok := parser.LookupNodeType(name); ok {
+		return nodeType, true
+	}
+	return parser.LookupNodeType(name + "Node")
+}
+
+func (c *compiler) attribute() (*attribute, error) {
+	c.pos++
+	c.skipSpace()
+	result := &attribute{}
+	for {
+		start := c.pos
+		if c.peek() == '-' {
+			c.pos++
+		}
+		for !c.eof() && isIdentChar(c.peek()) {
+			c.pos++
+		}
+		if c.pos == start {
+			return nil, c.errorf("expected a field name")
+		}
+		result.path = append(result.path, c.pattern[start:c.pos])
+		if c.peek() != '.' {
+			break
+		}
+		c.pos++
+	}
+	c.skipSpace()
+	if c.peek() == ']' {
+		c.pos++
+		return result, nil
+	}
+	for _, op := range []string{"=", "!=", "^=", "$=", "*="} {
+		if strings.HasPrefix(c.pattern[c.pos:], op) {
+			result.op = op
+		}
+	}
+	if result.op == "" {
+		return nil, c.errorf("expected an operator or ']'")
+	}
+	c.pos += len(result.op)
+	c.skipSpace()
+	var err error
+	if result.value, err = c.value(); err != nil {
+		return nil, err
+	}
+	if result.op != "=" && result.op != "!=" && (result.value.regexp != nil || result.value.selector != nil && result.value.selector.word == "") {
+		return nil, c.errorf("%s compares text only", result.op)
+	}
+	return result, c.expect(']')
+}
+
+func (c *compiler) value() (*value, error) {
+	switch b := c.peek(); {
+	case b == '"' || b == '\'':
+		text, err := c.quoted(b)
+		return &value{text: text}, err
+	case b == '/':
+		start := c.pos
+		source, err := c.quoted('/')
+		if err != nil {
+			return nil, err
+		}
+		re, err := regexp.Compile(source)
+		if err != nil {
+			c.pos = start
+			return nil, c.errorf("invalid regular expression: %v", err)
+		}
+		return &value{regexp: re}, nil
+	case b == '-' || b >= '0' && b <= '9':
+		start := c.pos
+		c.pos++
+		for !c.eof() && (c.peek() >= '0' && c.peek() <= '9' || c.peek() == '.') {
+			c.pos++
+		}
+		return &value{text: c.pattern[start:c.pos]}, nil
+	case isIdentStart(b) || b == '*':
+		selector, err := c.compound(true)
+		if err != nil {
+			return nil, err
+		}
+		return &value{text: selector.word, selector: selector}, nil
+	}
+	return nil, c.errorf("expected a value")
+}
+
+// quoted reads text up to the closing delimiter. A backslash escapes the
+// delimiter and itself; before any other character it is kept, so that
+// regular expressions keep their escapes.
+func (c *compiler) quoted(delimiter byte) (string, error) {
+	c.pos++
+	var text strings.Builder
+	for !c.eof() {
+		b := c.peek()
+		c.pos++
+		switch {
+		case b == delimiter:
+			return text.String(), nil
+		case b == '\\' && !c.eof() && (c.peek() == delimiter || c.peek() == '\\'):
+			text.WriteByte(c.peek())
+			c.pos++
+		default:
+			text.WriteByte(b)
+		}
+	}
+	return "", c.errorf("unterminated %c", delimiter)
+}
+
+func (c *compiler) pseudo() (*pseudo, error) {
+	c.pos++
+	start := c.pos
+	for !c.eof() && (isIdentChar(c.peek()) || c.peek() == '-') {
+		c.pos++
+	}
+	result := &pseudo{name: c.pattern[start:c.pos]}
+	var err error
+	switch result.name {
+	case "has", "is", "not":
+		if err = c.expect('('); err != nil {
+			return nil, err
+		}
+		if result.selectors, err = c.list(result.name == "has"); err != nil {
+			return nil, err
+		}
+		err = c.expect(')')
+	case "nth-child":
+		if err = c.expect('('); err != nil {
+			return nil, err
+		}
+		c.skipSpace()
+		if _, err = fmt.Sscanf(c.pattern[c.pos:], "%d", &result.n); err != nil || result.n < 1 {
+			return nil, c.errorf("expected a position from 1")
+		}
+		for !c.eof() && c.peek() >= '0' && c.peek() <= '9' {
+			c.pos++
+		}
+		err = c.expect(')')
+	case "field":
+		if err = c.expect('('); err != nil {
+			return nil, err
+		}
+		c.skipSpace()
+		if result.field = c.ident(); result.field == "" {
+			return nil, c.errorf("expected a field name")
+		}
+		err = c.expect(')')
+	case "root", "first-child", "last-child", "empty":
+	default:
+		c.pos = start
+		return nil, c.errorf("unknown pseudo-class %q", result.name)
+	}
+	return result, err
+}
+
+func (c *compiler) ident() string {
+	start := c.pos
+	if !isIdentStart(c.peek()) {
+		return ""
+	}
+	for !c.eof() && isIdentChar(c.peek()) {
+		c.pos++
+	}
+	return c.pattern[start:c.pos]
+}
+
+// word reads an identifier, along with the ?, ! or = that can end a method
+// name.
+func (c *compiler) word() string {
+	start := c.pos
+	c.ident()
+	if b := c.peek(); b == '?' || b == '!' || b == '=' && c.pos+1 < len(c.pattern) && c.pattern[c.pos+1] == ']' {
+		c.pos++
+	}
+	return c.pattern[start:c.pos]
+}
+
+func isIdentStart(b byte) bool {
+	return b == '_' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= 0x80
+}
+
+func isIdentChar(b byte) bool {
+	return isIdentStart(b) || b >= '0' && b <= '9'
+}
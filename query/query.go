@@ -0,0 +1,159 @@
+// Package query finds nodes with CSS-like selectors over the AST, in the
+// spirit of RuboCop's NodePattern and tree-sitter queries. A selector is
+// compiled once and can run over any node:
+//
+//	q := query.MustCompile(`ClassNode[superclass.name=ApplicationRecord] CallNode[name=validates][arguments.arguments.0=SymbolNode]@call`)
+//	for _, match := range q.All(result.Value) {
+//		fmt.Println(match.Captures["call"].GetLocation())
+//	}
+//
+// A compound selector matches a single node. It starts with a node type,
+// such as CallNode or Call, or * for any node, and continues with any
+// number of the following:
+//
+//	[path]           the value at path is set: a node, a non-empty list, a
+//	                 set flag or a present location
+//	[path=value]     the value at path equals value
+//	[path!=value]    the value at path does not equal value
+//	[path^=text]     the value at path starts with text
+//	[path$=text]     the value at path ends with text
+//	[path*=text]     the value at path contains text
+//	:has(selector)   a descendant matches; a leading >, + or ~ relates the
+//	                 match to the node instead
+//	:is(selectors)   one of the selectors matches the node
+//	:not(selectors)  none of the selectors matches the node
+//	:root            the node the query runs over
+//	:first-child, :last-child, :nth-child(n)
+//	                 the position of the node among its parent's children
+//	:field(name)     the node is held in the named field of its parent
+//	:empty           the node has no children
+//	@name            captures the node under name
+//
+// A path is a dot-separated list of field names, as in the prism config,
+// and of list indexes, negative ones counting from the end. A flag name
+// such as SAFE_NAVIGATION, in any case, is also a field holding whether
+// the flag is set. A value is a quoted string, a /regular expression/, a
+// number, a word such as a method name, or a compound selector, which
+// matches a node value. When the path holds a list, the predicate holds if
+// it holds for any element.
+//
+// Compound selectors are combined like in CSS: A B matches a B with an
+// ancestor A, A > B a B whose parent is A, A + B a B right after a sibling
+// A, and A ~ B a B after a sibling A. Children are the nodes held in the
+// node fields, in declaration order. Several selectors separated by commas
+// match any of them.
+package query
+
+import (
+	"fmt"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Query is a compiled selector.
+type Query struct {
+	pattern   string
+	selectors []*complexSelector
+}
+
+// Match is a node matched by a query.
+type Match struct {
+	Node parser.Node
+	// Captures holds the nodes captured with @name. When a name is captured
+	// several times, the last capture wins.
+	Captures map[string]parser.Node
+}
+
+// SyntaxError is returned when a selector cannot be compiled.
+type SyntaxError struct {
+	Pattern string
+	// Offset is the byte offset of the error in Pattern.
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("query: %s at offset %d in %q", e.Message, e.Offset, e.Pattern)
+}
+
+// Compile compiles a selector.
+func Compile(pattern string) (*Query, error) {
+	p := &compiler{pattern: pattern}
+	selectors, err := p.selectorList()
+	if err != nil {
+		return nil, err
+	}
+	return &Query{pattern: pattern, selectors: selectors}, nil
+}
+
+// MustCompile is like Compile but panics if the selector cannot be
+// compiled.
+func MustCompile(pattern string) *Query {
+	q, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// String returns the selector the query was compiled from.
+func (q *Query) String() string {
+	return q.pattern
+}
+
+// Each calls yield for each node of the tree rooted at root that matches
+// the query, in pre-order, until yield returns false.
+func (q *Query) Each(root parser.Node, yield func(Match) bool) {
+	if root == nil {
+		return
+	}
+	t := newTree(root)
+	for _, e := range t.elements {
+		m := &matcher{tree: t}
+		if q.matchElement(m, e) && !yield(Match{Node: e.node, Captures: m.result()}) {
+			return
+		}
+	}
+}
+
+// All returns the nodes of the tree rooted at root that match the query,
+// in pre-order.
+func (q *Query) All(root parser.Node) []Match {
+	var matches []Match
+	q.Each(root, func(match Match) bool {
+		matches = append(matches, match)
+		return true
+	})
+	return matches
+}
+
+// First returns the first node of the tree rooted at root that matches the
+// query, in pre-order.
+func (q *Query) First(root parser.Node) (Match, bool) {
+	var first Match
+	found := false
+	q.Each(root, func(match Match) bool {
+		first, found = match, true
+		return false
+	})
+	return first, found
+}
+
+// Matches reports whether node matches the query, as the root of its own
+// tree.
+func (q *Query) Matches(node parser.Node) bool {
+	if node == nil {
+		return false
+	}
+	t := newTree(node)
+	return q.matchElement(&matcher{tree: t}, t.elements[0])
+}
+
+func (q *Query) matchElement(m *matcher, e *element) bool {
+	for _, selector := range q.selectors {
+		if m.complex(selector, e, nil) {
+			return true
+		}
+	}
+	return false
+}
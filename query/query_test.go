@@ -0,0 +1,154 @@
+package query_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/query"
+)
+
+const source = `class User < ApplicationRecord
+  validates :name, presence: true
+  validates :email
+
+  def admin?
+    role == "admin" && active
+  end
+
+  def self.find_by_email(email)
+    where(email: email)&.first
+  end
+end
+`
+
+// slices returns the source of each node.
+func slices(t *testing.T, result *parser.ParseResult, matches []query.Match) []string {
+	t.Helper()
+	texts := make([]string, len(matches))
+	for index, match := range matches {
+		texts[index] = string(result.Source.Slice(match.Node.GetLocation()))
+	}
+	return texts
+}
+
+func TestAll(t *testing.T) {
+	result := parsetest.Parse(t, source)
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{`CallNode[name=validates]`, []string{"validates :name, presence: true", "validates :email"}},
+		{`Call[name=validates][arguments.arguments.1]`, []string{"validates :name, presence: true"}},
+		{`Call[arguments.arguments.-1=KeywordHashNode]`, []string{"validates :name, presence: true", "where(email: email)"}},
+		{`ClassNode[superclass.name=ApplicationRecord] SymbolNode`, []string{":name", "presence:", ":email", "email:"}},
+		{`DefNode[name^=find] > *`, []string{"self", "email", "where(email: email)&.first"}},
+		{`DefNode[name$="?"]`, []string{"def admin?\n    role == \"admin\" && active\n  end"}},
+		{`Def[name*=by]:has(CallNode[SAFE_NAVIGATION])`, []string{"def self.find_by_email(email)\n    where(email: email)&.first\n  end"}},
+		{`CallNode[safe_navigation]`, []string{"where(email: email)&.first"}},
+		{`CallNode[name=/^(where|first)$/]`, []string{"where(email: email)&.first", "where(email: email)"}},
+		{`StringNode[unescaped="admin"]`, []string{`"admin"`}},
+		{`CallNode[name!=validates][receiver=CallNode]`, []string{"role == \"admin\"", "where(email: email)&.first"}},
+		{`CallNode[name=validates] + CallNode`, []string{"validates :email"}},
+		{`CallNode[name=validates] ~ DefNode`, []string{"def admin?\n    role == \"admin\" && active\n  end", "def self.find_by_email(email)\n    where(email: email)&.first\n  end"}},
+		{`DefNode:not(:has(AndNode))`, []string{"def self.find_by_email(email)\n    where(email: email)&.first\n  end"}},
+		{`:is(SymbolNode, StringNode):first-child`, []string{":name", "presence:", ":email", `"admin"`, "email:"}},
+		{`ArgumentsNode > *:last-child`, []string{"presence: true", ":email", `"admin"`, "email: email"}},
+		{`ArgumentsNode > :nth-child(2)`, []string{"presence: true"}},
+		{`*:field(superclass)`, []string{"ApplicationRecord"}},
+		{`ConstantReadNode:empty`, []string{"User", "ApplicationRecord"}},
+		{`ProgramNode:root`, []string{source[:len(source)-1]}},
+		{`AndNode, ParametersNode`, []string{"role == \"admin\" && active", "email"}},
+		{`IntegerNode`, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			got := slices(t, result, query.MustCompile(test.pattern).All(result.Value))
+			if strings.Join(got, "|") != strings.Join(test.want, "|") {
+				t.Errorf("All() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCaptures(t *testing.T) {
+	result := parsetest.Parse(t, source)
+	q := query.MustCompile(`ClassNode@class CallNode[name=validates]@call > ArgumentsNode > SymbolNode:first-child@name`)
+	matches := q.All(result.Value)
+	if len(matches) != 2 {
+		t.Fatalf("%d matches, want 2", len(matches))
+	}
+	for index, want := range []string{":name", ":email"} {
+		captures := matches[index].Captures
+		if got := string(result.Source.Slice(captures["name"].GetLocation())); got != want {
+			t.Errorf("match %d captured @name %q, want %q", index, got, want)
+		}
+		if captures["name"] != matches[index].Node {
+			t.Errorf("match %d captured @name %v, want the matched node", index, captures["name"])
+		}
+		if call, ok := captures["call"].(*parser.CallNode); !ok || call.Name != "validates" {
+			t.Errorf("match %d captured @call %v, want the validates call", index, captures["call"])
+		}
+		if _, ok := captures["class"].(*parser.ClassNode); !ok {
+			t.Errorf("match %d captured @class %T, want *parser.ClassNode", index, captures["class"])
+		}
+	}
+}
+
+func TestFirstAndMatches(t *testing.T) {
+	result := parsetest.Parse(t, source)
+	q := query.MustCompile(`DefNode`)
+	first, ok := q.First(result.Value)
+	if !ok || first.Node.(*parser.DefNode).Name != "admin?" {
+		t.Errorf("First() = %v, %t, want def admin?", first.Node, ok)
+	}
+	if _, ok := query.MustCompile(`WhileNode`).First(result.Value); ok {
+		t.Error("First() found a WhileNode")
+	}
+	if !q.Matches(first.Node) {
+		t.Error("Matches(def admin?) = false")
+	}
+	if query.MustCompile(`ClassNode DefNode`).Matches(first.Node) {
+		t.Error("Matches() looked above the node")
+	}
+	if q.Matches(nil) {
+		t.Error("Matches(nil) = true")
+	}
+
+	count := 0
+	q.Each(result.Value, func(query.Match) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("Each() went on after yield returned false: %d calls", count)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`UnknownNode`,
+		`CallNode[name`,
+		`CallNode[name=]`,
+		`CallNode:unknown`,
+		`CallNode:has(`,
+		`CallNode >`,
+		`CallNode[name=/(/]`,
+		`CallNode@`,
+	}
+	for _, pattern := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			_, err := query.Compile(pattern)
+			var syntaxError *query.SyntaxError
+			if !errors.As(err, &syntaxError) {
+				t.Fatalf("Compile() error = %v, want a *SyntaxError", err)
+			}
+			if syntaxError.Pattern != pattern || syntaxError.Offset < 0 || syntaxError.Offset > len(pattern) {
+				t.Errorf("SyntaxError = %+v", syntaxError)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package requires
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+type config struct {
+	loadPath []string
+}
+
+// Option configures Build.
+type Option func(*config)
+
+// WithLoadPath sets the directories require looks features up in, relative
+// to the working directory like the file names given to Build. It
+// defaults to lib.
+func WithLoadPath(directories ...string) Option {
+	return func(c *config) {
+		c.loadPath = directories
+	}
+}
+
+// Graph is the dependency graph of a set of files.
+type Graph struct {
+	// Files are the files of the graph, sorted.
+	Files []string
+	// Dependencies are the dependencies of the files, in the order of
+	// Files and then in source order.
+	Dependencies []*Dependency
+	files        map[string]bool
+}
+
+// Build extracts the dependencies of parsed files, by file name, and
+// resolves them against the files. File names are slash-separated paths
+// relative to the working directory.
+func Build(results map[string]*parser.ParseResult, options ...Option) *Graph {
+	c := &config{loadPath: []string{"lib"}}
+	for _, option := range options {
+		option(c)
+	}
+	g := &Graph{files: map[string]bool{}}
+	names := map[string]string{}
+	for name := range results {
+		file := clean(name)
+		names[file] = name
+		g.files[file] = true
+		g.Files = append(g.Files, file)
+	}
+	sort.Strings(g.Files)
+	for _, file := range g.Files {
+		for _, d := range Extract(file, results[names[file]]) {
+			d.Target = g.resolve(d, c.loadPath)
+			g.Dependencies = append(g.Dependencies, d)
+		}
+	}
+	return g
+}
+
+func clean(name string) string {
+	return path.Clean(filepath.ToSlash(name))
+}
+
+// resolve returns the file of the graph a dependency loads, or an empty
+// string.
+func (g *Graph) resolve(d *Dependency, loadPath []string) string {
+	if d.Dynamic {
+		return ""
+	}
+	feature := clean(d.Feature)
+	var candidates []string
+	switch {
+	case d.located || path.IsAbs(feature):
+		// A feature worked out from __FILE__ or __dir__, or expanded by
+		// File.expand_path, is already a path from the working directory,
+		// even for require_relative.
+		candidates = []string{feature}
+	case d.Kind == RequireRelative:
+		candidates = []string{path.Join(path.Dir(d.File), feature)}
+	case strings.HasPrefix(d.Feature, "./") || strings.HasPrefix(d.Feature, "../"):
+		candidates = []string{feature}
+	default:
+		if d.Kind == Load {
+			candidates = append(candidates, feature)
+		}
+		for _, directory := range loadPath {
+			candidates = append(candidates, path.Join(clean(directory), feature))
+		}
+	}
+	for _, candidate := range candidates {
+		if g.files[candidate] {
+			return candidate
+		}
+		// load needs the extension, require adds it.
+		if d.Kind != Load && g.files[candidate+".rb"] {
+			return candidate + ".rb"
+		}
+	}
+	return ""
+}
+
+// DependenciesOf returns the dependencies of a file.
+func (g *Graph) DependenciesOf(file string) []*Dependency {
+	var dependencies []*Dependency
+	for _, d := range g.Dependencies {
+		if d.File == file {
+			dependencies = append(dependencies, d)
+		}
+	}
+	return dependencies
+}
+
+// Cycles returns the groups of files that load each other, directly or
+// through other files, including a file that loads itself. Each group is
+// sorted, and the groups are sorted by their first file.
+func (g *Graph) Cycles() [][]string {
+	edges := map[string][]string{}
+	for _, d := range g.Dependencies {
+		if d.Target != "" {
+			edges[d.File] = append(edges[d.File], d.Target)
+		}
+	}
+	// Tarjan's strongly connected components.
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var cycles [][]string
+	var connect func(file string)
+	connect = func(file string) {
+		index[file] = len(index)
+		low[file] = index[file]
+		stack = append(stack, file)
+		onStack[file] = true
+		for _, target := range edges[file] {
+			if _, ok := index[target]; !ok {
+				connect(target)
+				low[file] = min(low[file], low[target])
+			} else if onStack[target] {
+				low[file] = min(low[file], index[target])
+			}
+		}
+		if low[file] != index[file] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == file {
+				break
+			}
+		}
+		if len(component) > 1 || loadsItself(edges, file) {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+	for _, file := range g.Files {
+		if _, ok := index[file]; !ok {
+			connect(file)
+		}
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
+
+func loadsItself(edges map[string][]string, file string) bool {
+	for _, target := range edges[file] {
+		if target == file {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON encodes the graph as JSON: its files, its dependencies and
+// its cycles.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	dependencies := g.Dependencies
+	if dependencies == nil {
+		dependencies = []*Dependency{}
+	}
+	cycles := g.Cycles()
+	if cycles == nil {
+		cycles = [][]string{}
+	}
+	return json.Marshal(struct {
+		Files        []string      `json:"files"`
+		Dependencies []*Dependency `json:"dependencies"`
+		Cycles       [][]string    `json:"cycles"`
+	}{g.Files, dependencies, cycles})
+}
+
+// DOT returns the graph in the Graphviz DOT language. Files are boxes, and
+// features outside of the graph dashed ellipses. Edges are labeled with
+// the kind of dependency unless it is require, and edges within a cycle
+// are red. Dynamic dependencies are left out.
+func (g *Graph) DOT() string {
+	inCycle := map[string]int{}
+	for index, cycle := range g.Cycles() {
+		for _, file := range cycle {
+			inCycle[file] = index + 1
+		}
+	}
+	var b strings.Builder
+	b.WriteString("digraph requires {\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, file := range g.Files {
+		fmt.Fprintf(&b, "  %q;\n", file)
+	}
+	external := map[string]bool{}
+	for _, d := range g.Dependencies {
+		if d.Target == "" && !d.Dynamic && !external[d.Feature] {
+			external[d.Feature] = true
+			fmt.Fprintf(&b, "  %q [shape=ellipse, style=dashed];\n", d.Feature)
+		}
+	}
+	seen := map[string]bool{}
+	for _, d := range g.Dependencies {
+		if d.Dynamic {
+			continue
+		}
+		target := d.Target
+		var attributes []string
+		if target == "" {
+			target = d.Feature
+			attributes = append(attributes, "style=dashed")
+		} else if inCycle[d.File] != 0 && inCycle[d.File] == inCycle[target] {
+			attributes = append(attributes, "color=red")
+		}
+		if d.Kind != Require {
+			attributes = append(attributes, fmt.Sprintf("label=%q", d.Kind))
+		}
+		edge := fmt.Sprintf("%q -> %q", d.File, target)
+		if len(attributes) > 0 {
+			edge += " [" + strings.Join(attributes, ", ") + "]"
+		}
+		if !seen[edge] {
+			seen[edge] = true
+			fmt.Fprintf(&b, "  %s;\n", edge)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
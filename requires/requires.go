@@ -0,0 +1,261 @@
+// Package requires extracts the files a Ruby file loads, with require,
+// require_relative, load and autoload, and builds the dependency graph of
+// a set of files.
+//
+// Arguments are worked out statically from string literals and
+// interpolations, __FILE__, __dir__, and the File.expand_path, File.join
+// and File.dirname calls made of them. Other arguments are reported as
+// dynamic.
+//
+//	graph := requires.Build(results, requires.WithLoadPath("lib", "app/models"))
+//	for _, cycle := range graph.Cycles() {
+//		fmt.Println("cycle:", strings.Join(cycle, " -> "))
+//	}
+//	os.WriteFile("requires.dot", []byte(graph.DOT()), 0o644)
+package requires
+
+import (
+	"path"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the way a file is loaded.
+type Kind int
+
+const (
+	// Require is require "feature", looked up in the load path.
+	Require Kind = iota
+	// RequireRelative is require_relative "path", relative to the
+	// directory of the requiring file.
+	RequireRelative
+	// Load is load "file.rb", relative to the working directory or looked
+	// up in the load path.
+	Load
+	// Autoload is autoload :Constant, "feature", required when the constant
+	// is first used.
+	Autoload
+)
+
+var kindNames = enum.Names[Kind]{
+	Require:         "require",
+	RequireRelative: "require_relative",
+	Load:            "load",
+	Autoload:        "autoload",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// MarshalText encodes the kind as its name, such as require_relative.
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Dependency is a call that loads a file.
+type Dependency struct {
+	Kind Kind `json:"kind"`
+	// File is the file the call is in, and Line its line.
+	File string `json:"file"`
+	Line int    `json:"line"`
+	// Feature is the argument of the call as worked out statically, such
+	// as "json" or "lib/models/user". It is empty for a dynamic argument.
+	Feature string `json:"feature,omitempty"`
+	// Constant is the constant of an autoload.
+	Constant string `json:"constant,omitempty"`
+	// Dynamic reports whether the argument cannot be worked out
+	// statically.
+	Dynamic bool `json:"dynamic,omitempty"`
+	// Target is the file of the graph the dependency resolves to, or empty
+	// if it is not one of them, as for the standard library and gems.
+	Target string `json:"target,omitempty"`
+	// Call is the call node.
+	Call *parser.CallNode `json:"-"`
+	// located is set when the feature is worked out from the location of
+	// the file or with File.expand_path, and so is a path from the working
+	// directory.
+	located bool
+}
+
+// Extract returns the dependencies of a file, in source order. The file
+// name is used to work out __FILE__, __dir__ and relative requires.
+func Extract(file string, result *parser.ParseResult) []*Dependency {
+	var dependencies []*Dependency
+	parser.Walk(result.Value, func(node parser.Node) bool {
+		if call, ok := node.(*parser.CallNode); ok {
+			if d := dependency(file, call); d != nil {
+				if result.Source != nil {
+					d.Line, _ = result.Source.Line(call.Location.StartOffset)
+				}
+				dependencies = append(dependencies, d)
+			}
+		}
+		return true
+	})
+	return dependencies
+}
+
+// dependency returns the dependency of a call, or nil if the call loads no
+// file.
+func dependency(file string, call *parser.CallNode) *Dependency {
+	var arguments []parser.Node
+	if call.Arguments != nil {
+		arguments = call.Arguments.Arguments
+	}
+	d := &Dependency{File: file, Call: call}
+	switch call.Name {
+	case "require", "require_relative":
+		if !kernelReceiver(call.Receiver) || len(arguments) != 1 {
+			return nil
+		}
+		d.Kind = Require
+		if call.Name == "require_relative" {
+			d.Kind = RequireRelative
+		}
+	case "load":
+		// The second argument wraps the file in an anonymous module.
+		if !kernelReceiver(call.Receiver) || len(arguments) == 0 || len(arguments) > 2 {
+			return nil
+		}
+		d.Kind = Load
+	case "autoload":
+		// Module#autoload is also called on constants, as in
+		// Foo.autoload(:Bar, "foo/bar").
+		if len(arguments) != 2 {
+			return nil
+		}
+		switch call.Receiver.(type) {
+		case nil, *parser.SelfNode, *parser.ConstantReadNode, *parser.ConstantPathNode:
+		default:
+			return nil
+		}
+		d.Kind = Autoload
+		switch constant := arguments[0].(type) {
+		case *parser.SymbolNode:
+			d.Constant = constant.Unescaped.Value
+		case *parser.StringNode:
+			d.Constant = constant.Unescaped.Value
+		}
+		arguments = arguments[1:]
+	default:
+		return nil
+	}
+	e := &evaluator{file: file}
+	if feature, ok := e.evaluate(arguments[0]); ok {
+		d.Feature, d.located = feature, e.located
+		if e.located {
+			d.Feature = path.Clean(feature)
+		}
+	} else {
+		d.Dynamic = true
+	}
+	return d
+}
+
+// kernelReceiver reports whether a receiver calls a Kernel method: none,
+// self or Kernel.
+func kernelReceiver(receiver parser.Node) bool {
+	switch r := receiver.(type) {
+	case nil, *parser.SelfNode:
+		return true
+	case *parser.ConstantReadNode:
+		return r.Name == "Kernel"
+	}
+	return false
+}
+
+type evaluator struct {
+	file string
+	// located is set when a value is worked out from __FILE__ or __dir__,
+	// or expanded by File.expand_path.
+	located bool
+}
+
+// evaluate works out the string value of an argument statically.
+func (e *evaluator) evaluate(node parser.Node) (string, bool) {
+	switch n := node.(type) {
+	case *parser.StringNode:
+		return n.Unescaped.Value, true
+	case *parser.SourceFileNode:
+		e.located = true
+		return e.file, true
+	case *parser.InterpolatedStringNode:
+		value := ""
+		for _, part := range n.Parts {
+			switch part := part.(type) {
+			case *parser.StringNode:
+				value += part.Unescaped.Value
+			case *parser.EmbeddedStatementsNode:
+				if part.Statements == nil || len(part.Statements.Body) != 1 {
+					return "", false
+				}
+				s, ok := e.evaluate(part.Statements.Body[0])
+				if !ok {
+					return "", false
+				}
+				value += s
+			default:
+				return "", false
+			}
+		}
+		return value, true
+	case *parser.ParenthesesNode:
+		if statements, ok := n.Body.(*parser.StatementsNode); ok && len(statements.Body) == 1 {
+			return e.evaluate(statements.Body[0])
+		}
+	case *parser.CallNode:
+		return e.call(n)
+	}
+	return "", false
+}
+
+func (e *evaluator) call(call *parser.CallNode) (string, bool) {
+	var arguments []string
+	if call.Arguments != nil {
+		for _, argument := range call.Arguments.Arguments {
+			s, ok := e.evaluate(argument)
+			if !ok {
+				return "", false
+			}
+			arguments = append(arguments, s)
+		}
+	}
+	if call.Receiver == nil {
+		if call.Name == "__dir__" && len(arguments) == 0 {
+			e.located = true
+			return path.Dir(e.file), true
+		}
+		return "", false
+	}
+	switch receiver := call.Receiver.(type) {
+	case *parser.ConstantReadNode:
+		if receiver.Name != "File" {
+			return "", false
+		}
+	case *parser.ConstantPathNode:
+		if receiver.Parent != nil || receiver.Name == nil || *receiver.Name != "File" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	switch {
+	case call.Name == "expand_path" && len(arguments) == 1:
+		// Expanded against the working directory, not the load path.
+		e.located = true
+		return path.Clean(arguments[0]), true
+	case call.Name == "expand_path" && len(arguments) == 2:
+		e.located = true
+		if path.IsAbs(arguments[0]) {
+			return path.Clean(arguments[0]), true
+		}
+		return path.Join(arguments[1], arguments[0]), true
+	case call.Name == "join" && len(arguments) > 0:
+		return path.Join(arguments...), true
+	case call.Name == "dirname" && len(arguments) == 1:
+		return path.Dir(arguments[0]), true
+	}
+	return "", false
+}
@@ -0,0 +1,180 @@
+package requires_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/requires"
+)
+
+// describe lists dependencies as kind feature -> target, with the line
+// they are on.
+func describe(dependencies []*requires.Dependency) string {
+	lines := make([]string, len(dependencies))
+	for index, d := range dependencies {
+		feature := d.Feature
+		if d.Dynamic {
+			feature = "(dynamic)"
+		}
+		if d.Constant != "" {
+			feature = d.Constant + " " + feature
+		}
+		lines[index] = fmt.Sprintf("%d: %s %s", d.Line, d.Kind, feature)
+		if d.Target != "" {
+			lines[index] += " -> " + d.Target
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{`require "json"`, `1: require json`},
+		{"Kernel.require 'set'\nself.load 'x.rb', true", "1: require set\n2: load x.rb"},
+		{`require_relative "../models/user"`, `1: require_relative ../models/user`},
+		{`require "lib/#{"x"}"`, `1: require lib/x`},
+		{`require name`, `1: require (dynamic)`},
+		{`require "a", "b"`, ``},
+		{`foo.require "a"`, ``},
+		{`autoload :User, "app/user"`, `1: autoload User app/user`},
+		{`Foo::Bar.autoload("Baz", "foo/baz")`, `1: autoload Baz foo/baz`},
+		{`foo.autoload :X, "x"`, ``},
+		{`require File.expand_path("../helper", __FILE__)`, `1: require app/helper`},
+		{`require File.expand_path("helper", __dir__)`, `1: require app/helper`},
+		{`require File.expand_path("/abs/x", __dir__)`, `1: require /abs/x`},
+		{`load File.join(File.dirname(__FILE__), "tasks", "x.rake")`, `1: load app/tasks/x.rake`},
+		{`require_relative File.join(__dir__, "x")`, `1: require_relative app/x`},
+		{`require File.expand_path("x")`, `1: require x`},
+		{`require File.read("x")`, `1: require (dynamic)`},
+		{"if ok\n  require (\"a\")\nend", `2: require a`},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			dependencies := requires.Extract("app/helper_spec.rb", parsetest.Parse(t, test.source))
+			if got := describe(dependencies); got != test.want {
+				t.Errorf("Extract() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+// build parses files given as name and source pairs and builds their graph.
+func build(t *testing.T, files []string, options ...requires.Option) *requires.Graph {
+	t.Helper()
+	results := map[string]*parser.ParseResult{}
+	for index := 0; index < len(files); index += 2 {
+		results[files[index]] = parsetest.Parse(t, files[index+1])
+	}
+	return requires.Build(results, options...)
+}
+
+func TestResolve(t *testing.T) {
+	files := []string{
+		"lib/app.rb", "",
+		"lib/app/util.rb", "",
+		"app/models/user.rb", "",
+		"app/models/admin.rb", "",
+		"tasks/x.rake", "",
+	}
+	tests := []struct {
+		file, source string
+		want         string
+	}{
+		{"bin/run", `require "app"`, "1: require app -> lib/app.rb"},
+		{"bin/run", `require "app/util.rb"`, "1: require app/util.rb -> lib/app/util.rb"},
+		{"bin/run", `require "json"`, "1: require json"},
+		{"bin/run", `require "./lib/app"`, "1: require ./lib/app -> lib/app.rb"},
+		{"lib/app.rb", `require_relative "app/util"`, "1: require_relative app/util -> lib/app/util.rb"},
+		{"app/models/user.rb", `require_relative "../../lib/app"`, "1: require_relative ../../lib/app -> lib/app.rb"},
+		// Features worked out from __dir__ are paths from the working
+		// directory, not from the directory of the file.
+		{"app/models/user.rb", `require_relative File.join(__dir__, "admin")`, "1: require_relative app/models/admin -> app/models/admin.rb"},
+		{"app/models/user.rb", `require_relative File.expand_path("admin", __dir__)`, "1: require_relative app/models/admin -> app/models/admin.rb"},
+		{"app/models/user.rb", `require File.expand_path("../admin", __FILE__)`, "1: require app/models/admin -> app/models/admin.rb"},
+		// File.expand_path expands against the working directory, so its
+		// features are not looked up in the load path.
+		{"bin/run", `require File.expand_path("lib/app")`, "1: require lib/app -> lib/app.rb"},
+		{"bin/run", `require File.expand_path("app")`, "1: require app"},
+		{"app/models/user.rb", `require_relative File.expand_path("lib/app")`, "1: require_relative lib/app -> lib/app.rb"},
+		{"bin/run", `load "tasks/x.rake"`, "1: load tasks/x.rake -> tasks/x.rake"},
+		{"bin/run", `load "tasks/x"`, "1: load tasks/x"},
+		{"bin/run", `autoload :Util, "app/util"`, "1: autoload Util app/util -> lib/app/util.rb"},
+		{"bin/run", `require "admin"`, "1: require admin"},
+	}
+	for _, test := range tests {
+		t.Run(test.file+" "+test.source, func(t *testing.T) {
+			g := build(t, append(files, test.file, test.source))
+			if got := describe(g.DependenciesOf(test.file)); got != test.want {
+				t.Errorf("dependencies =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+
+	g := build(t, append(files, "bin/run", `require "admin"`), requires.WithLoadPath("lib", "app/models"))
+	if got := describe(g.DependenciesOf("bin/run")); got != "1: require admin -> app/models/admin.rb" {
+		t.Errorf("with the load path lib and app/models, dependencies = %s", got)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	g := build(t, []string{
+		"lib/a.rb", `require_relative "b"`,
+		"lib/b.rb", `require_relative "c"`,
+		"lib/c.rb", `require "a"`,
+		"lib/d.rb", "require_relative 'd'\nrequire 'a'",
+		"lib/e.rb", `require "json"`,
+	})
+	if got := fmt.Sprint(g.Cycles()); got != "[[lib/a.rb lib/b.rb lib/c.rb] [lib/d.rb]]" {
+		t.Errorf("Cycles() = %s", got)
+	}
+	if got := strings.Join(g.Files, " "); got != "lib/a.rb lib/b.rb lib/c.rb lib/d.rb lib/e.rb" {
+		t.Errorf("Files = %s", got)
+	}
+}
+
+func TestOutputs(t *testing.T) {
+	g := build(t, []string{
+		"lib/a.rb", "require_relative 'b'\nrequire 'json'\nrequire x",
+		"lib/b.rb", `load "lib/a.rb"`,
+	})
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"files":["lib/a.rb","lib/b.rb"],"dependencies":[` +
+		`{"kind":"require_relative","file":"lib/a.rb","line":1,"feature":"b","target":"lib/b.rb"},` +
+		`{"kind":"require","file":"lib/a.rb","line":2,"feature":"json"},` +
+		`{"kind":"require","file":"lib/a.rb","line":3,"dynamic":true},` +
+		`{"kind":"load","file":"lib/b.rb","line":1,"feature":"lib/a.rb","target":"lib/a.rb"}],` +
+		`"cycles":[["lib/a.rb","lib/b.rb"]]}`
+	if string(data) != want {
+		t.Errorf("MarshalJSON() =\n%s\nwant\n%s", data, want)
+	}
+
+	dot := `digraph requires {
+  node [shape=box];
+  "lib/a.rb";
+  "lib/b.rb";
+  "json" [shape=ellipse, style=dashed];
+  "lib/a.rb" -> "lib/b.rb" [color=red, label="require_relative"];
+  "lib/a.rb" -> "json" [style=dashed];
+  "lib/b.rb" -> "lib/a.rb" [color=red, label="load"];
+}
+`
+	if got := g.DOT(); got != dot {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, dot)
+	}
+}
+
+func TestKindString(t *testing.T) {
+	if got := requires.Kind(4).String(); got != "requires.Kind(4)" {
+		t.Errorf("Kind(4).String() = %q", got)
+	}
+}
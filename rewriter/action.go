@@ -0,0 +1,291 @@
+package rewriter
+
+import (
+	"sort"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// action is a node of the edit tree: insertions around a range, an optional
+// replacement of it, and the edits of the disjoint ranges it contains,
+// ordered by position. Actions are immutable, so a rejected edit leaves the
+// tree untouched.
+type action struct {
+	start        int
+	end          int
+	insertBefore string
+	replacement  *string
+	insertAfter  string
+	children     []*action
+}
+
+type replacement struct {
+	start int
+	end   int
+	text  string
+}
+
+func (a *action) location() parser.Location {
+	return parser.Location{StartOffset: a.start, Length: a.end - a.start}
+}
+
+func (a *action) empty() bool {
+	return a.insertBefore == "" && a.insertAfter == "" && len(a.children) == 0 &&
+		(a.replacement == nil || (*a.replacement == "" && a.start == a.end))
+}
+
+// insertion reports whether the action adds content to the source.
+func (a *action) insertion() bool {
+	return a.insertBefore != "" || a.insertAfter != "" || (a.replacement != nil && *a.replacement != "")
+}
+
+func (a *action) orderedReplacements(replacements []replacement) []replacement {
+	if a.insertBefore != "" {
+		replacements = append(replacements, replacement{a.start, a.start, a.insertBefore})
+	}
+	if a.replacement != nil {
+		replacements = append(replacements, replacement{a.start, a.end, *a.replacement})
+	}
+	for _, child := range a.children {
+		replacements = child.orderedReplacements(replacements)
+	}
+	if a.insertAfter != "" {
+		replacements = append(replacements, replacement{a.end, a.end, a.insertAfter})
+	}
+	return replacements
+}
+
+// with returns a copy of the action with the given edits and children. A
+// replacement swallows the children.
+func (a *action) with(r *Rewriter, insertBefore string, replacement *string, insertAfter string, children []*action) (*action, error) {
+	if replacement != nil {
+		if err := a.swallow(r, children); err != nil {
+			return nil, err
+		}
+		children = nil
+	}
+	return &action{
+		start:        a.start,
+		end:          a.end,
+		insertBefore: insertBefore,
+		replacement:  replacement,
+		insertAfter:  insertAfter,
+		children:     children,
+	}, nil
+}
+
+func (a *action) withChildren(r *Rewriter, children []*action) (*action, error) {
+	return a.with(r, a.insertBefore, a.replacement, a.insertAfter, children)
+}
+
+func (a *action) combine(r *Rewriter, other *action) (*action, error) {
+	if other.empty() {
+		return a, nil
+	}
+	return a.doCombine(r, other)
+}
+
+// doCombine assumes a contains the range of other.
+func (a *action) doCombine(r *Rewriter, other *action) (*action, error) {
+	if other.start == a.start && other.end == a.end {
+		return a.merge(r, other)
+	}
+	return a.placeInHierarchy(r, other)
+}
+
+func (a *action) placeInHierarchy(r *Rewriter, other *action) (*action, error) {
+	family, err := a.analyseHierarchy(r, other)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(family.fusible) > 0 {
+		siblings := concat(family.left, family.child, family.right)
+		withoutFusible, err := a.withChildren(r, siblings)
+		if err != nil {
+			return nil, err
+		}
+		fused := *other
+		for _, child := range family.fusible {
+			fused.start = min(fused.start, child.start)
+			fused.end = max(fused.end, child.end)
+		}
+		return withoutFusible.doCombine(r, &fused)
+	}
+
+	var extra *action
+	switch {
+	case family.parent != nil:
+		// other belongs within one of the children,
+		extra, err = family.parent.doCombine(r, other)
+	case family.hasChild:
+		// or it contains some of them,
+		extra, err = other.withChildren(r, family.child)
+		if err == nil {
+			extra, err = extra.combineChildren(r, other.children)
+		}
+	default:
+		// or else it becomes an additional child.
+		extra = other
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a.withChildren(r, concat(family.left, []*action{extra}, family.right))
+}
+
+// combineChildren assumes the children are all contained within a.
+func (a *action) combineChildren(r *Rewriter, children []*action) (*action, error) {
+	parent := a
+	for _, child := range children {
+		var err error
+		if parent, err = parent.placeInHierarchy(r, child); err != nil {
+			return nil, err
+		}
+	}
+	return parent, nil
+}
+
+// family is the hierarchy of the children of an action with respect to
+// another action: the children disjoint from it on the left and right, the
+// child containing it, the children it contains, and the children
+// overlapping it that can be fused into a single deletion.
+type family struct {
+	left     []*action
+	right    []*action
+	parent   *action
+	child    []*action
+	hasChild bool
+	fusible  []*action
+}
+
+// searchChild returns the index of the first child from from on for which
+// found is true, or the number of children.
+func (a *action) searchChild(from int, found func(*action) bool) int {
+	return from + sort.Search(len(a.children)-from, func(index int) bool {
+		return found(a.children[from+index])
+	})
+}
+
+// analyseHierarchy returns the family of other among the children. A child
+// with a range equal to the one of other is its parent; an empty range at
+// the boundary of another range is disjoint from it.
+func (a *action) analyseHierarchy(r *Rewriter, other *action) (family, error) {
+	var f family
+
+	// The first child that isn't completely to the left of other.
+	leftIndex := a.searchChild(0, func(child *action) bool { return child.end > other.start })
+	// The first child that is completely to the right of other.
+	from := 0
+	if leftIndex > 0 {
+		from = leftIndex - 1
+	}
+	rightIndex := a.searchChild(from, func(child *action) bool { return child.start >= other.end })
+
+	switch center := rightIndex - leftIndex; center {
+	case 0:
+		// All children are disjoint from other.
+	case -1:
+		// An empty child with the same range as other appears both left and
+		// right of it; treat it as the parent.
+		leftIndex--
+		rightIndex++
+		f.parent = a.children[leftIndex]
+	default:
+		overlapLeft := compare(a.children[leftIndex].start, other.start)
+		overlapRight := compare(a.children[rightIndex-1].end, other.end)
+		if center == 1 && overlapLeft <= 0 && overlapRight >= 0 {
+			f.parent = a.children[leftIndex]
+			break
+		}
+		contained := append([]*action(nil), a.children[leftIndex:rightIndex]...)
+		var fusible []*action
+		if overlapLeft < 0 {
+			fusible = append(fusible, contained[0])
+			contained = contained[1:]
+		}
+		if overlapRight > 0 && len(contained) > 0 {
+			fusible = append(fusible, contained[len(contained)-1])
+			contained = contained[:len(contained)-1]
+		}
+		if err := a.checkFusible(r, other, fusible); err != nil {
+			return f, err
+		}
+		f.child, f.hasChild, f.fusible = contained, true, fusible
+	}
+
+	f.left = a.children[:leftIndex]
+	f.right = a.children[rightIndex:]
+	return f, nil
+}
+
+func (a *action) checkFusible(r *Rewriter, other *action, fusible []*action) error {
+	for _, child := range fusible {
+		kind := CrossingDeletions
+		if other.insertion() || child.insertion() {
+			kind = CrossingInsertions
+		}
+		err := r.enforce(kind, func() *ClobberingError {
+			return &ClobberingError{Conflict: kind, Location: other.location(), Conflicts: []parser.Location{child.location()}}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// merge assumes other has the same range as a and no children.
+func (a *action) merge(r *Rewriter, other *action) (*action, error) {
+	err := r.enforce(DifferentReplacements, func() *ClobberingError {
+		if a.replacement != nil && other.replacement != nil && *a.replacement != *other.replacement {
+			return &ClobberingError{Conflict: DifferentReplacements, Location: a.location()}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	replacement := a.replacement
+	if other.replacement != nil {
+		replacement = other.replacement
+	}
+	merged, err := a.with(r, other.insertBefore+a.insertBefore, replacement, a.insertAfter+other.insertAfter, a.children)
+	if err != nil {
+		return nil, err
+	}
+	return merged.combineChildren(r, other.children)
+}
+
+func (a *action) swallow(r *Rewriter, children []*action) error {
+	return r.enforce(SwallowedInsertions, func() *ClobberingError {
+		var conflicts []parser.Location
+		for _, child := range children {
+			if child.insertion() {
+				conflicts = append(conflicts, child.location())
+			}
+		}
+		if len(conflicts) == 0 {
+			return nil
+		}
+		return &ClobberingError{Conflict: SwallowedInsertions, Location: a.location(), Conflicts: conflicts}
+	})
+}
+
+func concat(lists ...[]*action) []*action {
+	var result []*action
+	for _, list := range lists {
+		result = append(result, list...)
+	}
+	return result
+}
+
+func compare(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
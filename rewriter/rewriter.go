@@ -0,0 +1,233 @@
+// Package rewriter edits Ruby source through its AST. It follows the
+// semantics of the parser gem's Parser::Source::TreeRewriter, which RuboCop
+// uses for autocorrection: edits are kept in a tree of non-overlapping
+// ranges, and conflicting edits are reported as clobbering errors.
+package rewriter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Ranged is anything with a source range: every parser.Node, as well as
+// parser.Location itself.
+type Ranged interface {
+	GetLocation() parser.Location
+}
+
+// Conflict is the kind of a conflict between two edits.
+type Conflict string
+
+const (
+	// CrossingDeletions is reported when two removals or replacements
+	// overlap without one containing the other.
+	CrossingDeletions Conflict = "crossing_deletions"
+	// DifferentReplacements is reported when a range is replaced twice
+	// with different content.
+	DifferentReplacements Conflict = "different_replacements"
+	// SwallowedInsertions is reported when a replacement swallows
+	// insertions made within its range.
+	SwallowedInsertions Conflict = "swallowed_insertions"
+	// CrossingInsertions is reported when an overlapping edit inserts
+	// content. It is always an error.
+	CrossingInsertions Conflict = "crossing_insertions"
+)
+
+// Policy is how the rewriter handles a kind of conflict.
+type Policy int
+
+const (
+	// PolicyRaise rejects the conflicting edit with a ClobberingError.
+	PolicyRaise Policy = iota
+	// PolicyWarn accepts the edit and records the conflict in Warnings.
+	PolicyWarn
+	// PolicyAccept accepts the edit silently.
+	PolicyAccept
+)
+
+// ClobberingError is returned when an edit conflicts with a previous one.
+type ClobberingError struct {
+	Conflict  Conflict
+	Location  parser.Location
+	Conflicts []parser.Location
+}
+
+func (e *ClobberingError) Error() string {
+	conflicts := make([]string, len(e.Conflicts))
+	for index, conflict := range e.Conflicts {
+		conflicts[index] = formatRange(conflict.StartOffset, conflict.EndOffset())
+	}
+	message := fmt.Sprintf("rewriter detected clobbering: %s at %s", e.Conflict, formatRange(e.Location.StartOffset, e.Location.EndOffset()))
+	if len(conflicts) > 0 {
+		message += " with " + strings.Join(conflicts, ", ")
+	}
+	return message
+}
+
+func formatRange(start, end int) string {
+	return fmt.Sprintf("%d...%d", start, end)
+}
+
+// Rewriter collects edits to a source and applies them with Process.
+type Rewriter struct {
+	source   []byte
+	policies map[Conflict]Policy
+	root     *action
+	warnings []ClobberingError
+}
+
+// Option configures a Rewriter.
+type Option func(*Rewriter)
+
+// WithCrossingDeletions sets the policy for overlapping removals. The
+// default is PolicyAccept, which fuses them into a single removal.
+func WithCrossingDeletions(policy Policy) Option {
+	return func(r *Rewriter) {
+		r.policies[CrossingDeletions] = policy
+	}
+}
+
+// WithDifferentReplacements sets the policy for replacing a range twice with
+// different content. The default is PolicyRaise; otherwise the last
+// replacement wins.
+func WithDifferentReplacements(policy Policy) Option {
+	return func(r *Rewriter) {
+		r.policies[DifferentReplacements] = policy
+	}
+}
+
+// WithSwallowedInsertions sets the policy for replacements that swallow
+// previous insertions. The default is PolicyRaise; otherwise the insertions
+// are dropped.
+func WithSwallowedInsertions(policy Policy) Option {
+	return func(r *Rewriter) {
+		r.policies[SwallowedInsertions] = policy
+	}
+}
+
+// New returns a rewriter for the source of the parse result. It fails if
+// the result has no source, as a result built or cloned without one.
+func New(result *parser.ParseResult, options ...Option) (*Rewriter, error) {
+	if result == nil || result.Source == nil {
+		return nil, errors.New("parse result has no source")
+	}
+	return NewFromSource(result.Source.Bytes, options...), nil
+}
+
+// NewFromSource returns a rewriter for the given source.
+func NewFromSource(source []byte, options ...Option) *Rewriter {
+	r := &Rewriter{
+		source: source,
+		policies: map[Conflict]Policy{
+			CrossingDeletions:     PolicyAccept,
+			DifferentReplacements: PolicyRaise,
+			SwallowedInsertions:   PolicyRaise,
+		},
+	}
+	for _, option := range options {
+		option(r)
+	}
+	r.root = &action{start: 0, end: len(source)}
+	return r
+}
+
+// Replace replaces the source of target with text.
+func (r *Rewriter) Replace(target Ranged, text string) error {
+	return r.combine(target, "", &text, "")
+}
+
+// Remove removes the source of target.
+func (r *Rewriter) Remove(target Ranged) error {
+	return r.Replace(target, "")
+}
+
+// Wrap inserts before and after around the source of target. Wrapping the
+// same range again wraps around the previous insertions.
+func (r *Rewriter) Wrap(target Ranged, before, after string) error {
+	return r.combine(target, before, nil, after)
+}
+
+// InsertBefore inserts text before the source of target, in front of any
+// text already inserted there.
+func (r *Rewriter) InsertBefore(target Ranged, text string) error {
+	return r.Wrap(target, text, "")
+}
+
+// InsertAfter inserts text after the source of target, behind any text
+// already inserted there.
+func (r *Rewriter) InsertAfter(target Ranged, text string) error {
+	return r.Wrap(target, "", text)
+}
+
+// Empty reports whether the rewriter has no edits.
+func (r *Rewriter) Empty() bool {
+	return r.root.empty()
+}
+
+// Warnings returns the conflicts accepted under PolicyWarn.
+func (r *Rewriter) Warnings() []ClobberingError {
+	return r.warnings
+}
+
+// Process returns the source with all edits applied.
+func (r *Rewriter) Process() []byte {
+	var output []byte
+	last := 0
+	for _, replacement := range r.root.orderedReplacements(nil) {
+		if replacement.start > last {
+			output = append(output, r.source[last:replacement.start]...)
+		}
+		output = append(output, replacement.text...)
+		last = replacement.end
+	}
+	if last < len(r.source) {
+		output = append(output, r.source[last:]...)
+	}
+	return output
+}
+
+// combine adds an edit to the action tree. On a conflict the tree is left
+// unchanged.
+func (r *Rewriter) combine(target Ranged, before string, replacement *string, after string) error {
+	location := target.GetLocation()
+	if location.StartOffset < 0 || location.Length < 0 || location.EndOffset() > len(r.source) {
+		return fmt.Errorf("range %s is outside of the source", formatRange(location.StartOffset, location.EndOffset()))
+	}
+	edit := &action{
+		start:        location.StartOffset,
+		end:          location.EndOffset(),
+		insertBefore: before,
+		replacement:  replacement,
+		insertAfter:  after,
+	}
+	root, err := r.root.combine(r, edit)
+	if err != nil {
+		return err
+	}
+	r.root = root
+	return nil
+}
+
+// enforce applies the policy of the conflict to the error built by conflict,
+// if any.
+func (r *Rewriter) enforce(kind Conflict, conflict func() *ClobberingError) error {
+	policy, ok := r.policies[kind]
+	if !ok {
+		policy = PolicyRaise
+	}
+	if policy == PolicyAccept {
+		return nil
+	}
+	err := conflict()
+	if err == nil {
+		return nil
+	}
+	if policy == PolicyWarn {
+		r.warnings = append(r.warnings, *err)
+		return nil
+	}
+	return err
+}
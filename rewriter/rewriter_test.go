@@ -0,0 +1,225 @@
+package rewriter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+const source = "foo(bar, baz) + qux"
+
+// at returns the location of the first occurrence of text in source.
+func at(text string) parser.Location {
+	return parser.Location{StartOffset: strings.Index(source, text), Length: len(text)}
+}
+
+func TestRewriter(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		edits   func(r *Rewriter) error
+		want    string
+		// conflict is the conflict of the last edit, if it is rejected.
+		conflict Conflict
+	}{
+		{
+			name:  "replace",
+			edits: func(r *Rewriter) error { return r.Replace(at("bar"), "x") },
+			want:  "foo(x, baz) + qux",
+		},
+		{
+			name:  "remove",
+			edits: func(r *Rewriter) error { return r.Remove(at(", baz")) },
+			want:  "foo(bar) + qux",
+		},
+		{
+			name: "disjoint edits",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Replace(at("qux"), "1"), r.Replace(at("foo"), "f"), r.Remove(at(", baz")))
+			},
+			want: "f(bar) + 1",
+		},
+		{
+			name: "insertions before go in front",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.InsertBefore(at("bar"), "a"), r.InsertBefore(at("bar"), "b"))
+			},
+			want: "foo(babar, baz) + qux",
+		},
+		{
+			name: "insertions after go behind",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.InsertAfter(at("bar"), "a"), r.InsertAfter(at("bar"), "b"))
+			},
+			want: "foo(barab, baz) + qux",
+		},
+		{
+			name: "wraps nest",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Wrap(at("bar"), "(", ")"), r.Wrap(at("bar"), "[", "]"))
+			},
+			want: "foo([(bar)], baz) + qux",
+		},
+		{
+			name: "replacement keeps insertions around it",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Wrap(at("bar"), "<", ">"), r.Replace(at("bar"), "x"))
+			},
+			want: "foo(<x>, baz) + qux",
+		},
+		{
+			name: "edits inside a wrapped range",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Wrap(at("foo(bar, baz)"), "(", ")"), r.Replace(at("bar"), "x"))
+			},
+			want: "(foo(x, baz)) + qux",
+		},
+		{
+			name: "same replacement twice",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Replace(at("bar"), "x"), r.Replace(at("bar"), "x"))
+			},
+			want: "foo(x, baz) + qux",
+		},
+		{
+			name: "different replacements",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Replace(at("bar"), "x"), r.Replace(at("bar"), "y"))
+			},
+			want:     "foo(x, baz) + qux",
+			conflict: DifferentReplacements,
+		},
+		{
+			name:    "different replacements accepted",
+			options: []Option{WithDifferentReplacements(PolicyAccept)},
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Replace(at("bar"), "x"), r.Replace(at("bar"), "y"))
+			},
+			want: "foo(y, baz) + qux",
+		},
+		{
+			name: "crossing deletions are fused",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Remove(at("bar, ")), r.Remove(at(", baz")))
+			},
+			want: "foo() + qux",
+		},
+		{
+			name:    "crossing deletions raised",
+			options: []Option{WithCrossingDeletions(PolicyRaise)},
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Remove(at("bar, ")), r.Remove(at(", baz")))
+			},
+			want:     "foo(baz) + qux",
+			conflict: CrossingDeletions,
+		},
+		{
+			name: "crossing insertions",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Wrap(at("bar, "), "(", ")"), r.Remove(at(", baz")))
+			},
+			want:     "foo((bar, )baz) + qux",
+			conflict: CrossingInsertions,
+		},
+		{
+			name: "swallowed insertions",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.InsertBefore(at("baz"), "x"), r.Remove(at("bar, baz")))
+			},
+			want:     "foo(bar, xbaz) + qux",
+			conflict: SwallowedInsertions,
+		},
+		{
+			name:    "swallowed insertions accepted",
+			options: []Option{WithSwallowedInsertions(PolicyAccept)},
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.InsertBefore(at("baz"), "x"), r.Remove(at("bar, baz")))
+			},
+			want: "foo() + qux",
+		},
+		{
+			name: "removal swallows removals",
+			edits: func(r *Rewriter) error {
+				return errors.Join(r.Remove(at("baz")), r.Remove(at("bar, baz")))
+			},
+			want: "foo() + qux",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewFromSource([]byte(source), test.options...)
+			err := test.edits(r)
+			var clobbering *ClobberingError
+			switch {
+			case test.conflict == "" && err != nil:
+				t.Fatalf("edits failed: %v", err)
+			case test.conflict != "" && !errors.As(err, &clobbering):
+				t.Fatalf("edits = %v, want a %s conflict", err, test.conflict)
+			case test.conflict != "" && clobbering.Conflict != test.conflict:
+				t.Fatalf("conflict = %s, want %s", clobbering.Conflict, test.conflict)
+			}
+			if got := string(r.Process()); got != test.want {
+				t.Errorf("Process() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRewriterWarnings(t *testing.T) {
+	r := NewFromSource([]byte(source), WithDifferentReplacements(PolicyWarn))
+	if err := errors.Join(r.Replace(at("bar"), "x"), r.Replace(at("bar"), "y")); err != nil {
+		t.Fatal(err)
+	}
+	warnings := r.Warnings()
+	if len(warnings) != 1 || warnings[0].Conflict != DifferentReplacements {
+		t.Fatalf("Warnings() = %v", warnings)
+	}
+	if got, want := warnings[0].Error(), "rewriter detected clobbering: different_replacements at 4...7"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if got := string(r.Process()); got != "foo(y, baz) + qux" {
+		t.Errorf("Process() = %q", got)
+	}
+}
+
+func TestRewriterNodes(t *testing.T) {
+	result := parsetest.Parse(t, "puts foo.bar(1)\n")
+	call := result.Value.Statements.Body[0].(*parser.CallNode)
+	inner := call.Arguments.Arguments[0].(*parser.CallNode)
+	r, err := New(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Empty() {
+		t.Error("Empty() is false before any edit")
+	}
+	err = errors.Join(
+		r.Replace(*call.MessageLoc, "p"),
+		r.Replace(*inner.MessageLoc, "baz"),
+		r.Wrap(inner.Arguments.Arguments[0], "[", "]"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Empty() {
+		t.Error("Empty() is true after edits")
+	}
+	if got, want := string(r.Process()), "p foo.baz([1])\n"; got != want {
+		t.Errorf("Process() = %q, want %q", got, want)
+	}
+	outside := parser.Location{StartOffset: 10, Length: 100}
+	if err := r.Remove(outside); err == nil {
+		t.Error("Remove() of a range outside of the source succeeded")
+	}
+}
+
+func TestRewriterWithoutSource(t *testing.T) {
+	for _, result := range []*parser.ParseResult{nil, {}} {
+		if _, err := New(result); err == nil {
+			t.Errorf("New(%v) succeeded without a source", result)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package scope
+
+import (
+	"strconv"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Analyze builds the scopes of the tree rooted at root and resolves its
+// local variable references.
+func Analyze(root parser.Node) *Analysis {
+	a := &Analysis{scopes: map[parser.Node]*Scope{}, references: map[parser.Node]*Variable{}}
+	if root == nil {
+		return a
+	}
+	if program, ok := root.(*parser.ProgramNode); ok {
+		a.Root = a.push(nil, Program, program)
+		a.visit(program.Statements, a.Root)
+		return a
+	}
+	a.Root = a.push(nil, Program, root)
+	a.visit(root, a.Root)
+	return a
+}
+
+func (a *Analysis) push(parent *Scope, kind Kind, node parser.Node) *Scope {
+	s := &Scope{Kind: kind, Node: node, Parent: parent, names: map[string]*Variable{}}
+	if parent != nil {
+		parent.Children = append(parent.Children, s)
+	}
+	a.scopes[node] = s
+	return s
+}
+
+// declare returns the variable of a scope with the given name, declaring
+// it with node if it is new.
+func (a *Analysis) declare(s *Scope, name string, kind VariableKind, node parser.Node) *Variable {
+	v, ok := s.names[name]
+	if !ok {
+		v = &Variable{Name: name, Kind: kind, Scope: s, Declaration: node}
+		s.names[name] = v
+		s.Variables = append(s.Variables, v)
+		a.Variables = append(a.Variables, v)
+	}
+	a.references[node] = v
+	return v
+}
+
+// reference resolves a local variable node depth scopes up from s,
+// declaring a local on the first write.
+func (a *Analysis) reference(s *Scope, name string, depth uint32, node parser.Node) *Variable {
+	for ; depth > 0 && s != nil; depth-- {
+		s = s.Parent
+	}
+	if s == nil {
+		return nil
+	}
+	if v, ok := s.names[name]; ok {
+		a.references[node] = v
+		return v
+	}
+	return a.declare(s, name, Local, node)
+}
+
+func (a *Analysis) read(s *Scope, name string, depth uint32, node parser.Node) {
+	if v := a.reference(s, name, depth, node); v != nil {
+		v.Reads = append(v.Reads, node)
+	}
+}
+
+func (a *Analysis) write(s *Scope, name string, depth uint32, node parser.Node) {
+	if v := a.reference(s, name, depth, node); v != nil {
+		v.Writes = append(v.Writes, node)
+	}
+}
+
+func (a *Analysis) readWrite(s *Scope, name string, depth uint32, node parser.Node) {
+	if v := a.reference(s, name, depth, node); v != nil {
+		v.Reads = append(v.Reads, node)
+		v.Writes = append(v.Writes, node)
+	}
+}
+
+func (a *Analysis) visit(node parser.Node, s *Scope) {
+	if node == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ClassNode:
+		// The path and the superclass are evaluated outside of the class.
+		a.visit(n.ConstantPath, s)
+		a.visit(n.Superclass, s)
+		a.visit(n.Body, a.push(s, Class, n))
+		return
+	case *parser.ModuleNode:
+		a.visit(n.ConstantPath, s)
+		a.visit(n.Body, a.push(s, Module, n))
+		return
+	case *parser.SingletonClassNode:
+		a.visit(n.Expression, s)
+		a.visit(n.Body, a.push(s, SingletonClass, n))
+		return
+	case *parser.DefNode:
+		a.visit(n.Receiver, s)
+		inner := a.push(s, Method, n)
+		if n.Parameters != nil {
+			a.visit(n.Parameters, inner)
+		}
+		a.visit(n.Body, inner)
+		return
+	case *parser.BlockNode:
+		inner := a.push(s, Block, n)
+		a.parameters(n.Parameters, inner)
+		a.visit(n.Body, inner)
+		return
+	case *parser.LambdaNode:
+		inner := a.push(s, Lambda, n)
+		a.parameters(n.Parameters, inner)
+		a.visit(n.Body, inner)
+		return
+
+	case *parser.RequiredParameterNode:
+		a.declare(s, n.Name, RequiredParameter, n)
+	case *parser.OptionalParameterNode:
+		a.declare(s, n.Name, OptionalParameter, n)
+	case *parser.RestParameterNode:
+		if n.Name != nil {
+			a.declare(s, *n.Name, RestParameter, n)
+		}
+	case *parser.RequiredKeywordParameterNode:
+		a.declare(s, n.Name, KeywordParameter, n)
+	case *parser.OptionalKeywordParameterNode:
+		a.declare(s, n.Name, KeywordParameter, n)
+	case *parser.KeywordRestParameterNode:
+		if n.Name != nil {
+			a.declare(s, *n.Name, KeywordRestParameter, n)
+		}
+	case *parser.BlockParameterNode:
+		if n.Name != nil {
+			a.declare(s, *n.Name, BlockParameter, n)
+		}
+	case *parser.BlockLocalVariableNode:
+		a.declare(s, n.Name, BlockLocal, n)
+
+	case *parser.LocalVariableReadNode:
+		a.read(s, n.Name, n.Depth, n)
+	case *parser.LocalVariableWriteNode:
+		a.write(s, n.Name, n.Depth, n)
+	case *parser.LocalVariableTargetNode:
+		a.write(s, n.Name, n.Depth, n)
+	case *parser.LocalVariableOperatorWriteNode:
+		a.readWrite(s, n.Name, n.Depth, n)
+	case *parser.LocalVariableAndWriteNode:
+		a.readWrite(s, n.Name, n.Depth, n)
+	case *parser.LocalVariableOrWriteNode:
+		a.readWrite(s, n.Name, n.Depth, n)
+	case *parser.ItLocalVariableReadNode:
+		for current := s; current != nil && current.Kind.Soft(); current = current.Parent {
+			if v, ok := current.names["it"]; ok && v.Kind == ItParameter {
+				a.references[n] = v
+				v.Reads = append(v.Reads, n)
+				break
+			}
+		}
+	case *parser.ForwardingSuperNode:
+		// super without arguments passes the parameters of the method.
+		if method := enclosingMethod(s); method != nil {
+			for _, v := range method.Variables {
+				if v.Kind.IsParameter() {
+					v.Reads = append(v.Reads, n)
+				}
+			}
+		}
+	}
+	for _, child := range node.CompactChildNodes() {
+		a.visit(child, s)
+	}
+}
+
+// parameters declares the parameters of a block or lambda, explicit or
+// implicit.
+func (a *Analysis) parameters(node parser.Node, s *Scope) {
+	switch n := node.(type) {
+	case *parser.NumberedParametersNode:
+		for number := 1; number <= int(n.Maximum); number++ {
+			a.declare(s, "_"+strconv.Itoa(number), NumberedParameter, n)
+		}
+		// The numbered parameters share one declaration node, which
+		// resolves to none of them.
+		delete(a.references, n)
+	case *parser.ItParametersNode:
+		a.declare(s, "it", ItParameter, n)
+	default:
+		a.visit(node, s)
+	}
+}
+
+// enclosingMethod returns the method scope s is in, through soft scopes.
+func enclosingMethod(s *Scope) *Scope {
+	for ; s != nil; s = s.Parent {
+		if s.Kind == Method {
+			return s
+		}
+		if !s.Kind.Soft() {
+			return nil
+		}
+	}
+	return nil
+}
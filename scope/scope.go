@@ -0,0 +1,241 @@
+// Package scope builds the lexical scopes of a Ruby program and resolves
+// each local variable reference to the variable it refers to.
+//
+// Prism records on each local variable node the name and the number of
+// scopes to go up to find it, and on each scope the names of its locals.
+// Analyze links them: every read, write and target is attached to a
+// Variable, declared by a parameter or by its first assignment, from which
+// unused, reassigned and shadowing variables can be reported:
+//
+//	analysis := scope.Analyze(result.Value)
+//	for _, v := range analysis.Unused() {
+//		fmt.Printf("%s is never read at %v\n", v.Name, v.Declaration.GetLocation())
+//	}
+//
+// Methods, classes, modules and the program are hard scopes, which do not
+// see the locals around them. Blocks and lambdas are soft scopes: they see
+// the locals of the scopes they are nested in.
+package scope
+
+import (
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the kind of a scope.
+type Kind int
+
+const (
+	// Program is the top-level scope of a file.
+	Program Kind = iota
+	// Class is the body of a class.
+	Class
+	// Module is the body of a module.
+	Module
+	// SingletonClass is the body of class << object.
+	SingletonClass
+	// Method is a method definition, including its parameters.
+	Method
+	// Block is a block given to a call, including its parameters.
+	Block
+	// Lambda is a lambda literal, as in ->(x) { x }.
+	Lambda
+)
+
+var kindNames = enum.Names[Kind]{
+	Program:        "program",
+	Class:          "class",
+	Module:         "module",
+	SingletonClass: "singleton class",
+	Method:         "method",
+	Block:          "block",
+	Lambda:         "lambda",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Soft reports whether a scope of this kind sees the locals of the scope it
+// is nested in.
+func (k Kind) Soft() bool {
+	return k == Block || k == Lambda
+}
+
+// Scope is a lexical scope of local variables.
+type Scope struct {
+	Kind Kind
+	// Node is the node that opens the scope, such as a *parser.DefNode. The
+	// scope of a tree whose root is not a program has the root as its node.
+	Node     parser.Node
+	Parent   *Scope
+	Children []*Scope
+	// Variables are the variables declared in the scope, in the order they
+	// are declared.
+	Variables []*Variable
+	names     map[string]*Variable
+}
+
+// Lookup returns the variable a name refers to in the scope: a variable of
+// the scope or, through soft scopes, of an enclosing one.
+func (s *Scope) Lookup(name string) *Variable {
+	for current := s; current != nil; current = current.Parent {
+		if v, ok := current.names[name]; ok {
+			return v
+		}
+		if !current.Kind.Soft() {
+			break
+		}
+	}
+	return nil
+}
+
+// VariableKind is the way a variable is declared.
+type VariableKind int
+
+const (
+	// Local is a variable declared by an assignment or by a target, as in
+	// a pattern, a for loop or a regular expression with named captures.
+	Local VariableKind = iota
+	// RequiredParameter is a positional parameter without a default value.
+	RequiredParameter
+	// OptionalParameter is a positional parameter with a default value.
+	OptionalParameter
+	// RestParameter is a named rest parameter, as in *args.
+	RestParameter
+	// KeywordParameter is a keyword parameter, with or without a default
+	// value.
+	KeywordParameter
+	// KeywordRestParameter is a named keyword rest parameter, as in
+	// **options.
+	KeywordRestParameter
+	// BlockParameter is a named block parameter, as in &block.
+	BlockParameter
+	// BlockLocal is a block-local variable, as in |x; y|.
+	BlockLocal
+	// NumberedParameter is one of _1 to _9. A block declares those up to
+	// the greatest one it uses.
+	NumberedParameter
+	// ItParameter is the implicit it parameter of a block.
+	ItParameter
+)
+
+var variableKindNames = enum.Names[VariableKind]{
+	Local:                "local",
+	RequiredParameter:    "required parameter",
+	OptionalParameter:    "optional parameter",
+	RestParameter:        "rest parameter",
+	KeywordParameter:     "keyword parameter",
+	KeywordRestParameter: "keyword rest parameter",
+	BlockParameter:       "block parameter",
+	BlockLocal:           "block local",
+	NumberedParameter:    "numbered parameter",
+	ItParameter:          "it parameter",
+}
+
+func (k VariableKind) String() string {
+	return variableKindNames.String(k)
+}
+
+// IsParameter reports whether variables of this kind are bound when the
+// scope is entered.
+func (k VariableKind) IsParameter() bool {
+	return k != Local && k != BlockLocal
+}
+
+// Variable is a local variable.
+type Variable struct {
+	Name  string
+	Kind  VariableKind
+	Scope *Scope
+	// Declaration is the node that declares the variable: a parameter, a
+	// *parser.BlockLocalVariableNode, the first write or target, or for
+	// numbered and it parameters, the parameters node of the block.
+	Declaration parser.Node
+	// Reads are the nodes that read the variable. Operator writes such as
+	// x += 1 both read and write it, and super without arguments reads the
+	// parameters of its method.
+	Reads []parser.Node
+	// Writes are the nodes that assign the variable, including the
+	// declaration of a local.
+	Writes []parser.Node
+}
+
+// Reassigned reports whether the variable is assigned after its
+// declaration: a parameter assigned at all, or another variable assigned
+// more than once.
+func (v *Variable) Reassigned() bool {
+	if v.Kind.IsParameter() {
+		return len(v.Writes) > 0
+	}
+	return len(v.Writes) > 1
+}
+
+// Shadowing is a parameter or block local of a block or lambda that hides a
+// variable of an enclosing scope with the same name.
+type Shadowing struct {
+	Variable *Variable
+	Outer    *Variable
+}
+
+// Analysis is the result of Analyze.
+type Analysis struct {
+	// Root is the outermost scope.
+	Root *Scope
+	// Variables are all the variables, in the order they are declared.
+	Variables  []*Variable
+	scopes     map[parser.Node]*Scope
+	references map[parser.Node]*Variable
+}
+
+// Scope returns the scope opened by a node, or nil if the node opens no
+// scope.
+func (a *Analysis) Scope(node parser.Node) *Scope {
+	return a.scopes[node]
+}
+
+// Resolve returns the variable a node declares or refers to: a local
+// variable read, write or target, a parameter or a block local. It returns
+// nil for other nodes.
+func (a *Analysis) Resolve(node parser.Node) *Variable {
+	return a.references[node]
+}
+
+// Unused returns the variables that are never read. Variables whose name
+// starts with an underscore are meant to be unused and are left out.
+func (a *Analysis) Unused() []*Variable {
+	var unused []*Variable
+	for _, v := range a.Variables {
+		if len(v.Reads) == 0 && v.Name[0] != '_' {
+			unused = append(unused, v)
+		}
+	}
+	return unused
+}
+
+// Reassigned returns the variables assigned after their declaration.
+func (a *Analysis) Reassigned() []*Variable {
+	var reassigned []*Variable
+	for _, v := range a.Variables {
+		if v.Reassigned() {
+			reassigned = append(reassigned, v)
+		}
+	}
+	return reassigned
+}
+
+// Shadowed returns the parameters and block locals of blocks and lambdas
+// that hide a variable of an enclosing scope, declared before the block.
+func (a *Analysis) Shadowed() []Shadowing {
+	var shadowed []Shadowing
+	for _, v := range a.Variables {
+		if !v.Scope.Kind.Soft() || v.Kind == Local || v.Scope.Parent == nil {
+			continue
+		}
+		outer := v.Scope.Parent.Lookup(v.Name)
+		if outer != nil && outer.Declaration.GetLocation().StartOffset < v.Scope.Node.GetLocation().StartOffset {
+			shadowed = append(shadowed, Shadowing{Variable: v, Outer: outer})
+		}
+	}
+	return shadowed
+}
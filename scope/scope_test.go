@@ -0,0 +1,157 @@
+package scope_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/scope"
+)
+
+// describe lists the variables of an analysis as name:kind:scope with the
+// numbers of reads and writes, e.g. x:local:program:r1w2.
+func describe(variables []*scope.Variable) string {
+	parts := make([]string, len(variables))
+	for index, v := range variables {
+		parts[index] = fmt.Sprintf("%s:%s:%s:r%dw%d", v.Name, v.Kind, v.Scope.Kind, len(v.Reads), len(v.Writes))
+	}
+	return strings.Join(parts, " ")
+}
+
+func names(variables []*scope.Variable) string {
+	parts := make([]string, len(variables))
+	for index, v := range variables {
+		parts[index] = v.Name
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"x = 1\nx += 2\np x", "x:local:program:r2w2"},
+		{"def f(a, b = 1, *c, d:, e: 2, **f, &g)\nend", "a:required parameter:method:r0w0 b:optional parameter:method:r0w0 c:rest parameter:method:r0w0 d:keyword parameter:method:r0w0 e:keyword parameter:method:r0w0 f:keyword rest parameter:method:r0w0 g:block parameter:method:r0w0"},
+		{"x = 1\n[1].each { |y; z| z = x + y }", "x:local:program:r1w1 y:required parameter:block:r1w0 z:block local:block:r0w1"},
+		{"x = 1\ndef f\n  x = 2\nend", "x:local:program:r0w1 x:local:method:r0w1"},
+		{"class A\n  x = 1\n  class << self\n    x = 2\n  end\nend", "x:local:class:r0w1 x:local:singleton class:r0w1"},
+		{"module M\n  y ||= 1\nend", "y:local:module:r1w1"},
+		{"f = ->(a) { a }", "f:local:program:r0w1 a:required parameter:lambda:r1w0"},
+		{"[1].map { _1 + _2 }", "_1:numbered parameter:block:r1w0 _2:numbered parameter:block:r1w0"},
+		{"[1].map { it * 2 }", "it:it parameter:block:r1w0"},
+		{"def f(a)\n  a = 1\n  super\nend", "a:required parameter:method:r1w1"},
+		{"case [1]\nin [v]\n  v\nend", "v:local:program:r1w1"},
+		{"for i in [1] do end", "i:local:program:r0w1"},
+		{"/(?<m>.)/ =~ s", "m:local:program:r0w1"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			analysis := scope.Analyze(parsetest.Parse(t, test.source).Value)
+			if got := describe(analysis.Variables); got != test.want {
+				t.Errorf("variables = %s\nwant        %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	result := parsetest.Parse(t, "x = 1\n[2].each { |y| p x, y }")
+	analysis := scope.Analyze(result.Value)
+	x := analysis.Variables[0]
+	if len(x.Reads) != 1 {
+		t.Fatalf("x has %d reads, want 1", len(x.Reads))
+	}
+	read := x.Reads[0].(*parser.LocalVariableReadNode)
+	if read.Depth != 1 || analysis.Resolve(read) != x || analysis.Resolve(x.Declaration) != x {
+		t.Errorf("Resolve() does not link the read and the declaration of x")
+	}
+	if analysis.Resolve(result.Value) != nil {
+		t.Error("Resolve(program) != nil")
+	}
+
+	block := x.Reads[0]
+	for _, s := range analysis.Root.Children {
+		if s.Kind == scope.Block {
+			block = s.Node
+		}
+	}
+	inner := analysis.Scope(block)
+	if inner == nil || inner.Parent != analysis.Root || analysis.Scope(result.Value) != analysis.Root {
+		t.Fatalf("Scope() does not return the block and program scopes")
+	}
+	if inner.Lookup("x") != x || inner.Lookup("y") == nil || analysis.Root.Lookup("y") != nil {
+		t.Error("Lookup() does not see through the block scope only")
+	}
+}
+
+func TestLookupStopsAtHardScopes(t *testing.T) {
+	analysis := scope.Analyze(parsetest.Parse(t, "x = 1\ndef f\n  [1].each { y = 1 }\nend").Value)
+	method := analysis.Root.Children[0]
+	block := method.Children[0]
+	if block.Lookup("y") == nil || block.Lookup("x") != nil {
+		t.Error("Lookup() crossed the method scope")
+	}
+}
+
+func TestReports(t *testing.T) {
+	tests := []struct {
+		source                        string
+		unused, reassigned, shadowing string
+	}{
+		{"a = 1\n_b = 2\nc = 3\np c", "a", "", ""},
+		{"def f(a)\n  a = 2\n  a\nend", "", "a", ""},
+		{"x = 1\ny = 1\ny = 2\n[1].each { |x| p x, y }", "x", "y", "x"},
+		{"[1].each { |x| x }\nx = 1\np x", "", "", ""},
+		{"x = 1\nf = ->(x) { x }\np x, f", "", "", "x"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			analysis := scope.Analyze(parsetest.Parse(t, test.source).Value)
+			if got := names(analysis.Unused()); got != test.unused {
+				t.Errorf("Unused() = %q, want %q", got, test.unused)
+			}
+			if got := names(analysis.Reassigned()); got != test.reassigned {
+				t.Errorf("Reassigned() = %q, want %q", got, test.reassigned)
+			}
+			var shadowing []*scope.Variable
+			for _, s := range analysis.Shadowed() {
+				if s.Outer.Name != s.Variable.Name || s.Outer.Scope == s.Variable.Scope {
+					t.Errorf("Shadowed() paired %s with %s", s.Variable.Name, s.Outer.Name)
+				}
+				shadowing = append(shadowing, s.Variable)
+			}
+			if got := names(shadowing); got != test.shadowing {
+				t.Errorf("Shadowed() = %q, want %q", got, test.shadowing)
+			}
+		})
+	}
+}
+
+func TestKindString(t *testing.T) {
+	if got := scope.SingletonClass.String(); got != "singleton class" {
+		t.Errorf("SingletonClass.String() = %q", got)
+	}
+	if got := scope.Kind(20).String(); got != "scope.Kind(20)" {
+		t.Errorf("Kind(20).String() = %q", got)
+	}
+	if got := scope.VariableKind(-1).String(); got != "scope.VariableKind(-1)" {
+		t.Errorf("VariableKind(-1).String() = %q", got)
+	}
+}
+
+func TestAnalyzeSubtree(t *testing.T) {
+	def := parsetest.Parse(t, "def f(a) = a").Value.Statements.Body[0]
+	analysis := scope.Analyze(def)
+	if analysis.Root.Node != def || len(analysis.Root.Children) != 1 {
+		t.Fatalf("Analyze(def) root = %v", analysis.Root.Node)
+	}
+	if got := describe(analysis.Variables); got != "a:required parameter:method:r1w0" {
+		t.Errorf("variables = %s", got)
+	}
+	if scope.Analyze(nil).Root != nil {
+		t.Error("Analyze(nil) has a root")
+	}
+}
@@ -0,0 +1,287 @@
+// Package signature describes the parameters of methods, blocks and
+// lambdas as a flat list in the order they are written, with their arity.
+//
+// Prism splits parameters into slots, each with its own node types. A
+// Signature turns them into parameters with a kind, a name and the source
+// of their default value:
+//
+//	sig, ok := signature.Of(def, result.Source.Bytes)
+//	for _, p := range sig.Parameters {
+//		fmt.Println(p.Kind, p.Name, p.Default)
+//	}
+//	fmt.Println(sig.Arity()) // like Method#arity
+package signature
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the kind of a parameter.
+type Kind int
+
+const (
+	// Required is a positional parameter before the optional and rest
+	// parameters, as in a or (a, b).
+	Required Kind = iota
+	// Optional is a positional parameter with a default value, as in a = 1.
+	Optional
+	// Rest is a rest parameter, as in *args or *.
+	Rest
+	// Post is a required positional parameter after the optional or rest
+	// parameters.
+	Post
+	// RequiredKeyword is a keyword parameter without a default value, as in
+	// a:.
+	RequiredKeyword
+	// OptionalKeyword is a keyword parameter with a default value, as in
+	// a: 1.
+	OptionalKeyword
+	// KeywordRest is a keyword rest parameter, as in **options or **.
+	KeywordRest
+	// NoKeywords is **nil, which accepts no keywords.
+	NoKeywords
+	// Block is a block parameter, as in &block or &.
+	Block
+	// Forwarding is ..., which forwards all arguments.
+	Forwarding
+)
+
+var kindNames = enum.Names[Kind]{
+	Required:        "required",
+	Optional:        "optional",
+	Rest:            "rest",
+	Post:            "post",
+	RequiredKeyword: "required keyword",
+	OptionalKeyword: "optional keyword",
+	KeywordRest:     "keyword rest",
+	NoKeywords:      "no keywords",
+	Block:           "block",
+	Forwarding:      "forwarding",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Parameter is a parameter of a signature.
+type Parameter struct {
+	Kind Kind
+	// Name is the name of the parameter, empty for an anonymous rest,
+	// keyword rest or block parameter. A destructuring parameter is named
+	// after its pattern, as in (a, *b).
+	Name string
+	// Names are the locals a destructuring parameter binds, in order.
+	Names []string
+	// Default is the source of the default value of an optional parameter.
+	Default string
+	// Node is the parameter node.
+	Node parser.Node
+}
+
+// Signature is the parameters of a method, block or lambda.
+type Signature struct {
+	Parameters []Parameter
+	// Proc reports whether the signature is that of a block, whose arity
+	// follows Proc#arity rather than Method#arity.
+	Proc bool
+}
+
+// Of returns the signature of a *parser.DefNode, *parser.BlockNode or
+// *parser.LambdaNode. The source is used for the default values and may be
+// nil. A block using numbered parameters has _1 up to the greatest one it
+// uses, and a block using it has it, as required parameters.
+func Of(node parser.Node, source []byte) (*Signature, bool) {
+	var parameters parser.Node
+	s := &Signature{}
+	switch n := node.(type) {
+	case *parser.DefNode:
+		if n.Parameters != nil {
+			parameters = n.Parameters
+		}
+	case *parser.BlockNode:
+		parameters = n.Parameters
+		s.Proc = true
+	case *parser.LambdaNode:
+		parameters = n.Parameters
+	default:
+		return nil, false
+	}
+	if block, ok := parameters.(*parser.BlockParametersNode); ok {
+		parameters = nil
+		if block.Parameters != nil {
+			parameters = block.Parameters
+		}
+	}
+	switch n := parameters.(type) {
+	case *parser.ParametersNode:
+		s.add(n, source)
+	case *parser.NumberedParametersNode:
+		for number := 1; number <= int(n.Maximum); number++ {
+			s.Parameters = append(s.Parameters, Parameter{Kind: Required, Name: "_" + strconv.Itoa(number), Node: n})
+		}
+	case *parser.ItParametersNode:
+		s.Parameters = append(s.Parameters, Parameter{Kind: Required, Name: "it", Node: n})
+	}
+	return s, true
+}
+
+func (s *Signature) add(parameters *parser.ParametersNode, source []byte) {
+	for _, node := range parameters.Requireds {
+		s.Parameters = append(s.Parameters, positional(Required, node))
+	}
+	for _, node := range parameters.Optionals {
+		if n, ok := node.(*parser.OptionalParameterNode); ok {
+			s.Parameters = append(s.Parameters, Parameter{Kind: Optional, Name: n.Name, Default: text(source, n.Value), Node: n})
+		}
+	}
+	if n, ok := parameters.Rest.(*parser.RestParameterNode); ok {
+		s.Parameters = append(s.Parameters, Parameter{Kind: Rest, Name: name(n.Name), Node: n})
+	}
+	for _, node := range parameters.Posts {
+		s.Parameters = append(s.Parameters, positional(Post, node))
+	}
+	for _, node := range parameters.Keywords {
+		switch n := node.(type) {
+		case *parser.RequiredKeywordParameterNode:
+			s.Parameters = append(s.Parameters, Parameter{Kind: RequiredKeyword, Name: n.Name, Node: n})
+		case *parser.OptionalKeywordParameterNode:
+			s.Parameters = append(s.Parameters, Parameter{Kind: OptionalKeyword, Name: n.Name, Default: text(source, n.Value), Node: n})
+		}
+	}
+	switch n := parameters.KeywordRest.(type) {
+	case *parser.KeywordRestParameterNode:
+		s.Parameters = append(s.Parameters, Parameter{Kind: KeywordRest, Name: name(n.Name), Node: n})
+	case *parser.NoKeywordsParameterNode:
+		s.Parameters = append(s.Parameters, Parameter{Kind: NoKeywords, Node: n})
+	case *parser.ForwardingParameterNode:
+		s.Parameters = append(s.Parameters, Parameter{Kind: Forwarding, Node: n})
+	}
+	if parameters.Block != nil {
+		s.Parameters = append(s.Parameters, Parameter{Kind: Block, Name: name(parameters.Block.Name), Node: parameters.Block})
+	}
+}
+
+// positional returns a required parameter, which may destructure its
+// argument.
+func positional(kind Kind, node parser.Node) Parameter {
+	switch n := node.(type) {
+	case *parser.RequiredParameterNode:
+		return Parameter{Kind: kind, Name: n.Name, Node: n}
+	case *parser.MultiTargetNode:
+		var names []string
+		return Parameter{Kind: kind, Name: pattern(n, &names), Names: names, Node: n}
+	}
+	return Parameter{Kind: kind, Node: node}
+}
+
+// pattern returns a destructuring pattern as written, collecting the names
+// it binds.
+func pattern(node parser.Node, names *[]string) string {
+	switch n := node.(type) {
+	case *parser.RequiredParameterNode:
+		*names = append(*names, n.Name)
+		return n.Name
+	case *parser.SplatNode:
+		if n.Expression == nil {
+			return "*"
+		}
+		return "*" + pattern(n.Expression, names)
+	case *parser.MultiTargetNode:
+		var parts []string
+		for _, left := range n.Lefts {
+			parts = append(parts, pattern(left, names))
+		}
+		if n.Rest != nil {
+			if _, ok := n.Rest.(*parser.ImplicitRestNode); !ok {
+				parts = append(parts, pattern(n.Rest, names))
+			}
+		}
+		for _, right := range n.Rights {
+			parts = append(parts, pattern(right, names))
+		}
+		return "(" + strings.Join(parts, ", ") + ")"
+	}
+	return ""
+}
+
+func name(n *string) string {
+	if n == nil {
+		return ""
+	}
+	return *n
+}
+
+func text(source []byte, node parser.Node) string {
+	if source == nil || node == nil {
+		return ""
+	}
+	location := node.GetLocation()
+	if location.Length == 0 || location.EndOffset() > len(source) {
+		return ""
+	}
+	return string(source[location.StartOffset:location.EndOffset()])
+}
+
+// Min returns the least number of positional arguments the signature
+// accepts.
+func (s *Signature) Min() int {
+	count := 0
+	for _, p := range s.Parameters {
+		if p.Kind == Required || p.Kind == Post {
+			count++
+		}
+	}
+	return count
+}
+
+// Max returns the greatest number of positional arguments the signature
+// accepts, or -1 if it accepts any number, with a rest parameter or
+// forwarding. A block accepts any number of arguments, but Max still
+// reports the number its parameters take.
+func (s *Signature) Max() int {
+	count := 0
+	for _, p := range s.Parameters {
+		switch p.Kind {
+		case Required, Post, Optional:
+			count++
+		case Rest, Forwarding:
+			return -1
+		}
+	}
+	return count
+}
+
+// Arity returns the arity of the signature like Method#arity, or Proc#arity
+// for a block: the number of required arguments, or if more arguments are
+// accepted, -n-1 for n required arguments. Keyword parameters count as a
+// single argument, required if any keyword parameter is. For a block,
+// optional positional parameters alone do not make the arity negative.
+func (s *Signature) Arity() int {
+	required := s.Min()
+	optional, requiredKeyword, optionalKeyword := false, false, false
+	for _, p := range s.Parameters {
+		switch p.Kind {
+		case Optional:
+			optional = optional || !s.Proc
+		case Rest, Forwarding:
+			optional = true
+		case RequiredKeyword:
+			requiredKeyword = true
+		case OptionalKeyword, KeywordRest:
+			optionalKeyword = true
+		}
+	}
+	if requiredKeyword {
+		required++
+	} else if optionalKeyword && !s.Proc {
+		optional = true
+	}
+	if optional {
+		return -required - 1
+	}
+	return required
+}
@@ -0,0 +1,114 @@
+package signature_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/signature"
+)
+
+// callable returns the first method, block or lambda of a source.
+func callable(t *testing.T, result *parser.ParseResult) parser.Node {
+	t.Helper()
+	var found parser.Node
+	parser.Walk(result.Value, func(node parser.Node) bool {
+		switch node.(type) {
+		case *parser.DefNode, *parser.BlockNode, *parser.LambdaNode:
+			if found == nil {
+				found = node
+			}
+		}
+		return found == nil
+	})
+	if found == nil {
+		t.Fatal("no method, block or lambda")
+	}
+	return found
+}
+
+// describe lists parameters as kind:name, with the default value after =.
+func describe(s *signature.Signature) string {
+	parts := make([]string, len(s.Parameters))
+	for index, p := range s.Parameters {
+		parts[index] = p.Kind.String() + ":" + p.Name
+		if p.Default != "" {
+			parts[index] += "=" + p.Default
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestOf(t *testing.T) {
+	tests := []struct {
+		source   string
+		want     string
+		min, max int
+		arity    int
+	}{
+		{"def m; end", "", 0, 0, 0},
+		{"def m(a, b = 1 + 2) = a", "required:a optional:b=1 + 2", 1, 2, -2},
+		{"def m(a, *r, b); end", "required:a rest:r post:b", 2, -1, -3},
+		{"def m(*, **, &); end", "rest: keyword rest: block:", 0, -1, -1},
+		{"def m(a:); end", "required keyword:a", 0, 0, 1},
+		{"def m(a: 1); end", "optional keyword:a=1", 0, 0, -1},
+		{"def m(a, b:, c: {}); end", "required:a required keyword:b optional keyword:c={}", 1, 1, 2},
+		{"def m(**opts); end", "keyword rest:opts", 0, 0, -1},
+		{"def m(a, **nil); end", "required:a no keywords:", 1, 1, 1},
+		{"def m(...) = f(...)", "forwarding:", 0, -1, -1},
+		{"def m(&block); end", "block:block", 0, 0, 0},
+		{"def m((a, (b, *c)), d); end", "required:(a, (b, *c)) required:d", 2, 2, 2},
+		{"f { |x, y = 0| }", "required:x optional:y=0", 1, 2, 1},
+		{"f { |x = 0| }", "optional:x=0", 0, 1, 0},
+		{"f { |*a| }", "rest:a", 0, -1, -1},
+		{"f { |x, y:| }", "required:x required keyword:y", 1, 1, 2},
+		{"f { |x, y: 0| }", "required:x optional keyword:y=0", 1, 1, 1},
+		{"f { |a, (b, c); d| }", "required:a required:(b, c)", 2, 2, 2},
+		{"f { || }", "", 0, 0, 0},
+		{"f { }", "", 0, 0, 0},
+		{"f { _1 + _3 }", "required:_1 required:_2 required:_3", 3, 3, 3},
+		{"f { it }", "required:it", 1, 1, 1},
+		{"->(a, b = 1) {}", "required:a optional:b=1", 1, 2, -2},
+		{"-> {}", "", 0, 0, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			result := parsetest.Parse(t, test.source)
+			s, ok := signature.Of(callable(t, result), result.Source.Bytes)
+			if !ok {
+				t.Fatal("Of() = false")
+			}
+			if got := describe(s); got != test.want {
+				t.Errorf("parameters = %q, want %q", got, test.want)
+			}
+			if s.Min() != test.min || s.Max() != test.max {
+				t.Errorf("Min(), Max() = %d, %d, want %d, %d", s.Min(), s.Max(), test.min, test.max)
+			}
+			if got := s.Arity(); got != test.arity {
+				t.Errorf("Arity() = %d, want %d", got, test.arity)
+			}
+		})
+	}
+}
+
+func TestOfDetails(t *testing.T) {
+	result := parsetest.Parse(t, "def m((a, (b, *c)), d = 1); end")
+	def := result.Value.Statements.Body[0]
+	s, _ := signature.Of(def, nil)
+	if got := strings.Join(s.Parameters[0].Names, " "); got != "a b c" {
+		t.Errorf("Names = %q, want a b c", got)
+	}
+	if _, ok := s.Parameters[0].Node.(*parser.MultiTargetNode); !ok {
+		t.Errorf("Node = %T, want *parser.MultiTargetNode", s.Parameters[0].Node)
+	}
+	if s.Parameters[1].Default != "" {
+		t.Errorf("Default without a source = %q", s.Parameters[1].Default)
+	}
+	if _, ok := signature.Of(result.Value, nil); ok {
+		t.Error("Of(program) = true")
+	}
+	if got := signature.Kind(10).String(); got != "signature.Kind(10)" {
+		t.Errorf("Kind(10).String() = %q", got)
+	}
+}
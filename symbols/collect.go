@@ -0,0 +1,189 @@
+package symbols
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// unknown names an object or a constant path that cannot be worked out
+// statically.
+const unknown = "?"
+
+// Collect builds the definition index of the tree rooted at root, parsed
+// from file.
+func Collect(file string, root parser.Node) *Table {
+	t := &Table{File: file, names: map[string][]*Symbol{}}
+	if root != nil {
+		t.visit(root, context{})
+	}
+	return t
+}
+
+// context is the lexical context of a definition.
+type context struct {
+	// namespace is the qualified name of the class or module definitions
+	// are made in.
+	namespace string
+	// object is the name of the object whose singleton class definitions
+	// are made in, within class << object.
+	object string
+	parent *Symbol
+}
+
+func (t *Table) add(kind Kind, name, qualifiedName string, node parser.Node, nameLocation parser.Location, c context) *Symbol {
+	s := &Symbol{
+		Kind:          kind,
+		Name:          name,
+		QualifiedName: qualifiedName,
+		Namespace:     c.namespace,
+		File:          t.File,
+		Node:          node,
+		Location:      node.GetLocation(),
+		NameLocation:  nameLocation,
+		Parent:        c.parent,
+	}
+	t.Symbols = append(t.Symbols, s)
+	t.names[qualifiedName] = append(t.names[qualifiedName], s)
+	return s
+}
+
+func (t *Table) visit(node parser.Node, c context) {
+	if node == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ClassNode:
+		t.visit(n.Superclass, c)
+		name := qualify(c.namespace, n.ConstantPath)
+		s := t.add(Class, n.Name, name, n, nameLocation(n.ConstantPath), c)
+		t.visit(n.Body, context{namespace: name, parent: s})
+		return
+	case *parser.ModuleNode:
+		name := qualify(c.namespace, n.ConstantPath)
+		s := t.add(Module, n.Name, name, n, nameLocation(n.ConstantPath), c)
+		t.visit(n.Body, context{namespace: name, parent: s})
+		return
+	case *parser.SingletonClassNode:
+		t.visit(n.Expression, c)
+		object := objectName(n.Expression, c)
+		name := "#<Class:" + object + ">"
+		s := t.add(SingletonClass, name, name, n, n.Expression.GetLocation(), c)
+		t.visit(n.Body, context{namespace: name, object: object, parent: s})
+		return
+	case *parser.DefNode:
+		switch {
+		case n.Receiver != nil:
+			t.add(SingletonMethod, n.Name, objectName(n.Receiver, c)+"."+n.Name, n, n.NameLoc, c)
+		case c.object != "":
+			t.add(SingletonMethod, n.Name, c.object+"."+n.Name, n, n.NameLoc, c)
+		case c.namespace == "":
+			t.add(Method, n.Name, "Object#"+n.Name, n, n.NameLoc, c)
+		default:
+			t.add(Method, n.Name, c.namespace+"#"+n.Name, n, n.NameLoc, c)
+		}
+	case *parser.ConstantWriteNode:
+		t.add(Constant, n.Name, join(c.namespace, n.Name), n, n.NameLoc, c)
+	case *parser.ConstantOrWriteNode:
+		t.add(Constant, n.Name, join(c.namespace, n.Name), n, n.NameLoc, c)
+	case *parser.ConstantTargetNode:
+		t.add(Constant, n.Name, join(c.namespace, n.Name), n, n.Location, c)
+	case *parser.ConstantPathWriteNode:
+		t.add(Constant, lastName(n.Target), qualify(c.namespace, n.Target), n, n.Target.NameLoc, c)
+		// The parent of the target names no definition.
+		t.visit(n.Value, c)
+		return
+	case *parser.ConstantPathOrWriteNode:
+		t.add(Constant, lastName(n.Target), qualify(c.namespace, n.Target), n, n.Target.NameLoc, c)
+		t.visit(n.Value, c)
+		return
+	case *parser.ConstantPathTargetNode:
+		t.add(Constant, lastName(n), qualify(c.namespace, n), n, n.NameLoc, c)
+		return
+	}
+	for _, child := range node.CompactChildNodes() {
+		t.visit(child, c)
+	}
+}
+
+// objectName returns the name of the object an expression evaluates to,
+// for singleton methods and classes.
+func objectName(node parser.Node, c context) string {
+	switch node.(type) {
+	case *parser.SelfNode:
+		if c.namespace == "" {
+			return "main"
+		}
+		return c.namespace
+	case *parser.ConstantReadNode, *parser.ConstantPathNode:
+		name, _ := written(node)
+		return name
+	}
+	return unknown
+}
+
+// qualify returns the qualified name of a constant defined with a path
+// within a namespace.
+func qualify(namespace string, path parser.Node) string {
+	name, rooted := written(path)
+	if rooted {
+		return name
+	}
+	return join(namespace, name)
+}
+
+// written returns a constant path as written, and whether it starts with
+// ::.
+func written(node parser.Node) (string, bool) {
+	switch n := node.(type) {
+	case *parser.ConstantReadNode:
+		return n.Name, false
+	case *parser.ConstantPathNode:
+		return writtenPath(n.Parent, n.Name)
+	case *parser.ConstantPathTargetNode:
+		return writtenPath(n.Parent, n.Name)
+	}
+	return unknown, false
+}
+
+func writtenPath(parent parser.Node, name *string) (string, bool) {
+	last := unknown
+	if name != nil {
+		last = *name
+	}
+	if parent == nil {
+		return last, true
+	}
+	prefix, rooted := written(parent)
+	return prefix + "::" + last, rooted
+}
+
+func join(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "::" + name
+}
+
+func lastName(node parser.Node) string {
+	switch n := node.(type) {
+	case *parser.ConstantPathNode:
+		if n.Name != nil {
+			return *n.Name
+		}
+	case *parser.ConstantPathTargetNode:
+		if n.Name != nil {
+			return *n.Name
+		}
+	}
+	return unknown
+}
+
+// nameLocation returns the location of the last name of a constant path.
+func nameLocation(node parser.Node) parser.Location {
+	switch n := node.(type) {
+	case *parser.ConstantPathNode:
+		return n.NameLoc
+	case *parser.ConstantPathTargetNode:
+		return n.NameLoc
+	}
+	return node.GetLocation()
+}
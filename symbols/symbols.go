@@ -0,0 +1,119 @@
+// Package symbols builds the definition index of a Ruby file: its classes,
+// modules, singleton classes, methods and constants, each with the fully
+// qualified name Ruby would give it, such as Admin::UsersController#index.
+//
+// Qualified names are worked out from the lexical nesting of the
+// definitions and from the constant paths they are written with, without
+// evaluating anything:
+//
+//	Admin::UsersController          a class or module
+//	Admin::UsersController#index    an instance method
+//	Admin::UsersController.find     a singleton method
+//	Admin::UsersController::LIMIT   a constant
+//	#<Class:Admin::UsersController> the singleton class, as in class << self
+//
+// Methods defined at the top level are private methods of Object, and
+// singleton methods defined on self there belong to main. An object that
+// is neither self nor a constant is named ?.
+package symbols
+
+import (
+	"slices"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the kind of a definition.
+type Kind int
+
+const (
+	// Class is a class definition. A class reopened in several places has a
+	// symbol for each.
+	Class Kind = iota
+	// Module is a module definition.
+	Module
+	// SingletonClass is class << object.
+	SingletonClass
+	// Method is an instance method definition.
+	Method
+	// SingletonMethod is a method defined on a single object, with
+	// def self.name or within class << self.
+	SingletonMethod
+	// Constant is a constant assignment, as in LIMIT = 10.
+	Constant
+)
+
+var kindNames = enum.Names[Kind]{
+	Class:           "class",
+	Module:          "module",
+	SingletonClass:  "singleton class",
+	Method:          "method",
+	SingletonMethod: "singleton method",
+	Constant:        "constant",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Symbol is a definition.
+type Symbol struct {
+	Kind Kind
+	// Name is the name the definition is written with: the last segment of
+	// a constant path, or the name of a method.
+	Name string
+	// QualifiedName is the fully qualified name of the definition.
+	QualifiedName string
+	// Namespace is the qualified name of the class or module the definition
+	// is made in, empty at the top level.
+	Namespace string
+	// File is the file the definition is in, as given to Collect.
+	File string
+	// Node is the definition node, such as a *parser.ClassNode or a
+	// *parser.ConstantWriteNode.
+	Node parser.Node
+	// Location is the location of the whole definition, and NameLocation
+	// the location of its name.
+	Location     parser.Location
+	NameLocation parser.Location
+	// Parent is the class, module or singleton class definition the symbol
+	// is nested in, or nil at the top level.
+	Parent *Symbol
+}
+
+func (s *Symbol) String() string {
+	return s.QualifiedName
+}
+
+// Table is the definition index of a file.
+type Table struct {
+	File string
+	// Symbols are the definitions in source order.
+	Symbols []*Symbol
+	names   map[string][]*Symbol
+}
+
+// Lookup returns the definitions with a qualified name, in source order.
+// A class or module reopened several times has several definitions.
+func (t *Table) Lookup(qualifiedName string) []*Symbol {
+	return t.names[qualifiedName]
+}
+
+// Add adds a definition made other than with definition syntax, such as a
+// method defined by attr_reader, keeping the symbols in source order.
+func (t *Table) Add(s *Symbol) {
+	t.Symbols = insert(t.Symbols, s)
+	t.names[s.QualifiedName] = insert(t.names[s.QualifiedName], s)
+}
+
+// insert inserts a symbol after those starting at or before it.
+func insert(list []*Symbol, s *Symbol) []*Symbol {
+	index := slices.IndexFunc(list, func(other *Symbol) bool {
+		return other.Location.StartOffset > s.Location.StartOffset
+	})
+	if index < 0 {
+		index = len(list)
+	}
+	return slices.Insert(list, index, s)
+}
@@ -0,0 +1,116 @@
+package symbols_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// describe lists the symbols of a table as kind qualified-name, one per
+// line.
+func describe(table *symbols.Table) string {
+	lines := make([]string, len(table.Symbols))
+	for index, s := range table.Symbols {
+		lines[index] = s.Kind.String() + " " + s.QualifiedName
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			"module Admin\n  class UsersController < Base\n    LIMIT = 10\n    def index; end\n    def self.find; end\n  end\nend",
+			"module Admin\nclass Admin::UsersController\nconstant Admin::UsersController::LIMIT\nmethod Admin::UsersController#index\nsingleton method Admin::UsersController.find",
+		},
+		{
+			"class A::B\n  class << self\n    def build; end\n  end\nend",
+			"class A::B\nsingleton class #<Class:A::B>\nsingleton method A::B.build",
+		},
+		{
+			"def helper; end\ndef self.run; end\nclass << self\nend",
+			"method Object#helper\nsingleton method main.run\nsingleton class #<Class:main>",
+		},
+		{
+			"module M\n  class ::Top\n    def x; end\n  end\nend",
+			"module M\nclass Top\nmethod Top#x",
+		},
+		{
+			"class C\n  def object.x; end\n  class << @other\n    def y; end\n  end\nend",
+			"class C\nsingleton method ?.x\nsingleton class #<Class:?>\nsingleton method ?.y",
+		},
+		{
+			"module M\n  A ||= 1\n  B, C = 1, 2\n  M::D = 3\n  ::E ||= 4\n  X::F, G = 5, 6\nend",
+			"module M\nconstant M::A\nconstant M::B\nconstant M::C\nconstant M::M::D\nconstant E\nconstant M::X::F\nconstant M::G",
+		},
+		{
+			"class A\nend\nclass A\n  attr = Class.new\nend",
+			"class A\nclass A",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			table := symbols.Collect("a.rb", parsetest.Parse(t, test.source).Value)
+			if got := describe(table); got != test.want {
+				t.Errorf("symbols =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSymbolFields(t *testing.T) {
+	result := parsetest.Parse(t, "module A\n  class B::C\n    def run = 1\n  end\nend")
+	table := symbols.Collect("a.rb", result.Value)
+	run := table.Lookup("A::B::C#run")
+	if len(run) != 1 {
+		t.Fatalf("Lookup(A::B::C#run) = %v", run)
+	}
+	method := run[0]
+	if method.Name != "run" || method.Namespace != "A::B::C" || method.File != "a.rb" || method.String() != "A::B::C#run" {
+		t.Errorf("method = %+v", method)
+	}
+	if got := string(result.Source.Slice(method.NameLocation)); got != "run" {
+		t.Errorf("NameLocation holds %q, want run", got)
+	}
+	if _, ok := method.Node.(*parser.DefNode); !ok || method.Location != method.Node.GetLocation() {
+		t.Errorf("Node = %T at %v", method.Node, method.Location)
+	}
+	class := method.Parent
+	if class == nil || class.QualifiedName != "A::B::C" || class.Name != "C" || class.Parent.QualifiedName != "A" || class.Parent.Parent != nil {
+		t.Fatalf("parents of the method = %v", class)
+	}
+	if got := string(result.Source.Slice(class.NameLocation)); got != "C" {
+		t.Errorf("class NameLocation holds %q, want C", got)
+	}
+}
+
+func TestAddAndLookup(t *testing.T) {
+	result := parsetest.Parse(t, "class A\n  attr_reader :x\n  def y; end\nend\nclass A\nend")
+	table := symbols.Collect("a.rb", result.Value)
+	if len(table.Lookup("A")) != 2 || table.Lookup("B") != nil {
+		t.Errorf("Lookup() = %v, %v", table.Lookup("A"), table.Lookup("B"))
+	}
+
+	call := result.Value.Statements.Body[0].(*parser.ClassNode).Body.(*parser.StatementsNode).Body[0]
+	table.Add(&symbols.Symbol{Kind: symbols.Method, Name: "x", QualifiedName: "A#x", Node: call, Location: call.GetLocation()})
+	if want := "class A\nmethod A#x\nmethod A#y\nclass A"; describe(table) != want {
+		t.Errorf("symbols after Add() =\n%s\nwant\n%s", describe(table), want)
+	}
+	if len(table.Lookup("A#x")) != 1 {
+		t.Error("Add() did not index the symbol")
+	}
+}
+
+func TestKindString(t *testing.T) {
+	if got := symbols.SingletonMethod.String(); got != "singleton method" {
+		t.Errorf("SingletonMethod.String() = %q", got)
+	}
+	if got := symbols.Kind(6).String(); got != "symbols.Kind(6)" {
+		t.Errorf("Kind(6).String() = %q", got)
+	}
+}
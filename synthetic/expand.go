@@ -0,0 +1,308 @@
+package synthetic
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// context is the lexical context of a definition, as in the symbols
+// package.
+type context struct {
+	namespace string
+	object    string
+	parent    *symbols.Symbol
+}
+
+type expander struct {
+	file    string
+	symbols map[parser.Node]*symbols.Symbol
+	methods []*Method
+}
+
+// Expand returns the methods synthesized in the tree rooted at root, whose
+// definition index is table, in source order.
+func Expand(table *symbols.Table, root parser.Node) []*Method {
+	e := &expander{file: table.File, symbols: map[parser.Node]*symbols.Symbol{}}
+	for _, s := range table.Symbols {
+		e.symbols[s.Node] = s
+	}
+	if root != nil {
+		e.visit(root, context{})
+	}
+	return e.methods
+}
+
+func (e *expander) visit(node parser.Node, c context) {
+	if node == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ClassNode:
+		s := e.symbols[n]
+		if call := structNew(n.Superclass); call != nil && s != nil {
+			e.structure(call, context{namespace: s.QualifiedName, parent: s}, c)
+		} else {
+			e.visit(n.Superclass, c)
+		}
+		e.body(n, n.Body, c)
+		return
+	case *parser.ModuleNode:
+		e.body(n, n.Body, c)
+		return
+	case *parser.SingletonClassNode:
+		e.visit(n.Expression, c)
+		e.body(n, n.Body, c)
+		return
+	case *parser.ConstantWriteNode:
+		if e.constant(n, n.Value, c) {
+			return
+		}
+	case *parser.ConstantOrWriteNode:
+		if e.constant(n, n.Value, c) {
+			return
+		}
+	case *parser.ConstantPathWriteNode:
+		if e.constant(n, n.Value, c) {
+			return
+		}
+	case *parser.ConstantPathOrWriteNode:
+		if e.constant(n, n.Value, c) {
+			return
+		}
+	case *parser.AliasMethodNode:
+		if name, location, ok := literal(n.NewName); ok {
+			original, _, _ := literal(n.OldName)
+			m := e.add(Alias, name, location, n, false, c)
+			m.Original = original
+		}
+		return
+	case *parser.CallNode:
+		e.call(n, c)
+	}
+	for _, child := range node.CompactChildNodes() {
+		e.visit(child, c)
+	}
+}
+
+// body visits the body of a class, module or singleton class.
+func (e *expander) body(node, body parser.Node, c context) {
+	s, ok := e.symbols[node]
+	if !ok {
+		e.visit(body, c)
+		return
+	}
+	inner := context{namespace: s.QualifiedName, parent: s}
+	if s.Kind == symbols.SingletonClass {
+		inner.object = strings.TrimSuffix(strings.TrimPrefix(s.Name, "#<Class:"), ">")
+	}
+	e.visit(body, inner)
+}
+
+// constant expands a constant assigned a Struct.new call, and reports
+// whether it is one.
+func (e *expander) constant(node, value parser.Node, c context) bool {
+	call := structNew(value)
+	s, ok := e.symbols[node]
+	if call == nil || !ok {
+		return false
+	}
+	e.structure(call, context{namespace: s.QualifiedName, parent: s}, c)
+	return true
+}
+
+// structure adds the members of a Struct.new call to the struct defined in
+// inner, and visits the block that defines its other methods there.
+func (e *expander) structure(call *parser.CallNode, inner, outer context) {
+	for _, argument := range arguments(call) {
+		if _, ok := argument.(*parser.SymbolNode); !ok {
+			e.visit(argument, outer)
+			continue
+		}
+		name, location, _ := literal(argument)
+		e.add(StructMember, name, location, call, false, inner)
+		e.add(StructMember, name+"=", location, call, false, inner)
+	}
+	if block, ok := call.Block.(*parser.BlockNode); ok {
+		e.visit(block.Body, inner)
+	} else {
+		e.visit(call.Block, outer)
+	}
+}
+
+// call expands a call to one of the methods that define methods on self.
+func (e *expander) call(call *parser.CallNode, c context) {
+	switch call.Receiver.(type) {
+	case nil, *parser.SelfNode:
+	default:
+		return
+	}
+	arguments := arguments(call)
+	switch call.Name {
+	case "attr_reader", "attr", "attr_writer", "attr_accessor":
+		kind := map[string]Kind{
+			"attr_reader":   AttrReader,
+			"attr":          AttrReader,
+			"attr_writer":   AttrWriter,
+			"attr_accessor": AttrAccessor,
+		}[call.Name]
+		for _, argument := range arguments {
+			name, location, ok := literal(argument)
+			if !ok {
+				continue
+			}
+			if kind != AttrWriter {
+				e.add(kind, name, location, call, false, c)
+			}
+			if kind != AttrReader {
+				e.add(kind, name+"=", location, call, false, c)
+			}
+		}
+	case "define_method", "define_singleton_method":
+		if len(arguments) == 0 {
+			return
+		}
+		if name, location, ok := literal(arguments[0]); ok {
+			if call.Name == "define_method" {
+				e.add(DefineMethod, name, location, call, false, c)
+			} else {
+				e.add(DefineSingletonMethod, name, location, call, true, c)
+			}
+		}
+	case "alias_method":
+		if len(arguments) < 2 {
+			return
+		}
+		name, location, ok := literal(arguments[0])
+		original, _, known := literal(arguments[1])
+		if ok && known {
+			m := e.add(AliasMethod, name, location, call, false, c)
+			m.Original = original
+		}
+	case "delegate":
+		e.delegate(call, arguments, c)
+	}
+}
+
+// delegate expands delegate :a, :b, to: :target, with the prefix: option
+// naming the methods target_a or prefix_a.
+func (e *expander) delegate(call *parser.CallNode, arguments []parser.Node, c context) {
+	var target, prefix string
+	var names []parser.Node
+	for _, argument := range arguments {
+		options, ok := argument.(*parser.KeywordHashNode)
+		if !ok {
+			names = append(names, argument)
+			continue
+		}
+		for _, element := range options.Elements {
+			assoc, ok := element.(*parser.AssocNode)
+			if !ok {
+				continue
+			}
+			key, _, _ := literal(assoc.Key)
+			switch key {
+			case "to":
+				target, _, _ = literal(assoc.Value)
+			case "prefix":
+				if _, ok := assoc.Value.(*parser.TrueNode); ok {
+					prefix = "true"
+				} else {
+					prefix, _, _ = literal(assoc.Value)
+				}
+			}
+		}
+	}
+	if target == "" {
+		return
+	}
+	if prefix == "true" {
+		prefix = strings.TrimPrefix(target, "@")
+	}
+	for _, argument := range names {
+		original, location, ok := literal(argument)
+		if !ok {
+			continue
+		}
+		name := original
+		if prefix != "" {
+			name = prefix + "_" + original
+		}
+		m := e.add(Delegate, name, location, call, false, c)
+		m.Original, m.Target = original, target
+	}
+}
+
+// add adds a method defined on self, or on the object whose singleton
+// class the definition is made in.
+func (e *expander) add(kind Kind, name string, location parser.Location, node parser.Node, singleton bool, c context) *Method {
+	s := &symbols.Symbol{
+		Kind:         symbols.Method,
+		Name:         name,
+		Namespace:    c.namespace,
+		File:         e.file,
+		Node:         node,
+		Location:     node.GetLocation(),
+		NameLocation: location,
+		Parent:       c.parent,
+	}
+	switch {
+	case c.object != "":
+		s.Kind, s.QualifiedName = symbols.SingletonMethod, c.object+"."+name
+	case singleton && c.namespace == "":
+		s.Kind, s.QualifiedName = symbols.SingletonMethod, "main."+name
+	case singleton:
+		s.Kind, s.QualifiedName = symbols.SingletonMethod, c.namespace+"."+name
+	case c.namespace == "":
+		s.QualifiedName = "Object#" + name
+	default:
+		s.QualifiedName = c.namespace + "#" + name
+	}
+	m := &Method{Kind: kind, Symbol: s}
+	e.methods = append(e.methods, m)
+	return m
+}
+
+// structNew returns a Struct.new call, possibly with a block, or nil for
+// other expressions.
+func structNew(node parser.Node) *parser.CallNode {
+	call, ok := node.(*parser.CallNode)
+	if !ok || call.Name != "new" {
+		return nil
+	}
+	switch r := call.Receiver.(type) {
+	case *parser.ConstantReadNode:
+		if r.Name == "Struct" {
+			return call
+		}
+	case *parser.ConstantPathNode:
+		if r.Parent == nil && r.Name != nil && *r.Name == "Struct" {
+			return call
+		}
+	}
+	return nil
+}
+
+func arguments(call *parser.CallNode) []parser.Node {
+	if call.Arguments == nil {
+		return nil
+	}
+	return call.Arguments.Arguments
+}
+
+// literal returns the value of a symbol or string literal and the location
+// of its content.
+func literal(node parser.Node) (string, parser.Location, bool) {
+	switch n := node.(type) {
+	case *parser.SymbolNode:
+		location := n.Location
+		if n.ValueLoc != nil {
+			location = *n.ValueLoc
+		}
+		return n.Unescaped.Value, location, true
+	case *parser.StringNode:
+		return n.Unescaped.Value, n.ContentLoc, true
+	}
+	return "", parser.Location{}, false
+}
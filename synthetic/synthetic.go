@@ -0,0 +1,80 @@
+// Package synthetic finds the methods a Ruby file defines without def,
+// through attr_reader, attr_writer and attr_accessor, define_method and
+// define_singleton_method with a literal name, alias_method and alias,
+// ActiveSupport's delegate ... to:, and the members of Struct.new(:a, :b)
+// assigned to a constant or used as a superclass.
+//
+// Each method comes with a symbol named like those of the symbols
+// package, whose node is the call defining it, so that it can be added to
+// the definition index of the file:
+//
+//	table := symbols.Collect(file, root)
+//	for _, m := range synthetic.Expand(table, root) {
+//		table.Add(m.Symbol)
+//	}
+//	table.Lookup("User#email=") // attr_accessor :email
+//
+// Calls with a receiver other than self, and names that are not symbol or
+// string literals, define nothing that can be known statically and are
+// skipped.
+package synthetic
+
+import (
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+)
+
+// Kind is the construct a method is defined with.
+type Kind int
+
+const (
+	// AttrReader is a reader defined by attr_reader or attr.
+	AttrReader Kind = iota
+	// AttrWriter is a writer defined by attr_writer.
+	AttrWriter
+	// AttrAccessor is a reader or a writer defined by attr_accessor.
+	AttrAccessor
+	// DefineMethod is define_method(:name).
+	DefineMethod
+	// DefineSingletonMethod is define_singleton_method(:name).
+	DefineSingletonMethod
+	// AliasMethod is alias_method :new, :old.
+	AliasMethod
+	// Alias is alias new old.
+	Alias
+	// Delegate is a method defined by delegate :name, to: :target.
+	Delegate
+	// StructMember is a reader or a writer of a Struct.new member.
+	StructMember
+)
+
+var kindNames = enum.Names[Kind]{
+	AttrReader:            "attr_reader",
+	AttrWriter:            "attr_writer",
+	AttrAccessor:          "attr_accessor",
+	DefineMethod:          "define_method",
+	DefineSingletonMethod: "define_singleton_method",
+	AliasMethod:           "alias_method",
+	Alias:                 "alias",
+	Delegate:              "delegate",
+	StructMember:          "struct member",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Method is a synthesized method.
+type Method struct {
+	Kind Kind
+	// Symbol is the method definition, a symbols.Method or
+	// symbols.SingletonMethod whose node is the *parser.CallNode or
+	// *parser.AliasMethodNode defining it, and whose name location is that
+	// of the literal naming it.
+	Symbol *symbols.Symbol
+	// Original is the name of the method an alias calls, or the method a
+	// delegated method calls on its target.
+	Original string
+	// Target is the target of a delegated method, as given to to:.
+	Target string
+}
@@ -0,0 +1,109 @@
+package synthetic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/symbols"
+	"github.com/danielgatis/go-ruby-prism/synthetic"
+)
+
+// describe lists methods as kind qualified-name, with the original and the
+// target of aliases and delegated methods.
+func describe(methods []*synthetic.Method) string {
+	lines := make([]string, len(methods))
+	for index, m := range methods {
+		lines[index] = m.Kind.String() + " " + m.Symbol.QualifiedName
+		if m.Original != "" {
+			lines[index] += " -> " + m.Original
+		}
+		if m.Target != "" {
+			lines[index] += " on " + m.Target
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func expand(t *testing.T, source string) (*symbols.Table, []*synthetic.Method, *parser.ParseResult) {
+	t.Helper()
+	result := parsetest.Parse(t, source)
+	table := symbols.Collect("app.rb", result.Value)
+	return table, synthetic.Expand(table, result.Value), result
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			"class User\n  attr_reader :id, 'name'\n  attr :old\n  attr_writer :token\n  attr_accessor :email\nend",
+			"attr_reader User#id\nattr_reader User#name\nattr_reader User#old\nattr_writer User#token=\nattr_accessor User#email\nattr_accessor User#email=",
+		},
+		{
+			"class User\n  define_method(:a) { }\n  define_singleton_method(\"b\") { }\n  define_method(name) { }\nend",
+			"define_method User#a\ndefine_singleton_method User.b",
+		},
+		{
+			"class User\n  alias_method :full, :name\n  alias short name\n  alias $new $old\nend",
+			"alias_method User#full -> name\nalias User#short -> name",
+		},
+		{
+			"class Post\n  delegate :name, :email, to: :author\n  delegate :title, to: :@book, prefix: true\n  delegate :x, to: :y, prefix: :z\n  delegate :none\nend",
+			"delegate Post#name -> name on author\ndelegate Post#email -> email on author\ndelegate Post#book_title -> title on @book\ndelegate Post#z_x -> x on y",
+		},
+		{
+			"Point = Struct.new(:x, :y) do\n  attr_reader :z\nend\nclass Pair < Struct.new(:left)\nend",
+			"struct member Point#x\nstruct member Point#x=\nstruct member Point#y\nstruct member Point#y=\nattr_reader Point#z\nstruct member Pair#left\nstruct member Pair#left=",
+		},
+		{
+			"module M\n  Config ||= ::Struct.new(:debug, keyword_init: true)\nend",
+			"struct member M::Config#debug\nstruct member M::Config#debug=",
+		},
+		{
+			"class User\n  class << self\n    attr_accessor :cache\n    define_method(:build) { }\n  end\nend",
+			"attr_accessor User.cache\nattr_accessor User.cache=\ndefine_method User.build",
+		},
+		{
+			"attr_reader :top\ndefine_singleton_method(:run) { }\nself.attr_writer :w\nother.attr_reader :skipped",
+			"attr_reader Object#top\ndefine_singleton_method main.run\nattr_writer Object#w=",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			_, methods, _ := expand(t, test.source)
+			if got := describe(methods); got != test.want {
+				t.Errorf("methods =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSymbols(t *testing.T) {
+	table, methods, result := expand(t, "class User\n  def save; end\n  attr_accessor :email\nend")
+	for _, m := range methods {
+		table.Add(m.Symbol)
+	}
+	var names []string
+	for _, s := range table.Symbols {
+		names = append(names, s.QualifiedName)
+	}
+	if got := strings.Join(names, " "); got != "User User#save User#email User#email=" {
+		t.Errorf("symbols = %s", got)
+	}
+	writer := table.Lookup("User#email=")[0]
+	if writer.Kind != symbols.Method || writer.Name != "email=" || writer.Namespace != "User" || writer.Parent != table.Lookup("User")[0] {
+		t.Errorf("writer = %+v", writer)
+	}
+	if _, ok := writer.Node.(*parser.CallNode); !ok {
+		t.Errorf("writer node = %T, want *parser.CallNode", writer.Node)
+	}
+	if got := string(result.Source.Slice(writer.NameLocation)); got != "email" {
+		t.Errorf("NameLocation holds %q, want email", got)
+	}
+	if got := synthetic.Kind(9).String(); got != "synthetic.Kind(9)" {
+		t.Errorf("Kind(9).String() = %q", got)
+	}
+}
@@ -0,0 +1,446 @@
+package taint
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/scope"
+)
+
+// trace is the flow of a tainted value, from its last step back to its
+// source.
+type trace struct {
+	step     Step
+	previous *trace
+}
+
+// then returns the trace extended with a step.
+func (t *trace) then(step Step) *trace {
+	return &trace{step: step, previous: t}
+}
+
+// path returns the steps of the trace from the source.
+func (t *trace) path() []Step {
+	var steps []Step
+	for ; t != nil; t = t.previous {
+		steps = append([]Step{t.step}, steps...)
+	}
+	return steps
+}
+
+// member is an instance variable or method of a class, or of the top
+// level when class is nil.
+type member struct {
+	class parser.Node
+	name  string
+}
+
+type analyzer struct {
+	source *parser.Source
+	scopes *scope.Analysis
+	// locals, ivars and methods are the tainted variables and methods,
+	// each with the trace of the first tainted value found for it.
+	locals  map[*scope.Variable]*trace
+	ivars   map[member]*trace
+	methods map[member]*trace
+	changed bool
+}
+
+// Analyze returns the findings of a parsed file, in source order.
+func Analyze(file string, result *parser.ParseResult) []*Finding {
+	if result.Value == nil {
+		return nil
+	}
+	a := &analyzer{
+		source:  result.Source,
+		scopes:  scope.Analyze(result.Value),
+		locals:  map[*scope.Variable]*trace{},
+		ivars:   map[member]*trace{},
+		methods: map[member]*trace{},
+	}
+	// Values flow between methods through instance variables and return
+	// values, in any order, so they are propagated until nothing changes.
+	for a.changed = true; a.changed; {
+		a.changed = false
+		walk(result.Value, nil, a.propagate)
+	}
+	var findings []*Finding
+	walk(result.Value, nil, func(node, class parser.Node) {
+		if finding := a.sink(node, class); finding != nil {
+			finding.File = file
+			findings = append(findings, finding)
+		}
+	})
+	return findings
+}
+
+// propagate taints the variables and methods a node assigns tainted
+// values to.
+func (a *analyzer) propagate(node, class parser.Node) {
+	switch n := node.(type) {
+	case *parser.LocalVariableWriteNode:
+		a.local(n, a.taint(n.Value, class), n, "assigned to "+n.Name)
+	case *parser.LocalVariableOperatorWriteNode:
+		a.local(n, a.taint(n.Value, class), n, "assigned to "+n.Name)
+	case *parser.LocalVariableOrWriteNode:
+		a.local(n, a.taint(n.Value, class), n, "assigned to "+n.Name)
+	case *parser.LocalVariableAndWriteNode:
+		a.local(n, a.taint(n.Value, class), n, "assigned to "+n.Name)
+	case *parser.InstanceVariableWriteNode:
+		a.ivar(member{class, n.Name}, a.taint(n.Value, class), n)
+	case *parser.InstanceVariableOperatorWriteNode:
+		a.ivar(member{class, n.Name}, a.taint(n.Value, class), n)
+	case *parser.InstanceVariableOrWriteNode:
+		a.ivar(member{class, n.Name}, a.taint(n.Value, class), n)
+	case *parser.InstanceVariableAndWriteNode:
+		a.ivar(member{class, n.Name}, a.taint(n.Value, class), n)
+	case *parser.MultiWriteNode:
+		if t := a.taint(n.Value, class); t != nil {
+			targets := append(append([]parser.Node{}, n.Lefts...), n.Rights...)
+			if splat, ok := n.Rest.(*parser.SplatNode); ok && splat.Expression != nil {
+				targets = append(targets, splat.Expression)
+			}
+			for _, target := range targets {
+				a.target(target, t, n, class)
+			}
+		}
+	case *parser.ForNode:
+		a.target(n.Index, a.taint(n.Collection, class), n.Index, class)
+	case *parser.CallNode:
+		if n.Receiver == nil {
+			return
+		}
+		t := a.taint(n.Receiver, class)
+		if block, ok := n.Block.(*parser.BlockNode); ok && t != nil && iterating[n.Name] {
+			for _, parameter := range blockParameters(block) {
+				a.local(parameter, t, parameter, "yielded to "+a.text(parameter))
+			}
+		}
+		// Appending to a local string taints it.
+		if read, ok := n.Receiver.(*parser.LocalVariableReadNode); ok && (n.Name == "<<" || n.Name == "concat") {
+			a.local(read, a.arguments(n, class), n, "appended to "+read.Name)
+		}
+	case *parser.DefNode:
+		if n.Receiver == nil && n.Body != nil {
+			key := member{class, n.Name}
+			if t := a.taint(n.Body, class); t != nil && a.methods[key] == nil {
+				a.methods[key] = t.then(a.step(n, "returned by "+n.Name))
+				a.changed = true
+			}
+		}
+	}
+}
+
+// local taints the variable node declares or refers to.
+func (a *analyzer) local(node parser.Node, t *trace, at parser.Node, note string) {
+	v := a.scopes.Resolve(node)
+	if v == nil || t == nil || a.locals[v] != nil {
+		return
+	}
+	a.locals[v] = t.then(a.step(at, note))
+	a.changed = true
+}
+
+func (a *analyzer) ivar(key member, t *trace, at parser.Node) {
+	if t == nil || a.ivars[key] != nil {
+		return
+	}
+	a.ivars[key] = t.then(a.step(at, "assigned to "+key.name))
+	a.changed = true
+}
+
+// target taints a target of a multiple assignment or for loop.
+func (a *analyzer) target(node parser.Node, t *trace, at, class parser.Node) {
+	switch n := node.(type) {
+	case *parser.LocalVariableTargetNode:
+		a.local(n, t, at, "assigned to "+n.Name)
+	case *parser.InstanceVariableTargetNode:
+		a.ivar(member{class, n.Name}, t, at)
+	}
+}
+
+// taint returns the trace of the value of an expression, or nil if the
+// value is not tainted.
+func (a *analyzer) taint(node parser.Node, class parser.Node) *trace {
+	switch n := node.(type) {
+	case *parser.CallNode:
+		return a.call(n, class)
+	case *parser.ConstantReadNode:
+		if n.Name == "ENV" {
+			return a.origin(n)
+		}
+	case *parser.ConstantPathNode:
+		if n.Parent == nil && n.Name != nil && *n.Name == "ENV" {
+			return a.origin(n)
+		}
+	case *parser.LocalVariableReadNode:
+		return a.locals[a.scopes.Resolve(n)]
+	case *parser.InstanceVariableReadNode:
+		return a.ivars[member{class, n.Name}]
+	case *parser.InterpolatedStringNode:
+		return a.interpolated(n, n.Parts, class)
+	case *parser.InterpolatedSymbolNode:
+		return a.interpolated(n, n.Parts, class)
+	case *parser.InterpolatedXStringNode:
+		return a.interpolated(n, n.Parts, class)
+	case *parser.InterpolatedRegularExpressionNode:
+		return a.interpolated(n, n.Parts, class)
+	case *parser.EmbeddedStatementsNode:
+		if n.Statements != nil {
+			return a.taint(n.Statements, class)
+		}
+	case *parser.EmbeddedVariableNode:
+		return a.taint(n.Variable, class)
+	case *parser.StatementsNode:
+		if len(n.Body) > 0 {
+			return a.taint(n.Body[len(n.Body)-1], class)
+		}
+	case *parser.ParenthesesNode:
+		if n.Body != nil {
+			return a.taint(n.Body, class)
+		}
+	case *parser.BeginNode:
+		if n.Statements != nil {
+			return a.taint(n.Statements, class)
+		}
+	case *parser.OrNode:
+		return first(a.taint(n.Left, class), a.taint(n.Right, class))
+	case *parser.AndNode:
+		return first(a.taint(n.Left, class), a.taint(n.Right, class))
+	case *parser.IfNode:
+		var t *trace
+		if n.Statements != nil {
+			t = a.taint(n.Statements, class)
+		}
+		if t == nil && n.Subsequent != nil {
+			t = a.taint(n.Subsequent, class)
+		}
+		return t
+	case *parser.UnlessNode:
+		var t *trace
+		if n.Statements != nil {
+			t = a.taint(n.Statements, class)
+		}
+		if t == nil && n.ElseClause != nil {
+			t = a.taint(n.ElseClause, class)
+		}
+		return t
+	case *parser.ElseNode:
+		if n.Statements != nil {
+			return a.taint(n.Statements, class)
+		}
+	case *parser.ArrayNode:
+		for _, element := range n.Elements {
+			if t := a.taint(element, class); t != nil {
+				return t
+			}
+		}
+	case *parser.SplatNode:
+		if n.Expression != nil {
+			return a.taint(n.Expression, class)
+		}
+	case *parser.LocalVariableWriteNode:
+		return a.taint(n.Value, class)
+	case *parser.InstanceVariableWriteNode:
+		return a.taint(n.Value, class)
+	}
+	return nil
+}
+
+// call returns the trace of the value of a call.
+func (a *analyzer) call(n *parser.CallNode, class parser.Node) *trace {
+	if n.Receiver == nil && n.Arguments == nil && n.Block == nil {
+		if sources[n.Name] {
+			return a.origin(n)
+		}
+		if t := a.methods[member{class, n.Name}]; t != nil {
+			return t
+		}
+	}
+	if sanitizers[n.Name] {
+		return nil
+	}
+	if n.Receiver != nil {
+		if _, ok := n.Receiver.(*parser.SelfNode); ok && n.Arguments == nil {
+			if t := a.methods[member{class, n.Name}]; t != nil {
+				return t
+			}
+		}
+		if t := a.taint(n.Receiver, class); t != nil {
+			// A call on a source, as in params[:name], is a source itself.
+			if t.previous == nil && t.step.Location == n.Receiver.GetLocation() {
+				return a.origin(n)
+			}
+			return t
+		}
+	}
+	if concatenating[n.Name] {
+		if t := a.arguments(n, class); t != nil {
+			return t.then(a.step(n, "concatenated into a string"))
+		}
+	}
+	return nil
+}
+
+// arguments returns the trace of the first tainted argument of a call.
+func (a *analyzer) arguments(n *parser.CallNode, class parser.Node) *trace {
+	if n.Arguments == nil {
+		return nil
+	}
+	for _, argument := range n.Arguments.Arguments {
+		if t := a.taint(argument, class); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+func (a *analyzer) interpolated(node parser.Node, parts []parser.Node, class parser.Node) *trace {
+	for _, part := range parts {
+		if t := a.taint(part, class); t != nil {
+			return t.then(a.step(node, "interpolated into a string"))
+		}
+	}
+	return nil
+}
+
+// sink returns the finding of a node that passes a tainted value to a
+// sink, or nil.
+func (a *analyzer) sink(node, class parser.Node) *Finding {
+	var name string
+	var t *trace
+	var kind Kind
+	switch n := node.(type) {
+	case *parser.InterpolatedXStringNode:
+		name, kind, t = "`", CommandInjection, a.taint(n, class)
+	case *parser.CallNode:
+		s, ok := sinks[n.Name]
+		if !ok || !receives(s, n.Receiver) {
+			return nil
+		}
+		name, kind = n.Name, s.kind
+		switch {
+		case s.receiver:
+			t = a.taint(n.Receiver, class)
+		case n.Arguments != nil && len(n.Arguments.Arguments) > 0:
+			argument := n.Arguments.Arguments[0]
+			// The SQL of where(["name = ?", name]) is its first element,
+			// and the rest are bound safely.
+			if array, ok := argument.(*parser.ArrayNode); ok && kind == SQLInjection && len(array.Elements) > 0 {
+				argument = array.Elements[0]
+			}
+			if _, ok := argument.(*parser.KeywordHashNode); !ok {
+				t = a.taint(argument, class)
+			}
+		}
+	}
+	if t == nil {
+		return nil
+	}
+	line, _ := a.source.Line(node.GetLocation().StartOffset)
+	return &Finding{
+		Kind:     kind,
+		Sink:     name,
+		Location: node.GetLocation(),
+		Line:     line,
+		Path:     t.then(a.step(node, "reaches "+name)).path(),
+	}
+}
+
+// receives reports whether a sink is dangerous called on receiver.
+func receives(s sink, receiver parser.Node) bool {
+	if s.relation {
+		return relation(receiver)
+	}
+	if s.receivers == nil {
+		return true
+	}
+	name := ""
+	switch r := receiver.(type) {
+	case nil:
+	case *parser.ConstantReadNode:
+		name = r.Name
+	case *parser.ConstantPathNode:
+		if r.Parent != nil || r.Name == nil {
+			return false
+		}
+		name = *r.Name
+	default:
+		return false
+	}
+	for _, candidate := range s.receivers {
+		if candidate == name {
+			return true
+		}
+	}
+	return false
+}
+
+// relation reports whether a receiver can be a model or relation: none or
+// self, as within a model, a constant, or a chain of calls starting from
+// one, as in User.active or posts.recent. Local and instance variables
+// are not, as they hold arrays and hashes as often.
+func relation(receiver parser.Node) bool {
+	switch r := receiver.(type) {
+	case nil, *parser.SelfNode, *parser.ConstantReadNode, *parser.ConstantPathNode:
+		return true
+	case *parser.CallNode:
+		return relation(r.Receiver)
+	}
+	return false
+}
+
+// origin returns the trace of a source.
+func (a *analyzer) origin(node parser.Node) *trace {
+	return &trace{step: a.step(node, "source")}
+}
+
+func (a *analyzer) step(node parser.Node, note string) Step {
+	location := node.GetLocation()
+	line, _ := a.source.Line(location.StartOffset)
+	return Step{Text: a.text(node), Note: note, Location: location, Line: line}
+}
+
+// text returns the first line of the source of a node, shortened.
+func (a *analyzer) text(node parser.Node) string {
+	text := string(a.source.Slice(node.GetLocation()))
+	if line, _, more := strings.Cut(text, "\n"); more {
+		text = line + " ..."
+	}
+	if utf8.RuneCountInString(text) > 60 {
+		text = string([]rune(text)[:57]) + "..."
+	}
+	return text
+}
+
+// blockParameters returns the required parameters of a block.
+func blockParameters(block *parser.BlockNode) []parser.Node {
+	parameters, ok := block.Parameters.(*parser.BlockParametersNode)
+	if !ok || parameters.Parameters == nil {
+		return nil
+	}
+	return parameters.Parameters.Requireds
+}
+
+func first(traces ...*trace) *trace {
+	for _, t := range traces {
+		if t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// walk calls visit for a node and its descendants, parents first, with
+// the class or module each is in.
+func walk(node, class parser.Node, visit func(node, class parser.Node)) {
+	visit(node, class)
+	switch node.(type) {
+	case *parser.ClassNode, *parser.ModuleNode, *parser.SingletonClassNode:
+		class = node
+	}
+	for _, child := range node.CompactChildNodes() {
+		walk(child, class, visit)
+	}
+}
@@ -0,0 +1,116 @@
+package taint
+
+// sources are the methods, called without a receiver or arguments, that
+// return values from the request.
+var sources = map[string]bool{
+	"params":  true,
+	"cookies": true,
+	"request": true,
+}
+
+// sanitizers are the methods whose results are not tainted even when
+// called on a tainted value.
+var sanitizers = map[string]bool{}
+
+func init() {
+	for _, name := range []string{
+		"to_i", "to_f", "to_r", "to_c", "to_d", "to_bool",
+		"size", "length", "count", "bytesize", "hash", "object_id", "class",
+		"nil?", "present?", "blank?", "empty?", "any?", "none?", "zero?", "positive?", "negative?",
+		"==", "!=", "===", "=~", "!~", "<", "<=", ">", ">=", "<=>", "!",
+		"is_a?", "kind_of?", "instance_of?", "respond_to?", "include?", "key?", "has_key?",
+		"start_with?", "end_with?", "match?", "eql?", "equal?",
+		"shellescape", "quote", "sanitize_sql", "sanitize_sql_like",
+	} {
+		sanitizers[name] = true
+	}
+}
+
+// concatenating are the methods that build a string from their receiver
+// and arguments, so a tainted argument taints the result.
+var concatenating = map[string]bool{
+	"+": true, "<<": true, "concat": true, "%": true, "format": true, "sprintf": true,
+}
+
+// iterating are the methods whose blocks are yielded the elements of
+// their receivers.
+var iterating = map[string]bool{
+	"each": true, "each_pair": true, "each_value": true, "each_with_index": true,
+	"each_with_object": true, "map": true, "flat_map": true, "collect": true,
+	"select": true, "filter": true, "reject": true, "find": true, "detect": true,
+	"filter_map": true, "transform_values": true,
+}
+
+// sink is a dangerous method.
+type sink struct {
+	kind Kind
+	// receivers are the constants the method is dangerous on, with "" for
+	// a call without a receiver. Any receiver will do when there are none.
+	receivers []string
+	// receiver reports whether the receiver, rather than the first
+	// argument, is the dangerous value.
+	receiver bool
+	// relation reports whether the method is only dangerous called on a
+	// model or relation, as the query methods share their names with
+	// methods of arrays, hashes and other objects.
+	relation bool
+}
+
+var (
+	kernel = []string{"", "Kernel"}
+	sinks  = map[string]sink{
+		"eval":          {kind: CodeInjection, receivers: kernel},
+		"instance_eval": {kind: CodeInjection},
+		"class_eval":    {kind: CodeInjection},
+		"module_eval":   {kind: CodeInjection},
+
+		"system":    {kind: CommandInjection, receivers: kernel},
+		"exec":      {kind: CommandInjection, receivers: kernel},
+		"spawn":     {kind: CommandInjection, receivers: []string{"", "Kernel", "Process"}},
+		"popen":     {kind: CommandInjection, receivers: []string{"IO", "Open3"}},
+		"popen2":    {kind: CommandInjection, receivers: []string{"Open3"}},
+		"popen2e":   {kind: CommandInjection, receivers: []string{"Open3"}},
+		"popen3":    {kind: CommandInjection, receivers: []string{"Open3"}},
+		"capture2":  {kind: CommandInjection, receivers: []string{"Open3"}},
+		"capture2e": {kind: CommandInjection, receivers: []string{"Open3"}},
+		"capture3":  {kind: CommandInjection, receivers: []string{"Open3"}},
+		"pipeline":  {kind: CommandInjection, receivers: []string{"Open3"}},
+
+		// A method or constant name from a request is dangerous whatever it
+		// is looked up on.
+		"send":             {kind: UnsafeReflection},
+		"public_send":      {kind: UnsafeReflection},
+		"__send__":         {kind: UnsafeReflection},
+		"try":              {kind: UnsafeReflection},
+		"const_get":        {kind: UnsafeReflection},
+		"constantize":      {kind: UnsafeReflection, receiver: true},
+		"safe_constantize": {kind: UnsafeReflection, receiver: true},
+
+		"where":         {kind: SQLInjection, relation: true},
+		"not":           {kind: SQLInjection, relation: true},
+		"rewhere":       {kind: SQLInjection, relation: true},
+		"order":         {kind: SQLInjection, relation: true},
+		"reorder":       {kind: SQLInjection, relation: true},
+		"group":         {kind: SQLInjection, relation: true},
+		"having":        {kind: SQLInjection, relation: true},
+		"joins":         {kind: SQLInjection, relation: true},
+		"select":        {kind: SQLInjection, relation: true},
+		"pluck":         {kind: SQLInjection, relation: true},
+		"from":          {kind: SQLInjection, relation: true},
+		"lock":          {kind: SQLInjection, relation: true},
+		"exists?":       {kind: SQLInjection, relation: true},
+		"find_by":       {kind: SQLInjection, relation: true},
+		"find_by_sql":   {kind: SQLInjection, relation: true},
+		"count_by_sql":  {kind: SQLInjection, relation: true},
+		"update_all":    {kind: SQLInjection, relation: true},
+		"delete_all":    {kind: SQLInjection, relation: true},
+		"destroy_all":   {kind: SQLInjection, relation: true},
+		"execute":       {kind: SQLInjection, relation: true},
+		"exec_query":    {kind: SQLInjection, relation: true},
+		"select_all":    {kind: SQLInjection, relation: true},
+		"select_one":    {kind: SQLInjection, relation: true},
+		"select_value":  {kind: SQLInjection, relation: true},
+		"select_values": {kind: SQLInjection, relation: true},
+		"select_rows":   {kind: SQLInjection, relation: true},
+	}
+)
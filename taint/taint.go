@@ -0,0 +1,108 @@
+// Package taint finds where values from requests reach methods that are
+// dangerous to call with them, such as eval and system, in Ruby programs
+// and Rails applications in particular.
+//
+// The values of params, cookies, request and ENV are tainted, and so are
+// the values computed from them: the result of a method called on a
+// tainted value, strings interpolated with or concatenated to one, and the
+// local variables, instance variables and block parameters they are
+// assigned to, as well as the return value of a method of the same class
+// whose last expression is tainted. Conversions such as to_i and
+// predicates such as present? give untainted values. A tainted value
+// passed to a sink is a finding:
+//
+//	code injection      eval, instance_eval, class_eval, module_eval
+//	command injection   system, exec, spawn, backticks, IO.popen, Open3
+//	unsafe reflection   send, public_send, try, const_get, constantize
+//	SQL injection       where, order, find_by_sql and the other query methods
+//
+// The query methods are sinks when called on a model or relation: without
+// a receiver, on a constant, or on a chain of calls starting from one.
+//
+// Each finding has the data-flow path of the value, from the source to the
+// sink:
+//
+//	for _, finding := range taint.Analyze(file, result) {
+//		fmt.Println(finding)
+//	}
+//
+// The analysis is flow-insensitive and does not follow values across
+// classes or through arguments, so it can both miss and overreport.
+package taint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the kind of vulnerability of a finding.
+type Kind int
+
+const (
+	// SQLInjection is a tainted SQL fragment given to a query method.
+	SQLInjection Kind = iota
+	// CommandInjection is a tainted shell command.
+	CommandInjection
+	// CodeInjection is tainted Ruby code evaluated.
+	CodeInjection
+	// UnsafeReflection is a tainted method or constant name.
+	UnsafeReflection
+)
+
+var kindNames = enum.Names[Kind]{
+	SQLInjection:     "SQL injection",
+	CommandInjection: "command injection",
+	CodeInjection:    "code injection",
+	UnsafeReflection: "unsafe reflection",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// MarshalText encodes the kind as its name, such as SQL injection.
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Step is a node a tainted value flows through.
+type Step struct {
+	// Text is the first line of the source of the node, shortened.
+	Text string `json:"text"`
+	// Note says what happens to the value, such as "assigned to name".
+	Note     string          `json:"note"`
+	Location parser.Location `json:"location"`
+	Line     int             `json:"line"`
+}
+
+// Finding is a tainted value that reaches a sink.
+type Finding struct {
+	Kind Kind `json:"kind"`
+	// Sink is the name of the method called, or ` for a command in
+	// backticks.
+	Sink     string          `json:"sink"`
+	File     string          `json:"file"`
+	Location parser.Location `json:"location"`
+	Line     int             `json:"line"`
+	// Path is the flow of the value, from its source to the sink.
+	Path []Step `json:"path"`
+}
+
+// String formats the finding and its path, one step per line:
+//
+//	app/controllers/users_controller.rb:7: SQL injection in where
+//	  5: params[:name] (source)
+//	  5: name = params[:name] (assigned to name)
+//	  7: "name = '#{name}'" (interpolated into a string)
+//	  7: User.where("name = '#{name}'") (reaches where)
+func (f *Finding) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%d: %s in %s", f.File, f.Line, f.Kind, f.Sink)
+	for _, step := range f.Path {
+		fmt.Fprintf(&b, "\n  %d: %s (%s)", step.Line, step.Text, step.Note)
+	}
+	return b.String()
+}
@@ -0,0 +1,196 @@
+package taint_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/taint"
+)
+
+// describe renders the findings of a source as line: kind in sink, one per
+// line.
+func describe(t *testing.T, source string) string {
+	t.Helper()
+	var lines []string
+	for _, finding := range taint.Analyze("a.rb", parsetest.Parse(t, source)) {
+		lines = append(lines, fmt.Sprintf("%d: %s in %s", finding.Line, finding.Kind, finding.Sink))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestSources(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"eval(params[:code])", "1: code injection in eval"},
+		{"eval(cookies[:code])", "1: code injection in eval"},
+		{"eval(request.body.read)", "1: code injection in eval"},
+		{"system(ENV['CMD'])", "1: command injection in system"},
+		{"system(::ENV.fetch('CMD'))", "1: command injection in system"},
+		{"eval(params)", "1: code injection in eval"},
+		{"eval(code)", ""},
+		{"eval(self.params[:code])", ""},
+		{"eval(params(1))", ""},
+		{"eval('1 + 1')", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := describe(t, test.source); got != test.want {
+				t.Errorf("findings =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSanitizers(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"User.where(params[:id].to_i)", ""},
+		{"User.where(params[:name].present?)", ""},
+		{"system(params[:file].shellescape)", ""},
+		{"User.where(params[:name].strip)", "1: SQL injection in where"},
+		{"id = params[:id].to_i\nUser.where(\"id = #{id}\")", ""},
+		{"User.where(\"id = #{params[:id].size}\")", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := describe(t, test.source); got != test.want {
+				t.Errorf("findings =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSinks(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"Kernel.eval(params[:code])", "1: code injection in eval"},
+		{"Foo.eval(params[:code])", ""},
+		{"obj.instance_eval(params[:code])", "1: code injection in instance_eval"},
+		{"`ls #{params[:dir]}`", "1: command injection in `"},
+		{"exec(params[:cmd])", "1: command injection in exec"},
+		{"Process.spawn(params[:cmd])", "1: command injection in spawn"},
+		{"IO.popen(params[:cmd])", "1: command injection in popen"},
+		{"Open3.capture3(params[:cmd])", "1: command injection in capture3"},
+		{"File.popen(params[:cmd])", ""},
+		{"obj.send(params[:method])", "1: unsafe reflection in send"},
+		{"params[:class].constantize", "1: unsafe reflection in constantize"},
+		{"'User'.constantize(params[:x])", ""},
+		{"User.where(\"name = '#{params[:name]}'\")", "1: SQL injection in where"},
+		{"User.where(['name = ?', params[:name]])", ""},
+		{"User.where([\"name = '#{params[:name]}'\"])", "1: SQL injection in where"},
+		{"User.where(name: params[:name])", ""},
+		{"User.order(params[:sort] + ' desc')", "1: SQL injection in order"},
+		{"User.find_by_sql('select * from users where id = ' + params[:id])", "1: SQL injection in find_by_sql"},
+		{"User.where(format('id = %s', params[:id]))", "1: SQL injection in where"},
+		{"User.active.order(params[:sort])", "1: SQL injection in order"},
+		{"Admin::User.select(params[:columns])", "1: SQL injection in select"},
+		{"class User\n  def self.named\n    where(params[:sql])\n  end\nend", "3: SQL injection in where"},
+		{"ActiveRecord::Base.connection.execute(params[:sql])", "1: SQL injection in execute"},
+		{"list.send(params[:method])", "1: unsafe reflection in send"},
+		{"user.try(params[:method])", "1: unsafe reflection in try"},
+		// The query methods share their names with methods of arrays, hashes
+		// and other objects, which are not sinks.
+		{"list = []\nlist.select(params[:x])", ""},
+		{"[1, 2].select(params[:x])", ""},
+		{"hash = {}\nhash.group(params[:x])", ""},
+		{"@rows.from(params[:x])", ""},
+		{"@scope.not(params[:x])", ""},
+		{"params[:ids].select { |id| id }", ""},
+		{"file = File.new('x')\nfile.lock(params[:mode])", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := describe(t, test.source); got != test.want {
+				t.Errorf("findings =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFlow(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{"local", "name = params[:name]\nother = name\nUser.where(other)", "3: SQL injection in where"},
+		{"local reassigned", "name = 'x'\nUser.where(name)", ""},
+		{"operator write", "sql = 'a'\nsql += params[:b]\nUser.where(sql)", "3: SQL injection in where"},
+		{"append", "sql = 'id = '\nsql << params[:id]\nUser.where(sql)", "3: SQL injection in where"},
+		{"multiple assignment", "a, b = params[:a], 1\nsystem(b)\nsystem(a)", "2: command injection in system\n3: command injection in system"},
+		{"block parameter", "params[:ids].each { |id| User.where(id) }", "1: SQL injection in where"},
+		{"block parameter not iterating", "params[:ids].tap { |id| User.where(id) }", ""},
+		{"for", "for cmd in params[:cmds]\n  system(cmd)\nend", "2: command injection in system"},
+		{"conditional", "sql = a ? params[:a] : 'b'\nUser.where(sql)", "2: SQL injection in where"},
+		{"or", "sql = params[:a] || 'b'\nUser.where(sql)", "2: SQL injection in where"},
+		{
+			"ivar",
+			"class UsersController\n  def index\n    User.where(@sql)\n  end\n\n  def load\n    @sql = \"name = #{params[:name]}\"\n  end\nend",
+			"3: SQL injection in where",
+		},
+		{
+			"ivar in another class",
+			"class A\n  def load\n    @sql = params[:sql]\n  end\nend\n\nclass B\n  def index\n    User.where(@sql)\n  end\nend",
+			"",
+		},
+		{
+			"method return",
+			"class UsersController\n  def index\n    User.where(filter)\n    User.where(self.filter)\n  end\n\n  def filter\n    \"name = '#{params[:name]}'\"\n  end\nend",
+			"3: SQL injection in where\n4: SQL injection in where",
+		},
+		{
+			"method return through ivar",
+			"class UsersController\n  def index\n    @sql = sql\n    User.where(@sql)\n  end\n\n  def sql\n    name = params[:name]\n    \"name = '#{name}'\"\n  end\nend",
+			"4: SQL injection in where",
+		},
+		{
+			"method with arguments",
+			"class UsersController\n  def index\n    User.where(filter(1))\n  end\n\n  def filter(x)\n    params[:name]\n  end\nend",
+			"",
+		},
+		{
+			"method of another class",
+			"class A\n  def filter\n    params[:name]\n  end\nend\n\nclass B\n  def index\n    User.where(filter)\n  end\nend",
+			"",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := describe(t, test.source); got != test.want {
+				t.Errorf("findings =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	source := "class UsersController\n  def index\n    name = params[:name]\n    User.where(\"name = '#{name}'\")\n  end\nend"
+	findings := taint.Analyze("app/controllers/users_controller.rb", parsetest.Parse(t, source))
+	if len(findings) != 1 {
+		t.Fatalf("%d findings, want 1", len(findings))
+	}
+	want := `app/controllers/users_controller.rb:4: SQL injection in where
+  3: params[:name] (source)
+  3: name = params[:name] (assigned to name)
+  4: "name = '#{name}'" (interpolated into a string)
+  4: User.where("name = '#{name}'") (reaches where)`
+	if got := findings[0].String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+	data, err := json.Marshal(findings[0].Kind)
+	if err != nil || string(data) != `"SQL injection"` {
+		t.Errorf("Marshal() = %s, %v, want \"SQL injection\"", data, err)
+	}
+	if got := taint.Kind(9).String(); got != "taint.Kind(9)" {
+		t.Errorf("String() = %s, want taint.Kind(9)", got)
+	}
+}
@@ -1,5 +1,8 @@
 package node
 
+//go:generate ruby ../prism/templates/template.rb ../../templates/gen_clone.go ../parser/gen_clone.go
 //go:generate ruby ../prism/templates/template.rb ../../templates/gen_deserialize.go ../parser/gen_deserialize.go
+//go:generate ruby ../prism/templates/template.rb ../../templates/gen_inspect.go ../parser/gen_inspect.go
 //go:generate ruby ../prism/templates/template.rb ../../templates/gen_nodes.go ../parser/gen_nodes.go
+//go:generate ruby ../prism/templates/template.rb ../../templates/gen_node_types.go ../parser/gen_node_types.go
 //go:generate ruby ../prism/templates/template.rb ../../templates/gen_visitor.go ../parser/gen_visitor.go
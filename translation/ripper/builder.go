@@ -0,0 +1,567 @@
+package ripper
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// stmts returns a statements list, [[:void_stmt]] when it is empty.
+func (b *builder) stmts(statements *parser.StatementsNode) []any {
+	if statements == nil || len(statements.Body) == 0 {
+		return []any{sexp("void_stmt")}
+	}
+	return b.visitAll(statements.Body)
+}
+
+func (b *builder) visitAll(nodes []parser.Node) []any {
+	children := make([]any, 0, len(nodes))
+	for _, node := range nodes {
+		children = append(children, b.visit(node))
+	}
+	return children
+}
+
+// bodystmt returns the body of a method, class, module or do block, with its
+// rescue, else and ensure clauses.
+func (b *builder) bodystmt(body parser.Node) []any {
+	switch n := body.(type) {
+	case nil:
+		return sexp("bodystmt", b.stmts(nil), nil, nil, nil)
+	case *parser.StatementsNode:
+		return sexp("bodystmt", b.stmts(n), nil, nil, nil)
+	case *parser.BeginNode:
+		if n.BeginKeywordLoc == nil {
+			return b.clauses(n)
+		}
+	}
+	return sexp("bodystmt", b.visit(body), nil, nil, nil)
+}
+
+func (b *builder) clauses(n *parser.BeginNode) []any {
+	var rescue, elseClause, ensure any
+	if n.RescueClause != nil {
+		rescue = b.rescue(n.RescueClause)
+	}
+	if n.ElseClause != nil {
+		elseClause = sexp("else", b.stmts(n.ElseClause.Statements))
+	}
+	if n.EnsureClause != nil {
+		ensure = sexp("ensure", b.stmts(n.EnsureClause.Statements))
+	}
+	return sexp("bodystmt", b.stmts(n.Statements), rescue, elseClause, ensure)
+}
+
+func (b *builder) rescue(n *parser.RescueNode) []any {
+	var exceptions, reference, subsequent any
+	if len(n.Exceptions) > 0 {
+		exceptions = b.args(n.Exceptions)
+	}
+	if n.Reference != nil {
+		reference = b.visit(n.Reference)
+	}
+	if n.Subsequent != nil {
+		subsequent = b.rescue(n.Subsequent)
+	}
+	return sexp("rescue", exceptions, reference, b.stmts(n.Statements), subsequent)
+}
+
+// single returns the only statement of a modifier or ternary branch.
+func (b *builder) single(statements *parser.StatementsNode) any {
+	if statements == nil || len(statements.Body) == 0 {
+		return nil
+	}
+	return b.visit(statements.Body[0])
+}
+
+func (b *builder) visit(node parser.Node) any {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *parser.ProgramNode:
+		return sexp("program", b.stmts(n.Statements))
+	case *parser.StatementsNode:
+		return b.stmts(n)
+	case *parser.ParenthesesNode:
+		switch body := n.Body.(type) {
+		case nil:
+			return sexp("paren", false)
+		case *parser.StatementsNode:
+			return sexp("paren", b.stmts(body))
+		default:
+			return sexp("paren", []any{b.visit(body)})
+		}
+	case *parser.BeginNode:
+		if n.BeginKeywordLoc == nil {
+			return b.clauses(n)
+		}
+		return sexp("begin", b.clauses(n))
+	case *parser.PreExecutionNode:
+		return sexp("BEGIN", b.stmts(n.Statements))
+	case *parser.PostExecutionNode:
+		return sexp("END", b.stmts(n.Statements))
+
+	// Literals
+
+	case *parser.NilNode, *parser.TrueNode, *parser.FalseNode, *parser.SelfNode,
+		*parser.SourceFileNode, *parser.SourceLineNode, *parser.SourceEncodingNode:
+		return sexp("var_ref", b.token("kw", node.GetLocation()))
+	case *parser.IntegerNode:
+		return b.token("int", n.Location)
+	case *parser.FloatNode:
+		return b.token("float", n.Location)
+	case *parser.RationalNode:
+		return b.token("rational", n.Location)
+	case *parser.ImaginaryNode:
+		return b.token("imaginary", n.Location)
+	case *parser.StringNode:
+		return b.string(n)
+	case *parser.InterpolatedStringNode:
+		return b.interpolatedString(n)
+	case *parser.XStringNode:
+		if b.squiggly(&n.OpeningLoc) {
+			return sexp("xstring_literal", b.dedent(n.ContentLoc, b.indentation(n.ContentLoc)))
+		}
+		return sexp("xstring_literal", b.content(n.ContentLoc))
+	case *parser.InterpolatedXStringNode:
+		if b.squiggly(&n.OpeningLoc) {
+			return sexp("xstring_literal", b.heredoc(n.Parts, &n.ClosingLoc))
+		}
+		return sexp("xstring_literal", b.parts(n.Parts))
+	case *parser.SymbolNode:
+		return b.symbol(n)
+	case *parser.InterpolatedSymbolNode:
+		return sexp("dyna_symbol", append([]any{Symbol("string_content")}, b.parts(n.Parts)...))
+	case *parser.RegularExpressionNode:
+		return sexp("regexp_literal", b.content(n.ContentLoc), b.token("regexp_end", n.ClosingLoc))
+	case *parser.InterpolatedRegularExpressionNode:
+		return sexp("regexp_literal", b.parts(n.Parts), b.token("regexp_end", n.ClosingLoc))
+	case *parser.MatchLastLineNode:
+		return sexp("regexp_literal", b.content(n.ContentLoc), b.token("regexp_end", n.ClosingLoc))
+	case *parser.InterpolatedMatchLastLineNode:
+		return sexp("regexp_literal", b.parts(n.Parts), b.token("regexp_end", n.ClosingLoc))
+	case *parser.EmbeddedStatementsNode:
+		return sexp("string_embexpr", b.stmts(n.Statements))
+	case *parser.EmbeddedVariableNode:
+		return sexp("string_dvar", b.visit(n.Variable))
+	case *parser.ArrayNode:
+		return b.array(n)
+	case *parser.HashNode:
+		if len(n.Elements) == 0 {
+			return sexp("hash", nil)
+		}
+		return sexp("hash", sexp("assoclist_from_args", b.visitAll(n.Elements)))
+	case *parser.KeywordHashNode:
+		return sexp("bare_assoc_hash", b.visitAll(n.Elements))
+	case *parser.AssocNode:
+		return sexp("assoc_new", b.key(n.Key), b.visit(n.Value))
+	case *parser.AssocSplatNode:
+		return sexp("assoc_splat", b.visit(n.Value))
+	case *parser.ImplicitNode:
+		return nil
+	case *parser.RangeNode:
+		if n.IsEXCLUDE_END() {
+			return sexp("dot3", b.visit(n.Left), b.visit(n.Right))
+		}
+		return sexp("dot2", b.visit(n.Left), b.visit(n.Right))
+	case *parser.FlipFlopNode:
+		if n.IsEXCLUDE_END() {
+			return sexp("dot3", b.visit(n.Left), b.visit(n.Right))
+		}
+		return sexp("dot2", b.visit(n.Left), b.visit(n.Right))
+
+	// Variables
+
+	case *parser.LocalVariableReadNode, *parser.ItLocalVariableReadNode, *parser.InstanceVariableReadNode,
+		*parser.ClassVariableReadNode, *parser.GlobalVariableReadNode, *parser.ConstantReadNode:
+		return sexp("var_ref", b.name(node.GetLocation()))
+	case *parser.BackReferenceReadNode:
+		return sexp("var_ref", b.token("backref", n.Location))
+	case *parser.NumberedReferenceReadNode:
+		return sexp("var_ref", b.token("backref", n.Location))
+	case *parser.LocalVariableWriteNode:
+		return sexp("assign", sexp("var_field", b.name(n.NameLoc)), b.visit(n.Value))
+	case *parser.InstanceVariableWriteNode:
+		return sexp("assign", sexp("var_field", b.name(n.NameLoc)), b.visit(n.Value))
+	case *parser.ClassVariableWriteNode:
+		return sexp("assign", sexp("var_field", b.name(n.NameLoc)), b.visit(n.Value))
+	case *parser.GlobalVariableWriteNode:
+		return sexp("assign", sexp("var_field", b.name(n.NameLoc)), b.visit(n.Value))
+	case *parser.ConstantWriteNode:
+		return sexp("assign", sexp("var_field", b.name(n.NameLoc)), b.visit(n.Value))
+	case *parser.LocalVariableTargetNode, *parser.InstanceVariableTargetNode, *parser.ClassVariableTargetNode,
+		*parser.GlobalVariableTargetNode, *parser.ConstantTargetNode:
+		return sexp("var_field", b.name(node.GetLocation()))
+	case *parser.LocalVariableOperatorWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.LocalVariableAndWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.LocalVariableOrWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.InstanceVariableOperatorWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.InstanceVariableAndWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.InstanceVariableOrWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.ClassVariableOperatorWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.ClassVariableAndWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.ClassVariableOrWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.GlobalVariableOperatorWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.GlobalVariableAndWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.GlobalVariableOrWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.ConstantOperatorWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.ConstantAndWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.ConstantOrWriteNode:
+		return sexp("opassign", sexp("var_field", b.name(n.NameLoc)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+
+	// Constants
+
+	case *parser.ConstantPathNode:
+		if n.Parent == nil {
+			return sexp("top_const_ref", b.token("const", n.NameLoc))
+		}
+		return sexp("const_path_ref", b.visit(n.Parent), b.token("const", n.NameLoc))
+	case *parser.ConstantPathTargetNode:
+		return b.constantPathField(n.Parent, n.NameLoc)
+	case *parser.ConstantPathWriteNode:
+		return sexp("assign", b.constantPathField(n.Target.Parent, n.Target.NameLoc), b.visit(n.Value))
+	case *parser.ConstantPathOperatorWriteNode:
+		return sexp("opassign", b.constantPathField(n.Target.Parent, n.Target.NameLoc), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.ConstantPathAndWriteNode:
+		return sexp("opassign", b.constantPathField(n.Target.Parent, n.Target.NameLoc), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.ConstantPathOrWriteNode:
+		return sexp("opassign", b.constantPathField(n.Target.Parent, n.Target.NameLoc), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.ShareableConstantNode:
+		return b.visit(n.Write)
+
+	// Calls
+
+	case *parser.CallNode:
+		return b.call(n)
+	case *parser.CallOperatorWriteNode:
+		return sexp("opassign", b.field(n.Receiver, n.CallOperatorLoc, n.MessageLoc), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.CallAndWriteNode:
+		return sexp("opassign", b.field(n.Receiver, n.CallOperatorLoc, n.MessageLoc), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.CallOrWriteNode:
+		return sexp("opassign", b.field(n.Receiver, n.CallOperatorLoc, n.MessageLoc), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.CallTargetNode:
+		return b.field(n.Receiver, &n.CallOperatorLoc, &n.MessageLoc)
+	case *parser.IndexOperatorWriteNode:
+		return sexp("opassign", sexp("aref_field", b.visit(n.Receiver), b.argsAddBlock(n.Arguments, n.Block)), b.token("op", n.BinaryOperatorLoc), b.visit(n.Value))
+	case *parser.IndexAndWriteNode:
+		return sexp("opassign", sexp("aref_field", b.visit(n.Receiver), b.argsAddBlock(n.Arguments, n.Block)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.IndexOrWriteNode:
+		return sexp("opassign", sexp("aref_field", b.visit(n.Receiver), b.argsAddBlock(n.Arguments, n.Block)), b.token("op", n.OperatorLoc), b.visit(n.Value))
+	case *parser.IndexTargetNode:
+		return sexp("aref_field", b.visit(n.Receiver), b.argsAddBlock(n.Arguments, n.Block))
+	case *parser.SuperNode:
+		var node []any
+		switch {
+		case n.LparenLoc != nil && n.Arguments == nil && !isBlockArgument(n.Block):
+			node = sexp("super", sexp("arg_paren", nil))
+		case n.LparenLoc != nil:
+			node = sexp("super", sexp("arg_paren", b.argsAddBlock(n.Arguments, n.Block)))
+		default:
+			node = sexp("super", b.argsAddBlock(n.Arguments, n.Block))
+		}
+		return b.withBlock(node, n.Block)
+	case *parser.ForwardingSuperNode:
+		if n.Block != nil {
+			return sexp("method_add_block", sexp("zsuper"), b.block(n.Block))
+		}
+		return sexp("zsuper")
+	case *parser.YieldNode:
+		switch {
+		case n.Arguments == nil:
+			return sexp("yield0")
+		case n.LparenLoc != nil:
+			return sexp("yield", sexp("paren", b.argsAddBlock(n.Arguments, nil)))
+		default:
+			return sexp("yield", b.argsAddBlock(n.Arguments, nil))
+		}
+	case *parser.BlockArgumentNode:
+		return b.visit(n.Expression)
+	case *parser.SplatNode:
+		return b.visit(n.Expression)
+	case *parser.ForwardingArgumentsNode:
+		return sexp("args_forward")
+	case *parser.LambdaNode:
+		return b.lambda(n)
+	case *parser.MatchWriteNode:
+		return b.visit(n.Call)
+	case *parser.DefinedNode:
+		return sexp("defined", b.visit(n.Value))
+
+	// Assignments
+
+	case *parser.MultiWriteNode:
+		return sexp("massign", b.mlhs(n.Lefts, n.Rest, n.Rights), b.mrhs(n.Value))
+	case *parser.MultiTargetNode:
+		return append([]any{Symbol("mlhs")}, b.mlhs(n.Lefts, n.Rest, n.Rights)...)
+
+	// Control flow
+
+	case *parser.AndNode:
+		return sexp("binary", b.visit(n.Left), Symbol(b.slice(n.OperatorLoc)), b.visit(n.Right))
+	case *parser.OrNode:
+		return sexp("binary", b.visit(n.Left), Symbol(b.slice(n.OperatorLoc)), b.visit(n.Right))
+	case *parser.IfNode:
+		return b.ifNode(n)
+	case *parser.UnlessNode:
+		if n.EndKeywordLoc == nil {
+			return sexp("unless_mod", b.visit(n.Predicate), b.single(n.Statements))
+		}
+		var alternative any
+		if n.ElseClause != nil {
+			alternative = sexp("else", b.stmts(n.ElseClause.Statements))
+		}
+		return sexp("unless", b.visit(n.Predicate), b.stmts(n.Statements), alternative)
+	case *parser.WhileNode:
+		if n.ClosingLoc == nil {
+			return sexp("while_mod", b.visit(n.Predicate), b.single(n.Statements))
+		}
+		return sexp("while", b.visit(n.Predicate), b.stmts(n.Statements))
+	case *parser.UntilNode:
+		if n.ClosingLoc == nil {
+			return sexp("until_mod", b.visit(n.Predicate), b.single(n.Statements))
+		}
+		return sexp("until", b.visit(n.Predicate), b.stmts(n.Statements))
+	case *parser.ForNode:
+		return sexp("for", b.visit(n.Index), b.visit(n.Collection), b.stmts(n.Statements))
+	case *parser.CaseNode:
+		return sexp("case", b.visit(n.Predicate), b.clauseChain(n.Conditions, n.ElseClause))
+	case *parser.CaseMatchNode:
+		return sexp("case", b.visit(n.Predicate), b.clauseChain(n.Conditions, n.ElseClause))
+	case *parser.MatchPredicateNode:
+		return sexp("case", b.visit(n.Value), sexp("in", b.pattern(n.Pattern), nil, nil))
+	case *parser.MatchRequiredNode:
+		return sexp("case", b.visit(n.Value), sexp("in", b.pattern(n.Pattern), nil, nil))
+	case *parser.BreakNode:
+		if n.Arguments == nil {
+			return sexp("break", []any{})
+		}
+		return sexp("break", b.argsAddBlock(n.Arguments, nil))
+	case *parser.NextNode:
+		if n.Arguments == nil {
+			return sexp("next", []any{})
+		}
+		return sexp("next", b.argsAddBlock(n.Arguments, nil))
+	case *parser.ReturnNode:
+		if n.Arguments == nil {
+			return sexp("return0")
+		}
+		return sexp("return", b.argsAddBlock(n.Arguments, nil))
+	case *parser.RedoNode:
+		return sexp("redo")
+	case *parser.RetryNode:
+		return sexp("retry")
+	case *parser.RescueModifierNode:
+		return sexp("rescue_mod", b.visit(n.Expression), b.visit(n.RescueExpression))
+
+	// Definitions
+
+	case *parser.DefNode:
+		return b.def(n)
+	case *parser.ClassNode:
+		return sexp("class", b.constantRef(n.ConstantPath), b.visit(n.Superclass), b.bodystmt(n.Body))
+	case *parser.SingletonClassNode:
+		return sexp("sclass", b.visit(n.Expression), b.bodystmt(n.Body))
+	case *parser.ModuleNode:
+		return sexp("module", b.constantRef(n.ConstantPath), b.bodystmt(n.Body))
+	case *parser.AliasMethodNode:
+		return sexp("alias", b.methodName(n.NewName), b.methodName(n.OldName))
+	case *parser.AliasGlobalVariableNode:
+		return sexp("var_alias", b.token("gvar", n.NewName.GetLocation()), b.token("gvar", n.OldName.GetLocation()))
+	case *parser.UndefNode:
+		names := make([]any, 0, len(n.Names))
+		for _, name := range n.Names {
+			names = append(names, b.methodName(name))
+		}
+		return sexp("undef", names)
+	}
+
+	return nil
+}
+
+func (b *builder) ifNode(n *parser.IfNode) any {
+	if n.IfKeywordLoc == nil {
+		var alternative any
+		if elseNode, ok := n.Subsequent.(*parser.ElseNode); ok {
+			alternative = b.single(elseNode.Statements)
+		}
+		return sexp("ifop", b.visit(n.Predicate), b.single(n.Statements), alternative)
+	}
+	keyword := b.slice(*n.IfKeywordLoc)
+	if keyword == "if" && n.EndKeywordLoc == nil {
+		return sexp("if_mod", b.visit(n.Predicate), b.single(n.Statements))
+	}
+	var alternative any
+	switch subsequent := n.Subsequent.(type) {
+	case *parser.ElseNode:
+		alternative = sexp("else", b.stmts(subsequent.Statements))
+	case *parser.IfNode:
+		alternative = b.ifNode(subsequent)
+	}
+	return sexp(keyword, b.visit(n.Predicate), b.stmts(n.Statements), alternative)
+}
+
+// clauseChain links when and in clauses through their last element, ending
+// with the else clause.
+func (b *builder) clauseChain(conditions []parser.Node, elseClause *parser.ElseNode) any {
+	var chain any
+	if elseClause != nil {
+		chain = sexp("else", b.stmts(elseClause.Statements))
+	}
+	for index := len(conditions) - 1; index >= 0; index-- {
+		switch n := conditions[index].(type) {
+		case *parser.WhenNode:
+			chain = sexp("when", b.args(n.Conditions), b.stmts(n.Statements), chain)
+		case *parser.InNode:
+			chain = sexp("in", b.inPattern(n.Pattern), b.stmts(n.Statements), chain)
+		}
+	}
+	return chain
+}
+
+func (b *builder) def(n *parser.DefNode) any {
+	var parameters any
+	switch {
+	case n.LparenLoc != nil:
+		parameters = sexp("paren", b.params(n.Parameters))
+	case n.Parameters != nil || n.EqualLoc == nil:
+		parameters = b.params(n.Parameters)
+	}
+	// The body of an endless method is a single expression, not a list of
+	// statements.
+	body := n.Body
+	if statements, ok := body.(*parser.StatementsNode); ok && n.EqualLoc != nil && len(statements.Body) == 1 {
+		body = statements.Body[0]
+	}
+	name := b.name(n.NameLoc)
+	if n.Receiver == nil {
+		return sexp("def", name, parameters, b.bodystmt(body))
+	}
+	return sexp("defs", b.visit(n.Receiver), b.token("period", *n.OperatorLoc), name, parameters, b.bodystmt(body))
+}
+
+// constantRef returns the name of a class or module definition.
+func (b *builder) constantRef(node parser.Node) any {
+	switch n := node.(type) {
+	case *parser.ConstantReadNode:
+		return sexp("const_ref", b.token("const", n.Location))
+	case *parser.ConstantPathNode:
+		if n.Parent == nil {
+			return sexp("top_const_ref", b.token("const", n.NameLoc))
+		}
+		return sexp("const_path_ref", b.visit(n.Parent), b.token("const", n.NameLoc))
+	}
+	return b.visit(node)
+}
+
+func (b *builder) constantPathField(parent parser.Node, name parser.Location) any {
+	if parent == nil {
+		return sexp("top_const_field", b.token("const", name))
+	}
+	return sexp("const_path_field", b.visit(parent), b.token("const", name))
+}
+
+// methodName returns a name of alias and undef, which Ripper reports as a
+// symbol_literal holding the bare name.
+func (b *builder) methodName(node parser.Node) any {
+	if symbol, ok := node.(*parser.SymbolNode); ok && symbol.OpeningLoc == nil && symbol.ValueLoc != nil {
+		return sexp("symbol_literal", b.name(*symbol.ValueLoc))
+	}
+	return b.visit(node)
+}
+
+// mlhs returns the targets of a multiple assignment.
+func (b *builder) mlhs(lefts []parser.Node, rest parser.Node, rights []parser.Node) []any {
+	children := b.visitAll(lefts)
+	if splat, ok := rest.(*parser.SplatNode); ok {
+		children = append(children, sexp("rest_param", b.visit(splat.Expression)))
+	}
+	return append(children, b.visitAll(rights)...)
+}
+
+// mrhs returns the values of a multiple assignment.
+func (b *builder) mrhs(value parser.Node) any {
+	array, ok := value.(*parser.ArrayNode)
+	if !ok || array.OpeningLoc != nil || len(array.Elements) == 0 {
+		return b.visit(value)
+	}
+	for _, element := range array.Elements {
+		if _, ok := element.(*parser.SplatNode); ok {
+			var list any = []any{}
+			for _, element := range array.Elements {
+				if splat, ok := element.(*parser.SplatNode); ok {
+					list = sexp("mrhs_add_star", list, b.visit(splat.Expression))
+					continue
+				}
+				list = append(list.([]any), b.visit(element))
+			}
+			return list
+		}
+	}
+	last := len(array.Elements) - 1
+	return sexp("mrhs_new_from_args", b.visitAll(array.Elements[:last]), b.visit(array.Elements[last]))
+}
+
+func (b *builder) array(n *parser.ArrayNode) any {
+	opening := ""
+	if n.OpeningLoc != nil {
+		opening = b.slice(*n.OpeningLoc)
+	}
+	if strings.HasPrefix(opening, "%") {
+		words := make([]any, 0, len(n.Elements))
+		for _, element := range n.Elements {
+			switch element := element.(type) {
+			case *parser.StringNode:
+				words = append(words, b.wordPart(element.ContentLoc, opening))
+			case *parser.SymbolNode:
+				words = append(words, b.wordPart(*element.ValueLoc, opening))
+			case *parser.InterpolatedStringNode:
+				words = append(words, b.parts(element.Parts))
+			case *parser.InterpolatedSymbolNode:
+				words = append(words, b.parts(element.Parts))
+			}
+		}
+		return sexp("array", words)
+	}
+	if len(n.Elements) == 0 {
+		return sexp("array", nil)
+	}
+	return sexp("array", b.args(n.Elements))
+}
+
+// wordPart returns an element of %w and %i arrays, which the interpolating
+// %W and %I forms wrap in a word list.
+func (b *builder) wordPart(location parser.Location, opening string) any {
+	content := b.token("tstring_content", location)
+	if strings.HasPrefix(opening, "%W") || strings.HasPrefix(opening, "%I") {
+		return []any{content}
+	}
+	return content
+}
+
+// key returns the key of a hash pair, a label for the key: form.
+func (b *builder) key(node parser.Node) any {
+	if symbol, ok := node.(*parser.SymbolNode); ok && symbol.OpeningLoc == nil && symbol.ClosingLoc != nil {
+		return b.token("label", symbol.Location)
+	}
+	return b.visit(node)
+}
+
+func isBlockArgument(node parser.Node) bool {
+	_, ok := node.(*parser.BlockArgumentNode)
+	return ok
+}
@@ -0,0 +1,291 @@
+package ripper
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+var binaryOperators = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "**": true,
+	"==": true, "!=": true, "===": true, "=~": true, "!~": true,
+	"<": true, "<=": true, ">": true, ">=": true, "<=>": true,
+	"<<": true, ">>": true, "&": true, "|": true, "^": true,
+}
+
+var unaryOperators = map[string]bool{"-@": true, "+@": true, "!": true, "~": true}
+
+func (b *builder) call(n *parser.CallNode) any {
+	message := ""
+	if n.MessageLoc != nil {
+		message = b.slice(*n.MessageLoc)
+	}
+	var arguments []parser.Node
+	if n.Arguments != nil {
+		arguments = n.Arguments.Arguments
+	}
+
+	switch {
+	case n.Receiver != nil && n.CallOperatorLoc == nil && binaryOperators[n.Name] && message == n.Name && len(arguments) == 1:
+		return sexp("binary", b.visit(n.Receiver), Symbol(n.Name), b.visit(arguments[0]))
+	case n.Receiver != nil && n.CallOperatorLoc == nil && unaryOperators[n.Name] && len(arguments) == 0:
+		if message == "not" {
+			return sexp("unary", Symbol("not"), b.visit(n.Receiver))
+		}
+		return sexp("unary", Symbol(n.Name), b.visit(n.Receiver))
+	case b.isIndex(n) && n.Name == "[]":
+		var args any
+		if n.Arguments != nil || isBlockArgument(n.Block) {
+			args = b.argsAddBlock(n.Arguments, n.Block)
+		}
+		return b.withBlock(sexp("aref", b.visit(n.Receiver), args), n.Block)
+	case b.isIndex(n) && n.Name == "[]=" && len(arguments) > 0:
+		last := len(arguments) - 1
+		field := sexp("aref_field", b.visit(n.Receiver), sexp("args_add_block", b.args(arguments[:last]), false))
+		return sexp("assign", field, b.visit(arguments[last]))
+	case n.IsATTRIBUTE_WRITE() && n.MessageLoc != nil && len(arguments) == 1:
+		return sexp("assign", b.field(n.Receiver, n.CallOperatorLoc, n.MessageLoc), b.visit(arguments[0]))
+	}
+
+	var node []any
+	if n.Receiver == nil {
+		name := b.name(*n.MessageLoc)
+		switch {
+		case n.IsVARIABLE_CALL():
+			node = sexp("vcall", name)
+		case n.OpeningLoc != nil:
+			node = sexp("method_add_arg", sexp("fcall", name), b.argParen(n))
+		case n.Arguments != nil || isBlockArgument(n.Block):
+			node = sexp("command", name, b.argsAddBlock(n.Arguments, n.Block))
+		default:
+			node = sexp("method_add_arg", sexp("fcall", name), []any{})
+		}
+	} else {
+		receiver := b.visit(n.Receiver)
+		operator := b.callOperator(n.CallOperatorLoc)
+		var name any = Symbol("call")
+		if n.MessageLoc != nil {
+			name = b.name(*n.MessageLoc)
+		}
+		switch {
+		case n.OpeningLoc != nil:
+			node = sexp("method_add_arg", sexp("call", receiver, operator, name), b.argParen(n))
+		case n.Arguments != nil || isBlockArgument(n.Block):
+			node = sexp("command_call", receiver, operator, name, b.argsAddBlock(n.Arguments, n.Block))
+		default:
+			node = sexp("call", receiver, operator, name)
+		}
+	}
+	return b.withBlock(node, n.Block)
+}
+
+// isIndex reports whether the call uses the foo[bar] syntax.
+func (b *builder) isIndex(n *parser.CallNode) bool {
+	return n.CallOperatorLoc == nil && n.OpeningLoc != nil && b.slice(*n.OpeningLoc) == "["
+}
+
+func (b *builder) argParen(n *parser.CallNode) any {
+	if n.Arguments == nil && !isBlockArgument(n.Block) {
+		return sexp("arg_paren", nil)
+	}
+	return sexp("arg_paren", b.argsAddBlock(n.Arguments, n.Block))
+}
+
+// callOperator returns the scanner event of ".", "&." or "::", which Ripper
+// reports as the :"::" symbol.
+func (b *builder) callOperator(location *parser.Location) any {
+	if location == nil {
+		return nil
+	}
+	switch b.slice(*location) {
+	case ".":
+		return b.token("period", *location)
+	case "::":
+		return Symbol("::")
+	}
+	return b.token("op", *location)
+}
+
+// field returns the attribute target of foo.bar = 1 and foo.bar += 1.
+func (b *builder) field(receiver parser.Node, operator, message *parser.Location) any {
+	var name any
+	if message != nil {
+		name = b.name(*message)
+	}
+	return sexp("field", b.visit(receiver), b.callOperator(operator), name)
+}
+
+// argsAddBlock returns call arguments, closed by the block argument or false.
+func (b *builder) argsAddBlock(arguments *parser.ArgumentsNode, block parser.Node) any {
+	var list any = []any{}
+	if arguments != nil {
+		if len(arguments.Arguments) == 1 {
+			if _, ok := arguments.Arguments[0].(*parser.ForwardingArgumentsNode); ok {
+				return sexp("args_forward")
+			}
+		}
+		list = b.args(arguments.Arguments)
+	}
+	if blockArgument, ok := block.(*parser.BlockArgumentNode); ok && blockArgument != nil {
+		return sexp("args_add_block", list, b.visit(blockArgument.Expression))
+	}
+	return sexp("args_add_block", list, false)
+}
+
+// args returns an argument list. Ripper nests the list into an
+// args_add_star event at each splat and keeps appending to it afterwards.
+func (b *builder) args(nodes []parser.Node) any {
+	list := []any{}
+	for _, node := range nodes {
+		if splat, ok := node.(*parser.SplatNode); ok {
+			list = sexp("args_add_star", list, b.visit(splat.Expression))
+			continue
+		}
+		list = append(list, b.visit(node))
+	}
+	return list
+}
+
+func (b *builder) withBlock(node []any, block parser.Node) any {
+	if block, ok := block.(*parser.BlockNode); ok && block != nil {
+		return sexp("method_add_block", node, b.block(block))
+	}
+	return node
+}
+
+func (b *builder) block(n *parser.BlockNode) any {
+	if b.slice(n.OpeningLoc) == "{" {
+		return sexp("brace_block", b.blockVar(n.Parameters), b.braceBody(n.Body))
+	}
+	return sexp("do_block", b.blockVar(n.Parameters), b.bodystmt(n.Body))
+}
+
+func (b *builder) braceBody(body parser.Node) any {
+	if statements, ok := body.(*parser.StatementsNode); ok || body == nil {
+		return b.stmts(statements)
+	}
+	return []any{b.visit(body)}
+}
+
+func (b *builder) blockVar(parameters parser.Node) any {
+	n, ok := parameters.(*parser.BlockParametersNode)
+	if !ok {
+		return nil
+	}
+	var locals any = false
+	if len(n.Locals) > 0 {
+		names := make([]any, 0, len(n.Locals))
+		for _, local := range n.Locals {
+			names = append(names, b.token("ident", local.GetLocation()))
+		}
+		locals = names
+	}
+	return sexp("block_var", b.params(n.Parameters), locals)
+}
+
+func (b *builder) lambda(n *parser.LambdaNode) any {
+	parameters := b.params(nil)
+	if block, ok := n.Parameters.(*parser.BlockParametersNode); ok {
+		parameters = b.params(block.Parameters)
+		if block.OpeningLoc != nil && b.slice(*block.OpeningLoc) == "(" {
+			parameters = sexp("paren", parameters)
+		}
+	}
+	if b.slice(n.OpeningLoc) == "{" {
+		return sexp("lambda", parameters, b.braceBody(n.Body))
+	}
+	return sexp("lambda", parameters, b.bodystmt(n.Body))
+}
+
+// params returns the parameter list of a method, block or lambda:
+// [:params, requireds, optionals, rest, posts, keywords, keyword rest, block].
+func (b *builder) params(n *parser.ParametersNode) []any {
+	if n == nil {
+		return sexp("params", nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	var requireds, optionals, rest, posts, keywords, keywordRest, block any
+	if len(n.Requireds) > 0 {
+		requireds = b.paramList(n.Requireds)
+	}
+	if len(n.Optionals) > 0 {
+		list := make([]any, 0, len(n.Optionals))
+		for _, optional := range n.Optionals {
+			if optional, ok := optional.(*parser.OptionalParameterNode); ok {
+				list = append(list, []any{b.token("ident", optional.NameLoc), b.visit(optional.Value)})
+			}
+		}
+		optionals = list
+	}
+	switch r := n.Rest.(type) {
+	case *parser.RestParameterNode:
+		rest = b.param(r)
+	case *parser.ImplicitRestNode:
+		rest = sexp("excessed_comma")
+	}
+	if len(n.Posts) > 0 {
+		posts = b.paramList(n.Posts)
+	}
+	if len(n.Keywords) > 0 {
+		list := make([]any, 0, len(n.Keywords))
+		for _, keyword := range n.Keywords {
+			switch keyword := keyword.(type) {
+			case *parser.RequiredKeywordParameterNode:
+				list = append(list, []any{b.token("label", keyword.NameLoc), false})
+			case *parser.OptionalKeywordParameterNode:
+				list = append(list, []any{b.token("label", keyword.NameLoc), b.visit(keyword.Value)})
+			}
+		}
+		keywords = list
+	}
+	switch k := n.KeywordRest.(type) {
+	case *parser.KeywordRestParameterNode:
+		if k.NameLoc == nil {
+			keywordRest = sexp("kwrest_param", nil)
+		} else {
+			keywordRest = sexp("kwrest_param", b.token("ident", *k.NameLoc))
+		}
+	case *parser.NoKeywordsParameterNode:
+		keywordRest = Symbol("nil")
+	case *parser.ForwardingParameterNode:
+		rest = sexp("args_forward")
+	}
+	if n.Block != nil {
+		if n.Block.NameLoc == nil {
+			block = sexp("blockarg", nil)
+		} else {
+			block = sexp("blockarg", b.token("ident", *n.Block.NameLoc))
+		}
+	}
+	return sexp("params", requireds, optionals, rest, posts, keywords, keywordRest, block)
+}
+
+func (b *builder) paramList(nodes []parser.Node) []any {
+	list := make([]any, 0, len(nodes))
+	for _, node := range nodes {
+		list = append(list, b.param(node))
+	}
+	return list
+}
+
+func (b *builder) param(node parser.Node) any {
+	switch n := node.(type) {
+	case *parser.RequiredParameterNode:
+		return b.token("ident", n.Location)
+	case *parser.RestParameterNode:
+		if n.NameLoc == nil {
+			return sexp("rest_param", nil)
+		}
+		return sexp("rest_param", b.token("ident", *n.NameLoc))
+	case *parser.SplatNode:
+		return sexp("rest_param", b.param(n.Expression))
+	case *parser.MultiTargetNode:
+		list := []any{Symbol("mlhs")}
+		list = append(list, b.paramList(n.Lefts)...)
+		if n.Rest != nil {
+			if _, ok := n.Rest.(*parser.SplatNode); ok {
+				list = append(list, b.param(n.Rest))
+			}
+		}
+		return append(list, b.paramList(n.Rights)...)
+	}
+	return nil
+}
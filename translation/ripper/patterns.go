@@ -0,0 +1,96 @@
+package ripper
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// inPattern returns the pattern of an in clause, with a trailing if or
+// unless guard as a modifier around it.
+func (b *builder) inPattern(node parser.Node) any {
+	switch n := node.(type) {
+	case *parser.IfNode:
+		if n.Statements != nil && len(n.Statements.Body) == 1 {
+			return sexp("if_mod", b.visit(n.Predicate), b.pattern(n.Statements.Body[0]))
+		}
+	case *parser.UnlessNode:
+		if n.Statements != nil && len(n.Statements.Body) == 1 {
+			return sexp("unless_mod", b.visit(n.Predicate), b.pattern(n.Statements.Body[0]))
+		}
+	}
+	return b.pattern(node)
+}
+
+func (b *builder) pattern(node parser.Node) any {
+	switch n := node.(type) {
+	case *parser.LocalVariableTargetNode:
+		return sexp("var_field", b.token("ident", n.Location))
+	case *parser.ArrayPatternNode:
+		var requireds, rest, posts any
+		if len(n.Requireds) > 0 {
+			requireds = b.patterns(n.Requireds)
+		}
+		if splat, ok := n.Rest.(*parser.SplatNode); ok {
+			rest = b.patternRest(splat.Expression)
+		}
+		if len(n.Posts) > 0 {
+			posts = b.patterns(n.Posts)
+		}
+		return sexp("aryptn", b.visit(n.Constant), requireds, rest, posts)
+	case *parser.FindPatternNode:
+		var right any
+		if splat, ok := n.Right.(*parser.SplatNode); ok {
+			right = b.patternRest(splat.Expression)
+		}
+		return sexp("fndptn", b.visit(n.Constant), b.patternRest(n.Left.Expression), b.patterns(n.Requireds), right)
+	case *parser.HashPatternNode:
+		var pairs, rest any
+		if len(n.Elements) > 0 {
+			list := make([]any, 0, len(n.Elements))
+			for _, element := range n.Elements {
+				assoc, ok := element.(*parser.AssocNode)
+				if !ok {
+					continue
+				}
+				var value any
+				if _, implicit := assoc.Value.(*parser.ImplicitNode); !implicit {
+					value = b.pattern(assoc.Value)
+				}
+				list = append(list, []any{b.key(assoc.Key), value})
+			}
+			pairs = list
+		}
+		switch r := n.Rest.(type) {
+		case *parser.AssocSplatNode:
+			rest = b.patternRest(r.Value)
+		case *parser.NoKeywordsParameterNode:
+			rest = Symbol("nil")
+		}
+		return sexp("hshptn", b.visit(n.Constant), pairs, rest)
+	case *parser.AlternationPatternNode:
+		return sexp("binary", b.pattern(n.Left), Symbol("|"), b.pattern(n.Right))
+	case *parser.CapturePatternNode:
+		return sexp("binary", b.pattern(n.Value), Symbol("=>"), b.pattern(n.Target))
+	case *parser.PinnedVariableNode:
+		return b.visit(n.Variable)
+	case *parser.PinnedExpressionNode:
+		return sexp("begin", b.visit(n.Expression))
+	}
+	return b.visit(node)
+}
+
+func (b *builder) patterns(nodes []parser.Node) []any {
+	list := make([]any, 0, len(nodes))
+	for _, node := range nodes {
+		list = append(list, b.pattern(node))
+	}
+	return list
+}
+
+// patternRest returns the *rest or **rest of a pattern, [:var_field, nil]
+// when it is anonymous.
+func (b *builder) patternRest(target parser.Node) any {
+	if target == nil {
+		return sexp("var_field", nil)
+	}
+	return b.pattern(target)
+}
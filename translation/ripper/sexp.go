@@ -0,0 +1,126 @@
+// Package ripper translates the Prism AST into the s-expressions of Ruby's
+// Ripper.sexp, e.g. [:program, [[:command, [:@ident, "puts", [1, 0]], ...]]].
+//
+// Ripper interleaves parser events with scanner events such as
+// [:@ident, "puts", [1, 0]]. The scanner events are recovered from the
+// locations of the Prism nodes and the source they point into.
+package ripper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Symbol is a Ruby symbol in a sexp, such as :program or :@ident.
+type Symbol string
+
+// Sexp converts the parse result into the value Ruby's Ripper.sexp returns
+// for the same source. Sexps are built from []any arrays holding Symbol,
+// string, int, bool, nil and nested []any values.
+func Sexp(result *parser.ParseResult) []any {
+	b := &builder{source: result.Source}
+	return []any{Symbol("program"), b.stmts(result.Value.Statements)}
+}
+
+// Inspect formats a sexp the way Ruby's Array#inspect does, e.g.
+// [:program, [[:vcall, [:@ident, "foo", [1, 0]]]]].
+func Inspect(sexp any) string {
+	var builder strings.Builder
+	writeSexp(&builder, sexp)
+	return builder.String()
+}
+
+func writeSexp(builder *strings.Builder, sexp any) {
+	switch value := sexp.(type) {
+	case []any:
+		builder.WriteByte('[')
+		for index, element := range value {
+			if index > 0 {
+				builder.WriteString(", ")
+			}
+			writeSexp(builder, element)
+		}
+		builder.WriteByte(']')
+	case nil:
+		builder.WriteString("nil")
+	case Symbol:
+		builder.WriteString(ruby.InspectSymbol(string(value)))
+	case string:
+		builder.WriteString(ruby.InspectString(value, true))
+	case int:
+		builder.WriteString(strconv.Itoa(value))
+	case bool:
+		builder.WriteString(strconv.FormatBool(value))
+	default:
+		fmt.Fprint(builder, value)
+	}
+}
+
+type builder struct {
+	source *parser.Source
+}
+
+// sexp returns a parser event, e.g. [:binary, left, :+, right].
+func sexp(event string, children ...any) []any {
+	return append([]any{Symbol(event)}, children...)
+}
+
+func (b *builder) slice(location parser.Location) string {
+	return string(b.source.Slice(location))
+}
+
+// position returns the [line, column] of a byte offset.
+func (b *builder) position(offset int) []any {
+	line, _ := b.source.Line(offset)
+	column, _ := b.source.Column(offset)
+	return []any{line, column}
+}
+
+// token returns a scanner event for the source text at location, e.g.
+// [:@ident, "puts", [1, 0]].
+func (b *builder) token(event string, location parser.Location) []any {
+	return []any{Symbol("@" + event), b.slice(location), b.position(location.StartOffset)}
+}
+
+// tokenAt is like token for text that is not a plain slice of the source,
+// e.g. a label without its quotes.
+func (b *builder) tokenAt(event, text string, offset int) []any {
+	return []any{Symbol("@" + event), text, b.position(offset)}
+}
+
+var keywords = map[string]bool{
+	"BEGIN": true, "END": true, "__ENCODING__": true, "__FILE__": true, "__LINE__": true,
+	"alias": true, "and": true, "begin": true, "break": true, "case": true, "class": true,
+	"def": true, "defined?": true, "do": true, "else": true, "elsif": true, "end": true,
+	"ensure": true, "false": true, "for": true, "if": true, "in": true, "module": true,
+	"next": true, "nil": true, "not": true, "or": true, "redo": true, "rescue": true,
+	"retry": true, "return": true, "self": true, "super": true, "then": true, "true": true,
+	"undef": true, "unless": true, "until": true, "when": true, "while": true, "yield": true,
+}
+
+// name returns the scanner event for a method, variable or symbol name,
+// picking the event from the shape of the name as Ripper's lexer would.
+func (b *builder) name(location parser.Location) []any {
+	text := b.slice(location)
+	switch {
+	case text == "":
+		return b.token("ident", location)
+	case keywords[text]:
+		return b.token("kw", location)
+	case strings.HasPrefix(text, "@@"):
+		return b.token("cvar", location)
+	case strings.HasPrefix(text, "@"):
+		return b.token("ivar", location)
+	case strings.HasPrefix(text, "$"):
+		return b.token("gvar", location)
+	case text[0] >= 'A' && text[0] <= 'Z':
+		return b.token("const", location)
+	case ruby.IsIdentifier(strings.TrimRight(text, "?!=")):
+		return b.token("ident", location)
+	}
+	return b.token("op", location)
+}
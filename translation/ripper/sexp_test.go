@@ -0,0 +1,95 @@
+package ripper
+
+import (
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+)
+
+// The expected sexps are those of Ruby's Ripper.sexp.
+func TestSexp(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{`puts "hi"`, `[:program, [[:command, [:@ident, "puts", [1, 0]], [:args_add_block, [[:string_literal, [:string_content, [:@tstring_content, "hi", [1, 6]]]]], false]]]]`},
+		{"x = 1", `[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:@int, "1", [1, 4]]]]]`},
+		{"foo(1, bar)", `[:program, [[:method_add_arg, [:fcall, [:@ident, "foo", [1, 0]]], [:arg_paren, [:args_add_block, [[:@int, "1", [1, 4]], [:vcall, [:@ident, "bar", [1, 7]]]], false]]]]]`},
+		{"foo.bar(1) { |a| a }", `[:program, [[:method_add_block, [:method_add_arg, [:call, [:vcall, [:@ident, "foo", [1, 0]]], [:@period, ".", [1, 3]], [:@ident, "bar", [1, 4]]], [:arg_paren, [:args_add_block, [[:@int, "1", [1, 8]]], false]]], [:brace_block, [:block_var, [:params, [[:@ident, "a", [1, 14]]], nil, nil, nil, nil, nil, nil], false], [[:var_ref, [:@ident, "a", [1, 17]]]]]]]]`},
+		{"a + b * c", `[:program, [[:binary, [:vcall, [:@ident, "a", [1, 0]]], :+, [:binary, [:vcall, [:@ident, "b", [1, 4]]], :*, [:vcall, [:@ident, "c", [1, 8]]]]]]]`},
+		{`[1, :a, "s"]`, `[:program, [[:array, [[:@int, "1", [1, 1]], [:symbol_literal, [:symbol, [:@ident, "a", [1, 5]]]], [:string_literal, [:string_content, [:@tstring_content, "s", [1, 9]]]]]]]]`},
+		{"{ a: 1 }", `[:program, [[:hash, [:assoclist_from_args, [[:assoc_new, [:@label, "a:", [1, 2]], [:@int, "1", [1, 5]]]]]]]]`},
+		{`"a#{b}c"`, `[:program, [[:string_literal, [:string_content, [:@tstring_content, "a", [1, 1]], [:string_embexpr, [[:vcall, [:@ident, "b", [1, 4]]]]], [:@tstring_content, "c", [1, 6]]]]]]`},
+		{"if a then b else c end", `[:program, [[:if, [:vcall, [:@ident, "a", [1, 3]]], [[:vcall, [:@ident, "b", [1, 10]]]], [:else, [[:vcall, [:@ident, "c", [1, 17]]]]]]]]`},
+		{"class Foo < Bar; end", `[:program, [[:class, [:const_ref, [:@const, "Foo", [1, 6]]], [:var_ref, [:@const, "Bar", [1, 12]]], [:bodystmt, [[:void_stmt]], nil, nil, nil]]]]`},
+		{"def foo(a, b = 1, *c); end", `[:program, [[:def, [:@ident, "foo", [1, 4]], [:paren, [:params, [[:@ident, "a", [1, 8]]], [[[:@ident, "b", [1, 11]], [:@int, "1", [1, 15]]]], [:rest_param, [:@ident, "c", [1, 19]]], nil, nil, nil, nil]], [:bodystmt, [[:void_stmt]], nil, nil, nil]]]]`},
+		{"return", `[:program, [[:return0]]]`},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := Inspect(Sexp(parsetest.Parse(t, test.source))); got != test.want {
+				t.Errorf("Sexp() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSexpEndlessDef(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"def foo = 42", `[:program, [[:def, [:@ident, "foo", [1, 4]], nil, [:bodystmt, [:@int, "42", [1, 10]], nil, nil, nil]]]]`},
+		{"def self.bar(a) = a + 1", `[:program, [[:defs, [:var_ref, [:@kw, "self", [1, 4]]], [:@period, ".", [1, 8]], [:@ident, "bar", [1, 9]], [:paren, [:params, [[:@ident, "a", [1, 13]]], nil, nil, nil, nil, nil, nil]], [:bodystmt, [:binary, [:var_ref, [:@ident, "a", [1, 18]]], :+, [:@int, "1", [1, 22]]], nil, nil, nil]]]]`},
+		{"def foo = bar rescue nil", `[:program, [[:def, [:@ident, "foo", [1, 4]], nil, [:bodystmt, [:rescue_mod, [:vcall, [:@ident, "bar", [1, 10]]], [:var_ref, [:@kw, "nil", [1, 21]]]], nil, nil, nil]]]]`},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := Inspect(Sexp(parsetest.Parse(t, test.source))); got != test.want {
+				t.Errorf("Sexp() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSexpSquigglyHeredoc(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			"x = <<~E\n  a\nE\n",
+			`[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:string_literal, [:string_content, [:@tstring_content, "a\n", [2, 2]]]]]]]`,
+		},
+		{
+			"x = <<~E\n  a\n    b\nE\n",
+			`[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:string_literal, [:string_content, [:@tstring_content, "a\n", [2, 2]], [:@tstring_content, "  b\n", [3, 2]]]]]]]`,
+		},
+		{
+			// Blank lines do not count, and a tab indents to column 8.
+			"x = <<~E\n  a\n  \tb\n\n    c\nE\n",
+			`[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:string_literal, [:string_content, [:@tstring_content, "a\n", [2, 2]], [:@tstring_content, "\tb\n", [3, 2]], [:@tstring_content, "\n", [4, 0]], [:@tstring_content, "  c\n", [5, 2]]]]]]]`,
+		},
+		{
+			// Only the start of a line is indentation.
+			"x = <<~E\n    a #{b}\n  c\nE\n",
+			`[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:string_literal, [:string_content, [:@tstring_content, "  a ", [2, 2]], [:string_embexpr, [[:vcall, [:@ident, "b", [2, 8]]]]], [:@tstring_content, "\n", [2, 10]], [:@tstring_content, "c\n", [3, 2]]]]]]]`,
+		},
+		{
+			"x = <<~`E`\n  ls #{d}\nE\n",
+			`[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:xstring_literal, [[:@tstring_content, "ls ", [2, 2]], [:string_embexpr, [[:vcall, [:@ident, "d", [2, 7]]]]], [:@tstring_content, "\n", [2, 9]]]]]]]`,
+		},
+		{
+			// Other heredocs keep their indentation.
+			"x = <<-E\n  a\nE\n",
+			`[:program, [[:assign, [:var_field, [:@ident, "x", [1, 0]]], [:string_literal, [:string_content, [:@tstring_content, "  a\n", [2, 0]]]]]]]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := Inspect(Sexp(parsetest.Parse(t, test.source))); got != test.want {
+				t.Errorf("Sexp() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package ripper
+
+import (
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+func (b *builder) string(n *parser.StringNode) any {
+	if n.OpeningLoc != nil && b.slice(*n.OpeningLoc) == "?" {
+		return b.token("CHAR", n.Location)
+	}
+	if b.squiggly(n.OpeningLoc) {
+		return sexp("string_literal", append([]any{Symbol("string_content")}, b.dedent(n.ContentLoc, b.indentation(n.ContentLoc))...))
+	}
+	content := []any{Symbol("string_content")}
+	if n.ContentLoc.Length > 0 {
+		content = append(content, b.token("tstring_content", n.ContentLoc))
+	}
+	return sexp("string_literal", content)
+}
+
+// interpolatedString returns a string with interpolation, or a string_concat
+// chain for adjacent literals such as "a" "b".
+func (b *builder) interpolatedString(n *parser.InterpolatedStringNode) any {
+	concatenated := len(n.Parts) > 1
+	for _, part := range n.Parts {
+		if !isLiteral(part) {
+			concatenated = false
+		}
+	}
+	if concatenated {
+		var chain any = b.visit(n.Parts[0])
+		for _, part := range n.Parts[1:] {
+			chain = sexp("string_concat", chain, b.visit(part))
+		}
+		return chain
+	}
+	if b.squiggly(n.OpeningLoc) {
+		return sexp("string_literal", append([]any{Symbol("string_content")}, b.heredoc(n.Parts, n.ClosingLoc)...))
+	}
+	return sexp("string_literal", append([]any{Symbol("string_content")}, b.parts(n.Parts)...))
+}
+
+// isLiteral reports whether a string part is a literal of its own, as the
+// parts of "a" "b" are.
+func isLiteral(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.StringNode:
+		return n.OpeningLoc != nil
+	case *parser.InterpolatedStringNode:
+		return n.OpeningLoc != nil
+	}
+	return false
+}
+
+// parts returns the contents of an interpolated literal.
+func (b *builder) parts(parts []parser.Node) []any {
+	children := make([]any, 0, len(parts))
+	for _, part := range parts {
+		if part, ok := part.(*parser.StringNode); ok && part.OpeningLoc == nil {
+			children = append(children, b.token("tstring_content", part.ContentLoc))
+			continue
+		}
+		children = append(children, b.visit(part))
+	}
+	return children
+}
+
+// squiggly reports whether a literal is a <<~ heredoc.
+func (b *builder) squiggly(opening *parser.Location) bool {
+	return opening != nil && strings.HasPrefix(b.slice(*opening), "<<~")
+}
+
+// heredoc returns the contents of a <<~ heredoc with interpolation, whose
+// body ends at closing.
+func (b *builder) heredoc(parts []parser.Node, closing *parser.Location) []any {
+	if len(parts) == 0 || closing == nil {
+		return b.parts(parts)
+	}
+	start := parts[0].GetLocation().StartOffset
+	width := b.indentation(parser.Location{StartOffset: start, Length: closing.StartOffset - start})
+	children := make([]any, 0, len(parts))
+	for _, part := range parts {
+		if part, ok := part.(*parser.StringNode); ok && part.OpeningLoc == nil {
+			children = append(children, b.dedent(part.ContentLoc, width)...)
+			continue
+		}
+		children = append(children, b.visit(part))
+	}
+	return children
+}
+
+// indentation returns the indentation a <<~ heredoc removes from the lines
+// of its body: that of its least indented line, ignoring the lines with
+// only spaces and tabs. A tab indents to the next multiple of 8 columns.
+func (b *builder) indentation(body parser.Location) int {
+	width := -1
+	for _, line := range strings.SplitAfter(b.slice(body), "\n") {
+		column, index := 0, 0
+		for ; index < len(line); index++ {
+			if line[index] == ' ' {
+				column++
+			} else if line[index] == '\t' {
+				column = (column/8 + 1) * 8
+			} else {
+				break
+			}
+		}
+		if rest := strings.TrimRight(line[index:], "\r\n"); rest == "" {
+			continue
+		}
+		if width < 0 || column < width {
+			width = column
+		}
+	}
+	return max(width, 0)
+}
+
+// dedent returns the tokens of the text of a <<~ heredoc, one per line as
+// Ripper's lexer splits them, with up to width columns of indentation
+// removed from the lines as Ripper.sexp does. The column of a token moves
+// past the indentation removed.
+func (b *builder) dedent(location parser.Location, width int) []any {
+	var tokens []any
+	offset := location.StartOffset
+	for _, line := range strings.SplitAfter(b.slice(location), "\n") {
+		if line == "" {
+			continue
+		}
+		removed := 0
+		if offset == 0 || b.source.Bytes[offset-1] == '\n' {
+			column := 0
+			for ; removed < len(line); removed++ {
+				if line[removed] == ' ' {
+					column++
+				} else if line[removed] == '\t' {
+					column = (column/8 + 1) * 8
+				} else {
+					break
+				}
+				if column > width {
+					break
+				}
+			}
+		}
+		tokens = append(tokens, b.tokenAt("tstring_content", line[removed:], offset+removed))
+		offset += len(line)
+	}
+	return tokens
+}
+
+// content returns the contents of a literal without interpolation.
+func (b *builder) content(location parser.Location) []any {
+	if location.Length == 0 {
+		return []any{}
+	}
+	return []any{b.token("tstring_content", location)}
+}
+
+func (b *builder) symbol(n *parser.SymbolNode) any {
+	opening := ""
+	if n.OpeningLoc != nil {
+		opening = b.slice(*n.OpeningLoc)
+	}
+	if n.ValueLoc == nil {
+		return sexp("dyna_symbol", []any{Symbol("string_content")})
+	}
+	if opening == ":" {
+		return sexp("symbol_literal", sexp("symbol", b.name(*n.ValueLoc)))
+	}
+	if n.OpeningLoc == nil && n.ClosingLoc != nil {
+		return b.token("label", n.Location)
+	}
+	return sexp("dyna_symbol", []any{Symbol("string_content"), b.token("tstring_content", *n.ValueLoc)})
+}
@@ -0,0 +1,206 @@
+package whitequark
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+func (c *compiler) call(n *parser.CallNode) *Node {
+	receiver := c.compile(n.Receiver)
+
+	var node *Node
+	switch {
+	case c.isIndex(n) && n.Name == "[]":
+		node = New("index", append([]any{receiver}, c.arguments(n.Arguments, n.Block, false)...)...)
+	case c.isIndex(n) && n.Name == "[]=":
+		node = New("indexasgn", append([]any{receiver}, c.arguments(n.Arguments, n.Block, false)...)...)
+	default:
+		typ := "send"
+		if n.IsSAFE_NAVIGATION() {
+			typ = "csend"
+		}
+		children := []any{receiver, Symbol(n.Name)}
+		node = New(typ, append(children, c.arguments(n.Arguments, n.Block, true)...)...)
+	}
+
+	if block, ok := n.Block.(*parser.BlockNode); ok {
+		return c.block(node, block)
+	}
+	return node
+}
+
+// isIndex reports whether the call uses the foo[bar] syntax rather than an
+// explicit foo.[](bar).
+func (c *compiler) isIndex(n *parser.CallNode) bool {
+	return n.CallOperatorLoc == nil && n.OpeningLoc != nil && c.slice(*n.OpeningLoc) == "["
+}
+
+// callTarget returns the attribute read or write of foo.bar += 1 and
+// foo.bar, baz = 1, 2.
+func (c *compiler) callTarget(safeNavigation bool, receiver parser.Node, name string) *Node {
+	if safeNavigation {
+		return New("csend", c.compile(receiver), Symbol(name))
+	}
+	return New("send", c.compile(receiver), Symbol(name))
+}
+
+func (c *compiler) indexTarget(receiver parser.Node, arguments *parser.ArgumentsNode, block parser.Node) *Node {
+	return New("indexasgn", append([]any{c.compile(receiver)}, c.arguments(arguments, block, false)...)...)
+}
+
+// arguments returns the arguments of a call followed by its block argument,
+// if any. With kwargs set, a hash without braces becomes a kwargs node as
+// with the parser gem's emit_kwargs.
+func (c *compiler) arguments(arguments *parser.ArgumentsNode, block parser.Node, kwargs bool) []any {
+	var children []any
+	if arguments != nil {
+		for _, argument := range arguments.Arguments {
+			if hash, ok := argument.(*parser.KeywordHashNode); ok && kwargs {
+				children = append(children, New("kwargs", c.compileAll(hash.Elements)...))
+				continue
+			}
+			children = append(children, c.compile(argument))
+		}
+	}
+	if block, ok := block.(*parser.BlockArgumentNode); ok && block != nil {
+		children = append(children, c.compile(block))
+	}
+	return children
+}
+
+func (c *compiler) block(call *Node, block *parser.BlockNode) *Node {
+	return c.blockWith(call, block.Parameters, block.Body, true)
+}
+
+// blockWith wraps call in a block, numblock or itblock node. Blocks with a
+// single parameter use procarg0, lambdas do not.
+func (c *compiler) blockWith(call *Node, parameters parser.Node, body parser.Node, procarg0 bool) *Node {
+	switch parameters := parameters.(type) {
+	case *parser.NumberedParametersNode:
+		return New("numblock", call, int64(parameters.Maximum), c.compile(body))
+	case *parser.ItParametersNode:
+		return New("itblock", call, Symbol("it"), c.compile(body))
+	case *parser.BlockParametersNode:
+		return New("block", call, c.blockParameters(parameters, procarg0), c.compile(body))
+	}
+	return New("block", call, New("args"), c.compile(body))
+}
+
+func (c *compiler) blockParameters(n *parser.BlockParametersNode, procarg0 bool) *Node {
+	var children []any
+	if p := n.Parameters; p != nil {
+		if procarg0 && len(p.Requireds) == 1 && len(p.Optionals) == 0 && p.Rest == nil &&
+			len(p.Posts) == 0 && len(p.Keywords) == 0 && p.KeywordRest == nil && p.Block == nil {
+			switch required := p.Requireds[0].(type) {
+			case *parser.MultiTargetNode:
+				children = append(children, New("procarg0", c.parameterTargets(required)...))
+			default:
+				children = append(children, New("procarg0", c.parameter(required)))
+			}
+		} else {
+			children = c.parameterList(p)
+		}
+	}
+	for _, local := range n.Locals {
+		if local, ok := local.(*parser.BlockLocalVariableNode); ok {
+			children = append(children, New("shadowarg", Symbol(local.Name)))
+		}
+	}
+	return New("args", children...)
+}
+
+func (c *compiler) parameters(n *parser.ParametersNode) *Node {
+	if n == nil {
+		return New("args")
+	}
+	return New("args", c.parameterList(n)...)
+}
+
+func (c *compiler) parameterList(n *parser.ParametersNode) []any {
+	var children []any
+	for _, required := range n.Requireds {
+		children = append(children, c.parameter(required))
+	}
+	for _, optional := range n.Optionals {
+		children = append(children, c.parameter(optional))
+	}
+	if n.Rest != nil {
+		if rest := c.parameter(n.Rest); rest != nil {
+			children = append(children, rest)
+		}
+	}
+	for _, post := range n.Posts {
+		children = append(children, c.parameter(post))
+	}
+	for _, keyword := range n.Keywords {
+		children = append(children, c.parameter(keyword))
+	}
+	if n.KeywordRest != nil {
+		children = append(children, c.parameter(n.KeywordRest))
+	}
+	if n.Block != nil {
+		children = append(children, c.parameter(n.Block))
+	}
+	return children
+}
+
+func (c *compiler) parameter(node parser.Node) *Node {
+	switch n := node.(type) {
+	case *parser.RequiredParameterNode:
+		return New("arg", Symbol(n.Name))
+	case *parser.OptionalParameterNode:
+		return New("optarg", Symbol(n.Name), c.compile(n.Value))
+	case *parser.RestParameterNode:
+		if n.Name == nil {
+			return New("restarg")
+		}
+		return New("restarg", Symbol(*n.Name))
+	case *parser.RequiredKeywordParameterNode:
+		return New("kwarg", Symbol(n.Name))
+	case *parser.OptionalKeywordParameterNode:
+		return New("kwoptarg", Symbol(n.Name), c.compile(n.Value))
+	case *parser.KeywordRestParameterNode:
+		if n.Name == nil {
+			return New("kwrestarg")
+		}
+		return New("kwrestarg", Symbol(*n.Name))
+	case *parser.NoKeywordsParameterNode:
+		return New("kwnilarg")
+	case *parser.ForwardingParameterNode:
+		return New("forward_arg")
+	case *parser.BlockParameterNode:
+		if n.Name == nil {
+			return New("blockarg", nil)
+		}
+		return New("blockarg", Symbol(*n.Name))
+	case *parser.SplatNode:
+		if n.Expression == nil {
+			return New("restarg")
+		}
+		return c.parameter(n.Expression).withType("restarg")
+	case *parser.MultiTargetNode:
+		return New("mlhs", c.parameterTargets(n)...)
+	}
+	return nil
+}
+
+// parameterTargets returns the parameters of a destructuring parameter such
+// as (a, *b).
+func (c *compiler) parameterTargets(n *parser.MultiTargetNode) []any {
+	var children []any
+	for _, left := range n.Lefts {
+		children = append(children, c.parameter(left))
+	}
+	if n.Rest != nil {
+		if rest := c.parameter(n.Rest); rest != nil {
+			children = append(children, rest)
+		}
+	}
+	for _, right := range n.Rights {
+		children = append(children, c.parameter(right))
+	}
+	return children
+}
+
+func (n *Node) withType(typ string) *Node {
+	return New(typ, n.Children...)
+}
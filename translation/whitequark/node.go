@@ -0,0 +1,148 @@
+// Package whitequark translates the Prism AST into the AST of the whitequark
+// parser gem, e.g. (send nil :puts (str "hi")), mirroring the semantics of
+// Ruby's Prism::Translation::Parser with the modern parser gem emit flags.
+package whitequark
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/internal/ruby"
+)
+
+// Node is a node of the parser gem AST. Type is the node type as the parser
+// gem names it, e.g. "send" or "op_asgn". Children hold the child nodes and
+// values of the node, each one of:
+//
+//   - *Node for child nodes
+//   - nil for absent children
+//   - Symbol for Ruby symbols
+//   - string for Ruby strings
+//   - int64 for Ruby integers
+//   - float64 for Ruby floats
+//   - Rational and Complex for the values of rational and imaginary literals
+type Node struct {
+	Type     string
+	Children []any
+}
+
+// Symbol is a Ruby symbol child, printed as :name.
+type Symbol string
+
+// Rational is the value of a rational literal, printed as (3/2).
+type Rational struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// String returns the value the way Ruby's Rational#inspect does.
+func (r Rational) String() string {
+	return fmt.Sprintf("(%d/%d)", r.Numerator, r.Denominator)
+}
+
+// Complex is the value of an imaginary literal, printed as (0+1i). Imaginary
+// holds an int64, a float64 or a Rational.
+type Complex struct {
+	Imaginary any
+}
+
+// String returns the value the way Ruby's Complex#inspect does.
+func (c Complex) String() string {
+	sign, imaginary := "+", ""
+	switch value := c.Imaginary.(type) {
+	case int64:
+		if value < 0 {
+			sign, value = "-", -value
+		}
+		imaginary = strconv.FormatInt(value, 10)
+	case float64:
+		if math.Signbit(value) {
+			sign, value = "-", -value
+		}
+		imaginary = ruby.InspectFloat(value)
+	case Rational:
+		if value.Numerator < 0 {
+			sign, value.Numerator = "-", -value.Numerator
+		}
+		imaginary = value.String() + "*"
+	}
+	return "(0" + sign + imaginary + "i)"
+}
+
+// New returns a node of the given type. A nil *Node child is stored as an
+// untyped nil, so that it prints as nil.
+func New(typ string, children ...any) *Node {
+	for index, child := range children {
+		if node, ok := child.(*Node); ok && node == nil {
+			children[index] = nil
+		}
+	}
+	return &Node{Type: typ, Children: children}
+}
+
+// String returns the s-expression of the node the way the parser gem's
+// Node#to_sexp does, with each child node on its own indented line.
+func (n *Node) String() string {
+	if n == nil {
+		return "nil"
+	}
+	var builder strings.Builder
+	n.write(&builder, 0, true)
+	return builder.String()
+}
+
+// Inline returns the s-expression of the node on a single line, e.g.
+// (send nil :puts (str "hi")).
+func (n *Node) Inline() string {
+	if n == nil {
+		return "nil"
+	}
+	var builder strings.Builder
+	n.write(&builder, 0, false)
+	return builder.String()
+}
+
+func (n *Node) write(builder *strings.Builder, indent int, multiline bool) {
+	if multiline {
+		builder.WriteString(strings.Repeat("  ", indent))
+	}
+	builder.WriteByte('(')
+	// Like the ast gem's fancy_type, underscores print as dashes.
+	builder.WriteString(strings.ReplaceAll(n.Type, "_", "-"))
+	for _, child := range n.Children {
+		if node, ok := child.(*Node); ok {
+			if multiline {
+				builder.WriteByte('\n')
+			} else {
+				builder.WriteByte(' ')
+			}
+			node.write(builder, indent+1, multiline)
+			continue
+		}
+		builder.WriteByte(' ')
+		builder.WriteString(inspectValue(child))
+	}
+	builder.WriteByte(')')
+}
+
+// inspectValue formats a non-node child the way Ruby's inspect does.
+func inspectValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "nil"
+	case Symbol:
+		return ruby.InspectSymbol(string(value))
+	case string:
+		return ruby.InspectString(value, true)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case float64:
+		return ruby.InspectFloat(value)
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,108 @@
+package whitequark
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// in converts an in clause, moving a trailing if or unless from the pattern
+// into a guard.
+func (c *compiler) in(n *parser.InNode) *Node {
+	var guard *Node
+	pattern := n.Pattern
+	switch p := pattern.(type) {
+	case *parser.IfNode:
+		if p.Statements != nil && len(p.Statements.Body) == 1 {
+			guard = New("if_guard", c.compile(p.Predicate))
+			pattern = p.Statements.Body[0]
+		}
+	case *parser.UnlessNode:
+		if p.Statements != nil && len(p.Statements.Body) == 1 {
+			guard = New("unless_guard", c.compile(p.Predicate))
+			pattern = p.Statements.Body[0]
+		}
+	}
+	return New("in_pattern", c.pattern(pattern), guard, c.body(n.Statements))
+}
+
+func (c *compiler) pattern(node parser.Node) *Node {
+	switch n := node.(type) {
+	case *parser.LocalVariableTargetNode:
+		return New("match_var", Symbol(n.Name))
+	case *parser.ArrayPatternNode:
+		typ := "array_pattern"
+		children := c.patterns(n.Requireds)
+		switch rest := n.Rest.(type) {
+		case *parser.ImplicitRestNode:
+			typ = "array_pattern_with_tail"
+		case *parser.SplatNode:
+			children = append(children, c.matchRest(rest.Expression))
+		}
+		children = append(children, c.patterns(n.Posts)...)
+		return c.constPattern(n.Constant, New(typ, children...))
+	case *parser.FindPatternNode:
+		children := []any{c.matchRest(n.Left.Expression)}
+		children = append(children, c.patterns(n.Requireds)...)
+		if right, ok := n.Right.(*parser.SplatNode); ok {
+			children = append(children, c.matchRest(right.Expression))
+		}
+		return c.constPattern(n.Constant, New("find_pattern", children...))
+	case *parser.HashPatternNode:
+		var children []any
+		for _, element := range n.Elements {
+			assoc, ok := element.(*parser.AssocNode)
+			if !ok {
+				continue
+			}
+			if implicit, ok := assoc.Value.(*parser.ImplicitNode); ok {
+				children = append(children, c.pattern(implicit.Value))
+				continue
+			}
+			children = append(children, New("pair", c.compile(assoc.Key), c.pattern(assoc.Value)))
+		}
+		switch rest := n.Rest.(type) {
+		case *parser.AssocSplatNode:
+			children = append(children, c.matchRest(rest.Value))
+		case *parser.NoKeywordsParameterNode:
+			children = append(children, New("match_nil_pattern"))
+		}
+		return c.constPattern(n.Constant, New("hash_pattern", children...))
+	case *parser.AlternationPatternNode:
+		return New("match_alt", c.pattern(n.Left), c.pattern(n.Right))
+	case *parser.CapturePatternNode:
+		return New("match_as", c.pattern(n.Value), c.pattern(n.Target))
+	case *parser.PinnedVariableNode:
+		return New("pin", c.compile(n.Variable))
+	case *parser.PinnedExpressionNode:
+		return New("pin", New("begin", c.compile(n.Expression)))
+	case *parser.ParenthesesNode:
+		if statements, ok := n.Body.(*parser.StatementsNode); ok && len(statements.Body) == 1 {
+			return c.pattern(statements.Body[0])
+		}
+		return c.pattern(n.Body)
+	}
+	return c.compile(node)
+}
+
+func (c *compiler) patterns(nodes []parser.Node) []any {
+	children := make([]any, 0, len(nodes))
+	for _, node := range nodes {
+		children = append(children, c.pattern(node))
+	}
+	return children
+}
+
+// matchRest returns the rest of an array, find or hash pattern: *rest, **rest
+// or an anonymous * or **.
+func (c *compiler) matchRest(target parser.Node) *Node {
+	if target == nil {
+		return New("match_rest")
+	}
+	return New("match_rest", c.pattern(target))
+}
+
+func (c *compiler) constPattern(constant parser.Node, pattern *Node) *Node {
+	if constant == nil {
+		return pattern
+	}
+	return New("const_pattern", c.compile(constant), pattern)
+}
@@ -0,0 +1,96 @@
+package whitequark
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+func (c *compiler) string(n *parser.StringNode) *Node {
+	opening := ""
+	if n.OpeningLoc != nil {
+		opening = c.slice(*n.OpeningLoc)
+	}
+	if opening == "?" {
+		return New("str", n.Unescaped.Value)
+	}
+	parts := c.stringParts(n.ContentLoc, n.Unescaped.Value, false)
+	if strings.HasPrefix(opening, "<<") && n.ContentLoc.Length == 0 {
+		return New("dstr")
+	}
+	if len(parts) == 1 {
+		return parts[0].(*Node)
+	}
+	return New("dstr", parts...)
+}
+
+func (c *compiler) interpolatedString(n *parser.InterpolatedStringNode) *Node {
+	return New("dstr", c.parts(n.Parts, false)...)
+}
+
+// parts returns the parts of an interpolated literal. String parts that are
+// literals of their own, as in "a" "b", are kept whole; the others are split
+// into lines. With raw set, string parts hold their source text, as regular
+// expressions do in the parser gem.
+func (c *compiler) parts(parts []parser.Node, raw bool) []any {
+	var children []any
+	for _, part := range parts {
+		if part, ok := part.(*parser.StringNode); ok && part.OpeningLoc == nil {
+			children = append(children, c.stringParts(part.ContentLoc, part.Unescaped.Value, raw)...)
+			continue
+		}
+		children = append(children, c.compile(part))
+	}
+	return children
+}
+
+// stringParts returns the str nodes of string content. The parser gem's
+// lexer emits a token per source line, so content spanning several lines
+// becomes one str node per line.
+func (c *compiler) stringParts(content parser.Location, value string, raw bool) []any {
+	text := c.slice(content)
+	if raw {
+		value = text
+	}
+	lines := strings.Count(text, "\n")
+	if lines == 0 || strings.Count(value, "\n") != lines {
+		return []any{New("str", value)}
+	}
+	pieces := strings.SplitAfter(value, "\n")
+	if pieces[len(pieces)-1] == "" {
+		pieces = pieces[:len(pieces)-1]
+	}
+	children := make([]any, len(pieces))
+	for index, piece := range pieces {
+		children[index] = New("str", piece)
+	}
+	return children
+}
+
+func (c *compiler) regexp(content parser.Location, closing parser.Location) *Node {
+	var children []any
+	if content.Length > 0 {
+		children = c.stringParts(content, "", true)
+	}
+	return New("regexp", append(children, c.regopt(closing))...)
+}
+
+// regopt returns the options of a regular expression, sorted and without
+// duplicates.
+func (c *compiler) regopt(closing parser.Location) *Node {
+	text := c.slice(closing)
+	if text != "" {
+		text = text[1:]
+	}
+	options := strings.Split(text, "")
+	sort.Strings(options)
+	var children []any
+	for index, option := range options {
+		if index > 0 && option == options[index-1] {
+			continue
+		}
+		children = append(children, Symbol(option))
+	}
+	return New("regopt", children...)
+}
@@ -0,0 +1,482 @@
+package whitequark
+
+import (
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Translate converts the program of the parse result into the parser gem
+// AST. It returns nil for an empty program.
+func Translate(result *parser.ParseResult) *Node {
+	return TranslateNode(result.Value, result.Source)
+}
+
+// TranslateNode converts a Prism node into the parser gem AST. The source is
+// the source the node was parsed from; it is used to recover the literal text
+// of strings, regular expressions and operators.
+func TranslateNode(node parser.Node, source *parser.Source) *Node {
+	c := &compiler{source: source}
+	return c.compile(node)
+}
+
+type compiler struct {
+	source *parser.Source
+}
+
+func (c *compiler) slice(location parser.Location) string {
+	return string(c.source.Slice(location))
+}
+
+func (c *compiler) compileAll(nodes []parser.Node) []any {
+	children := make([]any, 0, len(nodes))
+	for _, node := range nodes {
+		children = append(children, c.compile(node))
+	}
+	return children
+}
+
+// body returns the node for a statements list: nil when empty, the statement
+// itself when there is only one and a begin node otherwise.
+func (c *compiler) body(statements *parser.StatementsNode) *Node {
+	if statements == nil || len(statements.Body) == 0 {
+		return nil
+	}
+	if len(statements.Body) == 1 {
+		return c.compile(statements.Body[0])
+	}
+	return New("begin", c.compileAll(statements.Body)...)
+}
+
+func (c *compiler) statements(statements *parser.StatementsNode) []any {
+	if statements == nil {
+		return nil
+	}
+	return c.compileAll(statements.Body)
+}
+
+func (c *compiler) compile(node parser.Node) *Node {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	// Programs and statements
+
+	case *parser.ProgramNode:
+		return c.body(n.Statements)
+	case *parser.StatementsNode:
+		return c.body(n)
+	case *parser.ParenthesesNode:
+		if statements, ok := n.Body.(*parser.StatementsNode); ok {
+			return New("begin", c.statements(statements)...)
+		}
+		if n.Body == nil {
+			return New("begin")
+		}
+		return New("begin", c.compile(n.Body))
+	case *parser.BeginNode:
+		return c.begin(n)
+	case *parser.PreExecutionNode:
+		return New("preexe", c.body(n.Statements))
+	case *parser.PostExecutionNode:
+		return New("postexe", c.body(n.Statements))
+
+	// Literals
+
+	case *parser.NilNode:
+		return New("nil")
+	case *parser.TrueNode:
+		return New("true")
+	case *parser.FalseNode:
+		return New("false")
+	case *parser.SelfNode:
+		return New("self")
+	case *parser.SourceFileNode:
+		return New("str", n.Filepath.Value)
+	case *parser.SourceLineNode:
+		line, _ := c.source.Line(n.Location.StartOffset)
+		return New("int", int64(line))
+	case *parser.SourceEncodingNode:
+		return New("__ENCODING__")
+	case *parser.IntegerNode:
+		return New("int", n.Value)
+	case *parser.FloatNode:
+		return New("float", n.Value)
+	case *parser.RationalNode:
+		return New("rational", Rational{Numerator: n.Numerator, Denominator: n.Denominator})
+	case *parser.ImaginaryNode:
+		return New("complex", imaginary(n.Numeric))
+	case *parser.StringNode:
+		return c.string(n)
+	case *parser.InterpolatedStringNode:
+		return c.interpolatedString(n)
+	case *parser.XStringNode:
+		return New("xstr", c.stringParts(n.ContentLoc, n.Unescaped.Value, false)...)
+	case *parser.InterpolatedXStringNode:
+		return New("xstr", c.parts(n.Parts, false)...)
+	case *parser.SymbolNode:
+		return New("sym", Symbol(n.Unescaped.Value))
+	case *parser.InterpolatedSymbolNode:
+		return New("dsym", c.parts(n.Parts, false)...)
+	case *parser.RegularExpressionNode:
+		return c.regexp(n.ContentLoc, n.ClosingLoc)
+	case *parser.InterpolatedRegularExpressionNode:
+		return New("regexp", append(c.parts(n.Parts, true), c.regopt(n.ClosingLoc))...)
+	case *parser.MatchLastLineNode:
+		return New("match_current_line", c.regexp(n.ContentLoc, n.ClosingLoc))
+	case *parser.InterpolatedMatchLastLineNode:
+		return New("match_current_line", New("regexp", append(c.parts(n.Parts, true), c.regopt(n.ClosingLoc))...))
+	case *parser.EmbeddedStatementsNode:
+		return New("begin", c.statements(n.Statements)...)
+	case *parser.EmbeddedVariableNode:
+		return c.compile(n.Variable)
+	case *parser.ArrayNode:
+		return New("array", c.compileAll(n.Elements)...)
+	case *parser.HashNode:
+		return New("hash", c.compileAll(n.Elements)...)
+	case *parser.KeywordHashNode:
+		return New("hash", c.compileAll(n.Elements)...)
+	case *parser.AssocNode:
+		return New("pair", c.compile(n.Key), c.compile(n.Value))
+	case *parser.AssocSplatNode:
+		if n.Value == nil {
+			return New("forwarded_kwrestarg")
+		}
+		return New("kwsplat", c.compile(n.Value))
+	case *parser.ImplicitNode:
+		return c.compile(n.Value)
+	case *parser.RangeNode:
+		if n.IsEXCLUDE_END() {
+			return New("erange", c.compile(n.Left), c.compile(n.Right))
+		}
+		return New("irange", c.compile(n.Left), c.compile(n.Right))
+	case *parser.FlipFlopNode:
+		if n.IsEXCLUDE_END() {
+			return New("eflipflop", c.compile(n.Left), c.compile(n.Right))
+		}
+		return New("iflipflop", c.compile(n.Left), c.compile(n.Right))
+
+	// Variables
+
+	case *parser.LocalVariableReadNode:
+		return New("lvar", Symbol(n.Name))
+	case *parser.ItLocalVariableReadNode:
+		return New("lvar", Symbol("it"))
+	case *parser.LocalVariableWriteNode:
+		return New("lvasgn", Symbol(n.Name), c.compile(n.Value))
+	case *parser.LocalVariableTargetNode:
+		return New("lvasgn", Symbol(n.Name))
+	case *parser.LocalVariableOperatorWriteNode:
+		return New("op_asgn", New("lvasgn", Symbol(n.Name)), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.LocalVariableAndWriteNode:
+		return New("and_asgn", New("lvasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.LocalVariableOrWriteNode:
+		return New("or_asgn", New("lvasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.InstanceVariableReadNode:
+		return New("ivar", Symbol(n.Name))
+	case *parser.InstanceVariableWriteNode:
+		return New("ivasgn", Symbol(n.Name), c.compile(n.Value))
+	case *parser.InstanceVariableTargetNode:
+		return New("ivasgn", Symbol(n.Name))
+	case *parser.InstanceVariableOperatorWriteNode:
+		return New("op_asgn", New("ivasgn", Symbol(n.Name)), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.InstanceVariableAndWriteNode:
+		return New("and_asgn", New("ivasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.InstanceVariableOrWriteNode:
+		return New("or_asgn", New("ivasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.ClassVariableReadNode:
+		return New("cvar", Symbol(n.Name))
+	case *parser.ClassVariableWriteNode:
+		return New("cvasgn", Symbol(n.Name), c.compile(n.Value))
+	case *parser.ClassVariableTargetNode:
+		return New("cvasgn", Symbol(n.Name))
+	case *parser.ClassVariableOperatorWriteNode:
+		return New("op_asgn", New("cvasgn", Symbol(n.Name)), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.ClassVariableAndWriteNode:
+		return New("and_asgn", New("cvasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.ClassVariableOrWriteNode:
+		return New("or_asgn", New("cvasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.GlobalVariableReadNode:
+		return New("gvar", Symbol(n.Name))
+	case *parser.GlobalVariableWriteNode:
+		return New("gvasgn", Symbol(n.Name), c.compile(n.Value))
+	case *parser.GlobalVariableTargetNode:
+		return New("gvasgn", Symbol(n.Name))
+	case *parser.GlobalVariableOperatorWriteNode:
+		return New("op_asgn", New("gvasgn", Symbol(n.Name)), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.GlobalVariableAndWriteNode:
+		return New("and_asgn", New("gvasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.GlobalVariableOrWriteNode:
+		return New("or_asgn", New("gvasgn", Symbol(n.Name)), c.compile(n.Value))
+	case *parser.BackReferenceReadNode:
+		return New("back_ref", Symbol(n.Name))
+	case *parser.NumberedReferenceReadNode:
+		return New("nth_ref", int64(n.Number))
+
+	// Constants
+
+	case *parser.ConstantReadNode:
+		return New("const", nil, Symbol(n.Name))
+	case *parser.ConstantPathNode:
+		return New("const", c.constantParent(n.Parent), Symbol(optional(n.Name)))
+	case *parser.ConstantWriteNode:
+		return New("casgn", nil, Symbol(n.Name), c.compile(n.Value))
+	case *parser.ConstantTargetNode:
+		return New("casgn", nil, Symbol(n.Name))
+	case *parser.ConstantOperatorWriteNode:
+		return New("op_asgn", New("casgn", nil, Symbol(n.Name)), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.ConstantAndWriteNode:
+		return New("and_asgn", New("casgn", nil, Symbol(n.Name)), c.compile(n.Value))
+	case *parser.ConstantOrWriteNode:
+		return New("or_asgn", New("casgn", nil, Symbol(n.Name)), c.compile(n.Value))
+	case *parser.ConstantPathWriteNode:
+		return New("casgn", c.constantParent(n.Target.Parent), Symbol(optional(n.Target.Name)), c.compile(n.Value))
+	case *parser.ConstantPathTargetNode:
+		return New("casgn", c.constantParent(n.Parent), Symbol(optional(n.Name)))
+	case *parser.ConstantPathOperatorWriteNode:
+		return New("op_asgn", c.constantPathTarget(n.Target), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.ConstantPathAndWriteNode:
+		return New("and_asgn", c.constantPathTarget(n.Target), c.compile(n.Value))
+	case *parser.ConstantPathOrWriteNode:
+		return New("or_asgn", c.constantPathTarget(n.Target), c.compile(n.Value))
+	case *parser.ShareableConstantNode:
+		return c.compile(n.Write)
+
+	// Calls
+
+	case *parser.CallNode:
+		return c.call(n)
+	case *parser.CallOperatorWriteNode:
+		return New("op_asgn", c.callTarget(n.IsSAFE_NAVIGATION(), n.Receiver, n.ReadName), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.CallAndWriteNode:
+		return New("and_asgn", c.callTarget(n.IsSAFE_NAVIGATION(), n.Receiver, n.ReadName), c.compile(n.Value))
+	case *parser.CallOrWriteNode:
+		return New("or_asgn", c.callTarget(n.IsSAFE_NAVIGATION(), n.Receiver, n.ReadName), c.compile(n.Value))
+	case *parser.CallTargetNode:
+		return c.callTarget(n.IsSAFE_NAVIGATION(), n.Receiver, n.Name)
+	case *parser.IndexOperatorWriteNode:
+		return New("op_asgn", c.indexTarget(n.Receiver, n.Arguments, n.Block), Symbol(n.BinaryOperator), c.compile(n.Value))
+	case *parser.IndexAndWriteNode:
+		return New("and_asgn", c.indexTarget(n.Receiver, n.Arguments, n.Block), c.compile(n.Value))
+	case *parser.IndexOrWriteNode:
+		return New("or_asgn", c.indexTarget(n.Receiver, n.Arguments, n.Block), c.compile(n.Value))
+	case *parser.IndexTargetNode:
+		return c.indexTarget(n.Receiver, n.Arguments, n.Block)
+	case *parser.SuperNode:
+		node := New("super", c.arguments(n.Arguments, n.Block, true)...)
+		if block, ok := n.Block.(*parser.BlockNode); ok {
+			return c.block(node, block)
+		}
+		return node
+	case *parser.ForwardingSuperNode:
+		if n.Block != nil {
+			return c.block(New("zsuper"), n.Block)
+		}
+		return New("zsuper")
+	case *parser.YieldNode:
+		return New("yield", c.arguments(n.Arguments, nil, true)...)
+	case *parser.BlockArgumentNode:
+		return New("block_pass", c.compile(n.Expression))
+	case *parser.SplatNode:
+		if n.Expression == nil {
+			return New("forwarded_restarg")
+		}
+		return New("splat", c.compile(n.Expression))
+	case *parser.ForwardingArgumentsNode:
+		return New("forwarded_args")
+	case *parser.LambdaNode:
+		return c.blockWith(New("lambda"), n.Parameters, n.Body, false)
+	case *parser.MatchWriteNode:
+		var value *Node
+		if n.Call.Arguments != nil && len(n.Call.Arguments.Arguments) > 0 {
+			value = c.compile(n.Call.Arguments.Arguments[0])
+		}
+		return New("match_with_lvasgn", c.compile(n.Call.Receiver), value)
+	case *parser.DefinedNode:
+		return New("defined?", c.compile(n.Value))
+
+	// Assignments
+
+	case *parser.MultiWriteNode:
+		return New("masgn", New("mlhs", c.targets(n.Lefts, n.Rest, n.Rights)...), c.compile(n.Value))
+	case *parser.MultiTargetNode:
+		return New("mlhs", c.targets(n.Lefts, n.Rest, n.Rights)...)
+
+	// Control flow
+
+	case *parser.AndNode:
+		return New("and", c.compile(n.Left), c.compile(n.Right))
+	case *parser.OrNode:
+		return New("or", c.compile(n.Left), c.compile(n.Right))
+	case *parser.IfNode:
+		var alternative *Node
+		switch subsequent := n.Subsequent.(type) {
+		case *parser.ElseNode:
+			alternative = c.body(subsequent.Statements)
+		case *parser.IfNode:
+			alternative = c.compile(subsequent)
+		}
+		return New("if", c.compile(n.Predicate), c.body(n.Statements), alternative)
+	case *parser.UnlessNode:
+		var alternative *Node
+		if n.ElseClause != nil {
+			alternative = c.body(n.ElseClause.Statements)
+		}
+		return New("if", c.compile(n.Predicate), alternative, c.body(n.Statements))
+	case *parser.CaseNode:
+		children := []any{c.compile(n.Predicate)}
+		children = append(children, c.compileAll(n.Conditions)...)
+		if n.ElseClause != nil {
+			children = append(children, c.body(n.ElseClause.Statements))
+		} else {
+			children = append(children, nil)
+		}
+		return New("case", children...)
+	case *parser.WhenNode:
+		return New("when", append(c.compileAll(n.Conditions), c.body(n.Statements))...)
+	case *parser.CaseMatchNode:
+		children := []any{c.compile(n.Predicate)}
+		children = append(children, c.compileAll(n.Conditions)...)
+		switch {
+		case n.ElseClause == nil:
+			children = append(children, nil)
+		case n.ElseClause.Statements == nil:
+			children = append(children, New("empty_else"))
+		default:
+			children = append(children, c.body(n.ElseClause.Statements))
+		}
+		return New("case_match", children...)
+	case *parser.InNode:
+		return c.in(n)
+	case *parser.MatchPredicateNode:
+		return New("match_pattern_p", c.compile(n.Value), c.pattern(n.Pattern))
+	case *parser.MatchRequiredNode:
+		return New("match_pattern", c.compile(n.Value), c.pattern(n.Pattern))
+	case *parser.WhileNode:
+		if n.IsBEGIN_MODIFIER() {
+			return New("while_post", c.compile(n.Predicate), c.body(n.Statements))
+		}
+		return New("while", c.compile(n.Predicate), c.body(n.Statements))
+	case *parser.UntilNode:
+		if n.IsBEGIN_MODIFIER() {
+			return New("until_post", c.compile(n.Predicate), c.body(n.Statements))
+		}
+		return New("until", c.compile(n.Predicate), c.body(n.Statements))
+	case *parser.ForNode:
+		return New("for", c.compile(n.Index), c.compile(n.Collection), c.body(n.Statements))
+	case *parser.BreakNode:
+		return New("break", c.arguments(n.Arguments, nil, false)...)
+	case *parser.NextNode:
+		return New("next", c.arguments(n.Arguments, nil, false)...)
+	case *parser.ReturnNode:
+		return New("return", c.arguments(n.Arguments, nil, false)...)
+	case *parser.RedoNode:
+		return New("redo")
+	case *parser.RetryNode:
+		return New("retry")
+	case *parser.RescueModifierNode:
+		return New("rescue", c.compile(n.Expression), New("resbody", nil, nil, c.compile(n.RescueExpression)), nil)
+
+	// Definitions
+
+	case *parser.DefNode:
+		parameters := c.parameters(n.Parameters)
+		if n.Receiver != nil {
+			return New("defs", c.compile(n.Receiver), Symbol(n.Name), parameters, c.compile(n.Body))
+		}
+		return New("def", Symbol(n.Name), parameters, c.compile(n.Body))
+	case *parser.ClassNode:
+		return New("class", c.compile(n.ConstantPath), c.compile(n.Superclass), c.compile(n.Body))
+	case *parser.SingletonClassNode:
+		return New("sclass", c.compile(n.Expression), c.compile(n.Body))
+	case *parser.ModuleNode:
+		return New("module", c.compile(n.ConstantPath), c.compile(n.Body))
+	case *parser.AliasMethodNode:
+		return New("alias", c.compile(n.NewName), c.compile(n.OldName))
+	case *parser.AliasGlobalVariableNode:
+		return New("alias", c.compile(n.NewName), c.compile(n.OldName))
+	case *parser.UndefNode:
+		return New("undef", c.compileAll(n.Names)...)
+	}
+
+	return nil
+}
+
+// begin converts begin blocks and the implicit begin of method, class and
+// block bodies that have rescue, else or ensure clauses.
+func (c *compiler) begin(n *parser.BeginNode) *Node {
+	if n.RescueClause == nil && n.EnsureClause == nil && n.ElseClause == nil {
+		return New("kwbegin", c.statements(n.Statements)...)
+	}
+
+	body := c.body(n.Statements)
+	if n.RescueClause != nil {
+		children := []any{body}
+		for clause := n.RescueClause; clause != nil; clause = clause.Subsequent {
+			var exceptions *Node
+			if len(clause.Exceptions) > 0 {
+				exceptions = New("array", c.compileAll(clause.Exceptions)...)
+			}
+			children = append(children, New("resbody", exceptions, c.compile(clause.Reference), c.body(clause.Statements)))
+		}
+		if n.ElseClause != nil {
+			children = append(children, c.body(n.ElseClause.Statements))
+		} else {
+			children = append(children, nil)
+		}
+		body = New("rescue", children...)
+	} else if n.ElseClause != nil {
+		body = New("begin", body, c.body(n.ElseClause.Statements))
+	}
+	if n.EnsureClause != nil {
+		body = New("ensure", body, c.body(n.EnsureClause.Statements))
+	}
+
+	if n.BeginKeywordLoc == nil {
+		return body
+	}
+	return New("kwbegin", body)
+}
+
+// constantParent returns the scope of a constant path, (cbase) for ::Foo.
+func (c *compiler) constantParent(parent parser.Node) *Node {
+	if parent == nil {
+		return New("cbase")
+	}
+	return c.compile(parent)
+}
+
+func (c *compiler) constantPathTarget(target *parser.ConstantPathNode) *Node {
+	return New("casgn", c.constantParent(target.Parent), Symbol(optional(target.Name)))
+}
+
+// targets returns the targets of a multiple assignment.
+func (c *compiler) targets(lefts []parser.Node, rest parser.Node, rights []parser.Node) []any {
+	children := c.compileAll(lefts)
+	switch rest := rest.(type) {
+	case *parser.SplatNode:
+		if rest.Expression == nil {
+			children = append(children, New("splat"))
+		} else {
+			children = append(children, New("splat", c.compile(rest.Expression)))
+		}
+	}
+	return append(children, c.compileAll(rights)...)
+}
+
+func imaginary(numeric parser.Node) Complex {
+	switch n := numeric.(type) {
+	case *parser.IntegerNode:
+		return Complex{Imaginary: n.Value}
+	case *parser.FloatNode:
+		return Complex{Imaginary: n.Value}
+	case *parser.RationalNode:
+		return Complex{Imaginary: Rational{Numerator: n.Numerator, Denominator: n.Denominator}}
+	}
+	return Complex{Imaginary: int64(0)}
+}
+
+func optional(name *string) string {
+	if name == nil {
+		return ""
+	}
+	return *name
+}
@@ -0,0 +1,64 @@
+package whitequark
+
+import (
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+)
+
+// The expected s-expressions are those of the parser gem.
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"foo.bar(1, *a, &b)", `(send (send nil :foo) :bar (int 1) (splat (send nil :a)) (block-pass (send nil :b)))`},
+		{"a&.b = 1", `(csend (send nil :a) :b= (int 1))`},
+		{"x = 1; x += 2; y ||= 3", `(begin (lvasgn :x (int 1)) (op-asgn (lvasgn :x) :+ (int 2)) (or-asgn (lvasgn :y) (int 3)))`},
+		{"foo[1] += 2", `(op-asgn (indexasgn (send nil :foo) (int 1)) :+ (int 2))`},
+		{"A::B ||= 1", `(or-asgn (casgn (const nil :A) :B) (int 1))`},
+		{"a, (b, *c) = d", `(masgn (mlhs (lvasgn :a) (mlhs (lvasgn :b) (splat (lvasgn :c)))) (send nil :d))`},
+		{"def foo(a, b = 1, *c, d:, e: 2, **f, &g); end", `(def :foo (args (arg :a) (optarg :b (int 1)) (restarg :c) (kwarg :d) (kwoptarg :e (int 2)) (kwrestarg :f) (blockarg :g)) nil)`},
+		{"def self.bar = 42", `(defs (self) :bar (args) (int 42))`},
+		{"class Foo < Bar; def baz; end; end", `(class (const nil :Foo) (const nil :Bar) (def :baz (args) nil))`},
+		{"module A::B; end", `(module (const (const nil :A) :B) nil)`},
+		{`"a#{b}c"`, `(dstr (str "a") (begin (send nil :b)) (str "c"))`},
+		{`:"a#{1}"`, `(dsym (str "a") (begin (int 1)))`},
+		{"/x#{y}/im", `(regexp (str "x") (begin (send nil :y)) (regopt :i :m))`},
+		{"`ls #{dir}`", `(xstr (str "ls ") (begin (send nil :dir)))`},
+		{"x = <<~E\n  a\n    b\nE\n", `(lvasgn :x (dstr (str "a\n") (str "  b\n")))`},
+		{"[1, 2.5, 3r, 4i]", `(array (int 1) (float 2.5) (rational (3/1)) (complex (0+4i)))`},
+		{`{ a: 1, "b" => 2, **c }`, `(hash (pair (sym :a) (int 1)) (pair (str "b") (int 2)) (kwsplat (send nil :c)))`},
+		{"1..2", `(irange (int 1) (int 2))`},
+		{"a && b || !c", `(or (and (send nil :a) (send nil :b)) (send (send nil :c) :!))`},
+		{"if a then b elsif c then d else e end", `(if (send nil :a) (send nil :b) (if (send nil :c) (send nil :d) (send nil :e)))`},
+		{"foo unless bar", `(if (send nil :bar) nil (send nil :foo))`},
+		{"while x; y; end", `(while (send nil :x) (send nil :y))`},
+		{"begin; a; rescue Foo => e; b; else; c; ensure; d; end", `(kwbegin (ensure (rescue (send nil :a) (resbody (array (const nil :Foo)) (lvasgn :e) (send nil :b)) (send nil :c)) (send nil :d)))`},
+		{"case x; when 1, 2 then :a; else :b; end", `(case (send nil :x) (when (int 1) (int 2) (sym :a)) (sym :b))`},
+		{"case x; in [Integer => a, *] then a; in {k: String} then 1; end", `(case-match (send nil :x) (in-pattern (array-pattern (match-as (const nil :Integer) (match-var :a)) (match-rest)) nil (lvar :a)) (in-pattern (hash-pattern (pair (sym :k) (const nil :String))) nil (int 1)) nil)`},
+		{"foo { |x, (y, z)| x }", `(block (send nil :foo) (args (arg :x) (mlhs (arg :y) (arg :z))) (lvar :x))`},
+		{"-> (a) { a }", `(block (lambda) (args (arg :a)) (lvar :a))`},
+		{"def each = yield(1)", `(def :each (args) (yield (int 1)))`},
+		{"super(1)", `(super (int 1))`},
+		{"super", `(zsuper)`},
+		{"", `nil`},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := Translate(parsetest.Parse(t, test.source)).Inline(); got != test.want {
+				t.Errorf("Translate() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNodeString(t *testing.T) {
+	got := Translate(parsetest.Parse(t, "foo(1, bar)")).String()
+	want := `(send nil :foo
+  (int 1)
+  (send nil :bar))`
+	if got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,219 @@
+// Package treediff compares two versions of a Ruby file at the AST level,
+// following the GumTree algorithm: the greatest identical subtrees are
+// matched first, then the nodes whose descendants are mostly matched
+// together, and the differences between the matched trees are reported as
+// inserted, deleted, moved and updated nodes.
+//
+// Nodes are compared through the generic node metadata: their type, the
+// non-location fields that make up their value, and their children. Locations
+// and comments are not compared, so formatting-only changes yield no
+// changes.
+package treediff
+
+import (
+	"sort"
+
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Kind is the kind of a change.
+type Kind int
+
+const (
+	// Insert is a node of the new tree with no counterpart in the old one.
+	Insert Kind = iota
+	// Delete is a node of the old tree with no counterpart in the new one.
+	Delete
+	// Move is a node whose counterpart has another parent, or another place
+	// among the matched children of its parent.
+	Move
+	// Update is a node whose counterpart has another value, such as the
+	// name of a call or the value of a literal.
+	Update
+)
+
+var kindNames = enum.Names[Kind]{
+	Insert: "insert",
+	Delete: "delete",
+	Move:   "move",
+	Update: "update",
+}
+
+func (k Kind) String() string {
+	return kindNames.String(k)
+}
+
+// Change is a difference between the two trees. Insertions and deletions
+// are reported for the root of each inserted or deleted subtree only.
+type Change struct {
+	Kind Kind
+	// Old is the node of the old tree, nil for an insertion.
+	Old parser.Node
+	// New is the node of the new tree, nil for a deletion.
+	New parser.Node
+	// OldLocation is the location of Old, nil for an insertion.
+	OldLocation *parser.Location
+	// NewLocation is the location of New, nil for a deletion.
+	NewLocation *parser.Location
+}
+
+// Result is the outcome of a diff.
+type Result struct {
+	// Changes are ordered by their position in the new tree, or in the old
+	// tree for deletions.
+	Changes []Change
+	// Matches maps the NodeIDs of the old nodes that have a counterpart to
+	// the NodeIDs of the counterparts.
+	Matches map[int]int
+}
+
+type config struct {
+	minHeight int
+	minDice   float64
+}
+
+// Option configures Diff.
+type Option func(*config)
+
+// WithMinHeight sets the height below which subtrees are not matched in the
+// first phase, but only as children of matched nodes. It defaults to 2.
+func WithMinHeight(height int) Option {
+	return func(c *config) {
+		c.minHeight = height
+	}
+}
+
+// WithMinDice sets the ratio of common descendants above which two nodes
+// are matched in the second phase. It defaults to 0.5.
+func WithMinDice(dice float64) Option {
+	return func(c *config) {
+		c.minDice = dice
+	}
+}
+
+// Diff compares the programs of two parse results.
+func Diff(old, new *parser.ParseResult, options ...Option) *Result {
+	return DiffNodes(old.Value, new.Value, options...)
+}
+
+// DiffNodes compares two trees.
+func DiffNodes(old, new parser.Node, options ...Option) *Result {
+	c := &config{minHeight: 2, minDice: 0.5}
+	for _, option := range options {
+		option(c)
+	}
+	src, srcOrder := build(old)
+	dst, dstOrder := build(new)
+	m := &matcher{config: c, mapping: mapping{src: map[*tree]*tree{}, dst: map[*tree]*tree{}}}
+	m.topDown(src, dst)
+	m.bottomUp(src, dst, srcOrder)
+
+	result := &Result{Matches: map[int]int{}}
+	for _, a := range srcOrder {
+		b := m.mapping.src[a]
+		if b == nil {
+			if a.parent == nil || m.mapping.src[a.parent] != nil {
+				result.Changes = append(result.Changes, Change{Kind: Delete, Old: a.node, OldLocation: a.location()})
+			}
+			continue
+		}
+		result.Matches[a.node.GetNodeID()] = b.node.GetNodeID()
+		if a.value != b.value {
+			result.Changes = append(result.Changes, change(Update, a, b))
+		}
+	}
+	moved := m.moved(srcOrder)
+	for _, b := range dstOrder {
+		a := m.mapping.dst[b]
+		switch {
+		case a == nil && (b.parent == nil || m.mapping.dst[b.parent] != nil):
+			result.Changes = append(result.Changes, Change{Kind: Insert, New: b.node, NewLocation: b.location()})
+		case a != nil && moved[a]:
+			result.Changes = append(result.Changes, change(Move, a, b))
+		}
+	}
+	sort.SliceStable(result.Changes, func(i, j int) bool {
+		pi, pj := result.Changes[i].position(), result.Changes[j].position()
+		if pi != pj {
+			return pi < pj
+		}
+		return result.Changes[i].Kind < result.Changes[j].Kind
+	})
+	return result
+}
+
+func change(kind Kind, a, b *tree) Change {
+	return Change{Kind: kind, Old: a.node, New: b.node, OldLocation: a.location(), NewLocation: b.location()}
+}
+
+func (c Change) position() int {
+	if c.NewLocation != nil {
+		return c.NewLocation.StartOffset
+	}
+	return c.OldLocation.StartOffset
+}
+
+// moved returns the matched trees whose counterparts have another parent,
+// or are out of order among the matched children of the counterpart of
+// their parent.
+func (m *matcher) moved(postOrder []*tree) map[*tree]bool {
+	moved := map[*tree]bool{}
+	for _, p := range postOrder {
+		q := m.mapping.src[p]
+		if q == nil {
+			for _, c := range p.children {
+				if m.mapping.src[c] != nil {
+					moved[c] = true
+				}
+			}
+			continue
+		}
+		var kept []*tree
+		for _, c := range p.children {
+			if d := m.mapping.src[c]; d != nil {
+				if d.parent == q {
+					kept = append(kept, c)
+				} else {
+					moved[c] = true
+				}
+			}
+		}
+		for _, c := range outOfOrder(kept, m.mapping.src) {
+			moved[c] = true
+		}
+	}
+	return moved
+}
+
+// outOfOrder returns the children not in the longest sequence whose
+// counterparts keep their order.
+func outOfOrder(children []*tree, matched map[*tree]*tree) []*tree {
+	// Longest increasing subsequence of the positions of the counterparts.
+	n := len(children)
+	lengths := make([]int, n)
+	previous := make([]int, n)
+	best := -1
+	for i := range children {
+		lengths[i], previous[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if matched[children[j]].position < matched[children[i]].position && lengths[j]+1 > lengths[i] {
+				lengths[i], previous[i] = lengths[j]+1, j
+			}
+		}
+		if best < 0 || lengths[i] > lengths[best] {
+			best = i
+		}
+	}
+	inOrder := map[int]bool{}
+	for i := best; i >= 0; i = previous[i] {
+		inOrder[i] = true
+	}
+	var list []*tree
+	for i, c := range children {
+		if !inOrder[i] {
+			list = append(list, c)
+		}
+	}
+	return list
+}
@@ -0,0 +1,109 @@
+package treediff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		// want are the changes, as kind, node type and source of the new
+		// node, or of the old node for deletions.
+		want []string
+	}{
+		{
+			name: "formatting only",
+			old:  "foo(1)\nbar(2)\n",
+			new:  "foo( 1 )\n\nbar 2\n",
+		},
+		{
+			name: "literal spelling",
+			old:  "x = 10\n",
+			new:  "x = 0xa\n",
+		},
+		{
+			name: "update literal",
+			old:  "foo(1)\n",
+			new:  "foo(2)\n",
+			want: []string{"update IntegerNode 2"},
+		},
+		{
+			name: "update name",
+			old:  "def a\n  x = 1\nend\n",
+			new:  "def b\n  x = 1\nend\n",
+			want: []string{"update DefNode def b"},
+		},
+		{
+			name: "insert",
+			old:  "a = 1\nc = 3\n",
+			new:  "a = 1\nb = 2\nc = 3\n",
+			want: []string{"insert LocalVariableWriteNode b = 2"},
+		},
+		{
+			name: "delete",
+			old:  "a = 1\nb = 2\nc = 3\n",
+			new:  "a = 1\nc = 3\n",
+			want: []string{"delete LocalVariableWriteNode b = 2"},
+		},
+		{
+			name: "move among siblings",
+			old:  "a = 1\nb = 2\nc = 3\n",
+			new:  "a = 1\nc = 3\nb = 2\n",
+			want: []string{"move LocalVariableWriteNode c = 3"},
+		},
+		{
+			name: "move into an inserted node",
+			old:  "def f\n  puts 1\n  puts 2\nend\n",
+			new:  "def f\n  if x\n    puts 1\n  end\n  puts 2\nend\n",
+			want: []string{"insert IfNode if x", "move CallNode puts 1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old, new := parsetest.Parse(t, test.old), parsetest.Parse(t, test.new)
+			result := Diff(old, new)
+			var got []string
+			for _, c := range result.Changes {
+				node, location, source := c.New, c.NewLocation, new.Source
+				if c.Kind == Delete {
+					node, location, source = c.Old, c.OldLocation, old.Source
+				}
+				text, _, _ := strings.Cut(string(source.Slice(*location)), "\n")
+				got = append(got, fmt.Sprintf("%s %s %s", c.Kind, node.Type(), text))
+			}
+			if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+				t.Errorf("changes =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestDiffMatches(t *testing.T) {
+	old := parsetest.Parse(t, "foo(1)\n")
+	new := parsetest.Parse(t, "bar\nfoo(1)\n")
+	result := Diff(old, new)
+	oldCall := old.Value.Statements.Body[0]
+	newCall := new.Value.Statements.Body[1]
+	if id, ok := result.Matches[oldCall.GetNodeID()]; !ok || id != newCall.GetNodeID() {
+		t.Errorf("Matches[foo(1)] = %d, %v, want %d", id, ok, newCall.GetNodeID())
+	}
+	for _, c := range result.Changes {
+		if c.Kind != Insert || c.New.Type() != parser.NodeTypeCallNode || c.Old != nil || c.OldLocation != nil {
+			t.Errorf("unexpected change %+v", c)
+		}
+	}
+}
+
+func TestKindString(t *testing.T) {
+	for kind, want := range map[Kind]string{Insert: "insert", Delete: "delete", Move: "move", Update: "update", 9: "treediff.Kind(9)"} {
+		if got := kind.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package treediff
+
+import (
+	"sort"
+)
+
+// mapping holds the matched pairs of trees, in both directions.
+type mapping struct {
+	src map[*tree]*tree
+	dst map[*tree]*tree
+}
+
+func (m *mapping) add(a, b *tree) {
+	m.src[a] = b
+	m.dst[b] = a
+}
+
+// addIsomorphic matches two isomorphic subtrees node by node.
+func (m *mapping) addIsomorphic(a, b *tree) {
+	m.add(a, b)
+	for index := range a.children {
+		m.addIsomorphic(a.children[index], b.children[index])
+	}
+}
+
+// heightList is a priority list of trees by height.
+type heightList struct {
+	buckets map[int][]*tree
+	max     int
+}
+
+func newHeightList(root *tree) *heightList {
+	l := &heightList{buckets: map[int][]*tree{}}
+	l.push(root)
+	return l
+}
+
+func (l *heightList) push(t *tree) {
+	l.buckets[t.height] = append(l.buckets[t.height], t)
+	l.max = max(l.max, t.height)
+}
+
+// pop removes and returns the trees of the greatest height.
+func (l *heightList) pop() []*tree {
+	trees := l.buckets[l.max]
+	delete(l.buckets, l.max)
+	for l.max > 0 && len(l.buckets[l.max]) == 0 {
+		l.max--
+	}
+	return trees
+}
+
+func (l *heightList) open(t *tree) {
+	for _, c := range t.children {
+		l.push(c)
+	}
+}
+
+type matcher struct {
+	config  *config
+	mapping mapping
+}
+
+// topDown matches the greatest isomorphic subtrees first. Subtrees with
+// several isomorphic counterparts are matched last, preferring those whose
+// parents are most similar.
+func (m *matcher) topDown(src, dst *tree) {
+	l1, l2 := newHeightList(src), newHeightList(dst)
+	type candidate struct{ a, b *tree }
+	var candidates []candidate
+	for min(l1.max, l2.max) >= m.config.minHeight {
+		if l1.max != l2.max {
+			if l1.max > l2.max {
+				for _, t := range l1.pop() {
+					l1.open(t)
+				}
+			} else {
+				for _, t := range l2.pop() {
+					l2.open(t)
+				}
+			}
+			continue
+		}
+		h1, h2 := l1.pop(), l2.pop()
+		isomorphs1 := map[*tree][]*tree{}
+		isomorphs2 := map[*tree][]*tree{}
+		for _, a := range h1 {
+			for _, b := range h2 {
+				if isomorphic(a, b) {
+					isomorphs1[a] = append(isomorphs1[a], b)
+					isomorphs2[b] = append(isomorphs2[b], a)
+				}
+			}
+		}
+		for _, a := range h1 {
+			for _, b := range isomorphs1[a] {
+				if len(isomorphs1[a]) > 1 || len(isomorphs2[b]) > 1 {
+					candidates = append(candidates, candidate{a, b})
+				} else {
+					m.mapping.addIsomorphic(a, b)
+				}
+			}
+		}
+		for _, a := range h1 {
+			if len(isomorphs1[a]) == 0 {
+				l1.open(a)
+			}
+		}
+		for _, b := range h2 {
+			if len(isomorphs2[b]) == 0 {
+				l2.open(b)
+			}
+		}
+	}
+
+	score := func(c candidate) float64 {
+		if c.a.parent == nil || c.b.parent == nil {
+			return 0
+		}
+		return m.dice(c.a.parent, c.b.parent)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		si, sj := score(candidates[i]), score(candidates[j])
+		if si != sj {
+			return si > sj
+		}
+		return distance(candidates[i].a, candidates[i].b) < distance(candidates[j].a, candidates[j].b)
+	})
+	for _, c := range candidates {
+		if m.mapping.src[c.a] == nil && m.mapping.dst[c.b] == nil {
+			m.mapping.addIsomorphic(c.a, c.b)
+		}
+	}
+}
+
+// distance compares the positions of two trees among their siblings, to
+// prefer matching subtrees that stayed in place.
+func distance(a, b *tree) int {
+	d := a.position - b.position
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// bottomUp matches the remaining inner nodes whose descendants are mostly
+// matched together, then recovers matches among their children.
+func (m *matcher) bottomUp(src, dst *tree, postOrder []*tree) {
+	for _, a := range postOrder {
+		if a == src {
+			if m.mapping.src[a] == nil && m.mapping.dst[dst] == nil && a.label == dst.label {
+				m.mapping.add(a, dst)
+			}
+			if b := m.mapping.src[a]; b != nil {
+				m.recover(a, b)
+			}
+			break
+		}
+		if m.mapping.src[a] != nil || len(a.children) == 0 {
+			if b := m.mapping.src[a]; b != nil && len(a.children) > 0 {
+				m.recover(a, b)
+			}
+			continue
+		}
+		var best *tree
+		bestDice := -1.0
+		for _, b := range m.candidates(a) {
+			if d := m.dice(a, b); d > bestDice {
+				best, bestDice = b, d
+			}
+		}
+		if best != nil && bestDice >= m.config.minDice {
+			m.mapping.add(a, best)
+			m.recover(a, best)
+		}
+	}
+}
+
+// candidates returns the unmatched trees with the label of a that are
+// ancestors of the counterparts of its matched descendants.
+func (m *matcher) candidates(a *tree) []*tree {
+	var list []*tree
+	seen := map[*tree]bool{}
+	for _, d := range a.descendants() {
+		b := m.mapping.src[d]
+		if b == nil {
+			continue
+		}
+		for p := b.parent; p != nil && p.parent != nil && !seen[p]; p = p.parent {
+			seen[p] = true
+			if p.label == a.label && m.mapping.dst[p] == nil {
+				list = append(list, p)
+			}
+		}
+	}
+	return list
+}
+
+// dice returns the ratio of the descendants of a and b that are matched
+// together.
+func (m *matcher) dice(a, b *tree) float64 {
+	total := a.size - 1 + b.size - 1
+	if total == 0 {
+		return 0
+	}
+	common := 0
+	for _, d := range a.descendants() {
+		if c := m.mapping.src[d]; c != nil && ancestor(b, c) {
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(total)
+}
+
+func ancestor(a, t *tree) bool {
+	for p := t.parent; p != nil; p = p.parent {
+		if p == a {
+			return true
+		}
+	}
+	return false
+}
+
+// recover matches the unmatched children of two matched trees: isomorphic
+// subtrees first, then trees with the same label and value in the same
+// order, then trees with the same label and value, or the same label, found
+// once among the children on both sides.
+func (m *matcher) recover(a, b *tree) {
+	for _, pair := range lcs(m.unmatched(a.children, m.mapping.src), m.unmatched(b.children, m.mapping.dst), isomorphic) {
+		m.mapping.addIsomorphic(pair[0], pair[1])
+	}
+	sameValue := func(x, y *tree) bool { return x.label == y.label && x.value == y.value }
+	for _, pair := range lcs(m.unmatched(a.children, m.mapping.src), m.unmatched(b.children, m.mapping.dst), sameValue) {
+		m.mapping.add(pair[0], pair[1])
+		m.recover(pair[0], pair[1])
+	}
+	for _, same := range []func(x, y *tree) bool{sameValue, sameLabel} {
+		// Reordered children, when they are unambiguous.
+		left, right := m.unmatched(a.children, m.mapping.src), m.unmatched(b.children, m.mapping.dst)
+		for _, x := range left {
+			if y := unique(x, left, right, same); y != nil {
+				m.mapping.add(x, y)
+				m.recover(x, y)
+			}
+		}
+	}
+}
+
+func sameLabel(x, y *tree) bool {
+	return x.label == y.label
+}
+
+func (m *matcher) unmatched(trees []*tree, matched map[*tree]*tree) []*tree {
+	var list []*tree
+	for _, t := range trees {
+		if matched[t] == nil {
+			list = append(list, t)
+		}
+	}
+	return list
+}
+
+// unique returns the tree of right that is the same as x, if it is the
+// only one there and x is the only such tree of left.
+func unique(x *tree, left, right []*tree, same func(x, y *tree) bool) *tree {
+	for _, t := range left {
+		if t != x && same(t, x) {
+			return nil
+		}
+	}
+	var found *tree
+	for _, t := range right {
+		if same(x, t) {
+			if found != nil {
+				return nil
+			}
+			found = t
+		}
+	}
+	return found
+}
+
+// lcs returns the pairs of a longest common subsequence of a and b.
+func lcs(a, b []*tree, equal func(x, y *tree) bool) [][2]*tree {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if equal(a[i], b[j]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	var pairs [][2]*tree
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case equal(a[i], b[j]):
+			pairs = append(pairs, [2]*tree{a[i], b[j]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,125 @@
+package treediff
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// tree is a node of the trees being matched, with the metadata the matching
+// needs: a label, a value and hashes that ignore locations.
+type tree struct {
+	node     parser.Node
+	label    parser.NodeType
+	value    string
+	parent   *tree
+	children []*tree
+	// position is the index of the tree in its parent's children.
+	position int
+	// order is the index of the tree in the post-order of its tree.
+	order  int
+	height int
+	size   int
+	// hash identifies the subtree up to isomorphism.
+	hash uint64
+}
+
+// build converts the tree rooted at node, using the generic node metadata:
+// the children are the node fields in declaration order, and the value is
+// made of the other fields that are not locations.
+func build(node parser.Node) (*tree, []*tree) {
+	var postOrder []*tree
+	var visit func(node parser.Node, parent *tree, position int) *tree
+	visit = func(node parser.Node, parent *tree, position int) *tree {
+		t := &tree{node: node, label: node.Type(), value: value(node), parent: parent, position: position, height: 1, size: 1}
+		for _, child := range node.CompactChildNodes() {
+			c := visit(child, t, len(t.children))
+			t.children = append(t.children, c)
+			t.height = max(t.height, c.height+1)
+			t.size += c.size
+		}
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%d:%q(", t.label, t.value)
+		for _, c := range t.children {
+			fmt.Fprintf(h, "%x,", c.hash)
+		}
+		t.hash = h.Sum64()
+		t.order = len(postOrder)
+		postOrder = append(postOrder, t)
+		return t
+	}
+	return visit(node, nil, 0), postOrder
+}
+
+// formattingFlags are flags that only record how a literal was written.
+var formattingFlags = map[string]bool{
+	"BINARY":      true,
+	"DECIMAL":     true,
+	"OCTAL":       true,
+	"HEXADECIMAL": true,
+}
+
+// value returns the names, literal values and flags of a node. Lists of
+// constants, such as locals, are derived from the children and left out.
+func value(node parser.Node) string {
+	info := node.Type().Info()
+	if info == nil {
+		return ""
+	}
+	fields := node.ToJSON()
+	var parts []string
+	for _, field := range info.Fields {
+		switch field.Kind {
+		case parser.FieldKindString:
+			if s, ok := fields[field.Name].(parser.RubyString); ok {
+				parts = append(parts, s.Value)
+			}
+		case parser.FieldKindOptionalConstant:
+			if s, ok := fields[field.Name].(*string); ok && s != nil {
+				parts = append(parts, *s)
+			}
+		case parser.FieldKindConstant, parser.FieldKindUInt8, parser.FieldKindUInt32,
+			parser.FieldKindInteger, parser.FieldKindDouble:
+			parts = append(parts, fmt.Sprint(fields[field.Name]))
+		case parser.FieldKindFlags:
+			bits := node.Flags().Bits()
+			for _, flag := range field.Flags {
+				if bits&flag.Mask != 0 && !formattingFlags[flag.Name] {
+					parts = append(parts, flag.Name)
+				}
+			}
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// descendants returns the trees below t, in pre-order.
+func (t *tree) descendants() []*tree {
+	var list []*tree
+	for _, c := range t.children {
+		list = append(list, c)
+		list = append(list, c.descendants()...)
+	}
+	return list
+}
+
+// isomorphic reports whether two subtrees have the same labels, values and
+// shape.
+func isomorphic(a, b *tree) bool {
+	if a.hash != b.hash || a.label != b.label || a.value != b.value || len(a.children) != len(b.children) {
+		return false
+	}
+	for index := range a.children {
+		if !isomorphic(a.children[index], b.children[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *tree) location() *parser.Location {
+	location := t.node.GetLocation()
+	return &location
+}
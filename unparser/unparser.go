@@ -0,0 +1,17 @@
+// Package unparser generates Ruby source from a syntax tree, whether it was
+// parsed or built in Go. The output re-parses into an equivalent tree:
+// parentheses are only added where the shape of the tree requires them, and
+// the locations of parsed nodes are used as hints to keep their layout, such
+// as heredocs, modifier conditionals and brace blocks.
+package unparser
+
+import (
+	"github.com/danielgatis/go-ruby-prism/internal/printer"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Unparse returns the Ruby source of node, typically the Value of a
+// ParseResult.
+func Unparse(node parser.Node) (string, error) {
+	return printer.Print(node, printer.Options{})
+}
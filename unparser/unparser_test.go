@@ -0,0 +1,107 @@
+package unparser
+
+import (
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/translation/whitequark"
+)
+
+// TestRoundTrip checks that the unparsed source parses into the same tree,
+// compared through the location-free s-expressions of the parser gem, and
+// that unparsing it again gives the same source.
+func TestRoundTrip(t *testing.T) {
+	sources := []string{
+		"foo.bar(1, *a, **h, &b)",
+		"a&.b ||= c",
+		"x = y = 1",
+		"a, (b, *c) = d",
+		"def foo(a, b = 1, *c, d:, e: 2, **f, &g)\n  a + b\nend",
+		"def self.bar = 42",
+		"class Foo < Bar\n  include Baz\n  attr_reader :x\n\n  def initialize(x) = @x = x\nend",
+		"module A::B; end",
+		`"a#{b}c #@x"`,
+		`:"a#{1}"`,
+		"/x#{y}/im",
+		"%w[a b] + %i[c d]",
+		"[1, 2.5, 3r, 4i, nil, true]",
+		`{ a: 1, "b" => 2, **c }`,
+		"if a then b elsif c then d else e end",
+		"foo unless bar",
+		"x = (a if b)",
+		"while x; y; end",
+		"begin; a; rescue Foo, Bar => e; b; else; c; ensure; d; end",
+		"case x; when 1, 2 then :a; else :b; end",
+		"y = 1\ncase x\nin [Integer => a, *rest] if a > 0 then a\nin {k: String => s} then s\nin ^y | nil then 0\nend",
+		"foo { |x, (y, z)| x }",
+		"foo do |a, *| a end",
+		"-> (a, b = 2) { a + b }",
+		"(a + b) * c",
+		"a - (b - c)",
+		"-a ** 2",
+		"!(a && b)",
+		"a and not b",
+		"x = <<~E\n  hello #{name}\n    world\nE\n",
+		"puts(<<~A, <<~B)\n  a\nA\n  b\nB\n",
+		"foo[1] += 2",
+		"A::B ||= 1",
+		"defined?(@x)",
+		"alias new old",
+		"undef foo, bar",
+		"BEGIN { a }",
+		"(1..)",
+		"a ? b : c",
+		"return 1, 2",
+		"def each = yield",
+		"super(1)",
+		"foo&.bar&.baz",
+		"x = *a, 1",
+		`puts "a" "b"`,
+	}
+	for _, source := range sources {
+		t.Run(source, func(t *testing.T) {
+			original := parsetest.Parse(t, source)
+			unparsed, err := Unparse(original.Value)
+			if err != nil {
+				t.Fatalf("Unparse() failed: %v", err)
+			}
+			reparsed := parsetest.Parse(t, unparsed)
+			if got, want := whitequark.Translate(reparsed).Inline(), whitequark.Translate(original).Inline(); got != want {
+				t.Fatalf("Unparse() = %q, which parses into\n%s\nwant\n%s", unparsed, got, want)
+			}
+			again, err := Unparse(reparsed.Value)
+			if err != nil {
+				t.Fatalf("Unparse() of the output failed: %v", err)
+			}
+			if again != unparsed {
+				t.Errorf("Unparse() of the output = %q, want %q", again, unparsed)
+			}
+		})
+	}
+}
+
+func TestUnparse(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"foo(bar,baz)", "foo(bar, baz)"},
+		{"module A::B; end", "module A::B\nend"},
+		{"if a then b else c end", "if a\n  b\nelse\n  c\nend"},
+		{"%w[a b]", `["a", "b"]`},
+		{"alias new old", "alias :new :old"},
+		{"x = <<~E\n  a\nE\n", "x = <<~HEREDOC\n  a\nHEREDOC\n"},
+		{"-> (a) { a }", "->(a) { a }"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			got, err := Unparse(parsetest.Parse(t, test.source).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("Unparse() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Package visibility works out whether each method definition is public,
+// private or protected, following the ways Ruby sets it in class and
+// module bodies:
+//
+//	private                       # the methods defined after are private
+//	private def helper; end       # a single method
+//	private :a, :b                # methods defined before
+//	module_function               # private, with a public singleton copy
+//	private_class_method :build   # singleton methods
+//	class << self; private; end   # singleton methods defined within
+//
+// Methods are public by default, except those defined at the top level,
+// which are private methods of Object, and initialize,
+// initialize_copy, initialize_clone, initialize_dup and
+// respond_to_missing?, which are always private. Singleton methods defined
+// with def self.name are public unless made private with
+// private_class_method. A block, such as that of Class.new or an
+// ActiveSupport::Concern included block, starts with public visibility
+// like a class body.
+//
+//	result := visibility.Analyze(root)
+//	for _, m := range result.Methods {
+//		fmt.Println(m.Node.Name, m.Level)
+//	}
+package visibility
+
+import (
+	"github.com/danielgatis/go-ruby-prism/internal/enum"
+	"github.com/danielgatis/go-ruby-prism/parser"
+)
+
+// Level is the visibility of a method.
+type Level int
+
+const (
+	// Public methods can be called with any receiver.
+	Public Level = iota
+	// Private methods can only be called without a receiver or on self.
+	Private
+	// Protected methods can be called on instances of the class they are
+	// defined in.
+	Protected
+)
+
+var levelNames = enum.Names[Level]{
+	Public:    "public",
+	Private:   "private",
+	Protected: "protected",
+}
+
+func (l Level) String() string {
+	return levelNames.String(l)
+}
+
+// Method is a method definition with its visibility.
+type Method struct {
+	Node  *parser.DefNode
+	Level Level
+	// Singleton reports whether the method is a singleton method, defined
+	// with def self.name or within class << self.
+	Singleton bool
+	// ModuleFunction reports whether module_function applies to the
+	// method, which makes it private and defines a public singleton method
+	// of the same name.
+	ModuleFunction bool
+}
+
+// Result holds the visibility of the methods of a tree.
+type Result struct {
+	// Methods are the method definitions in source order.
+	Methods []*Method
+	nodes   map[*parser.DefNode]*Method
+}
+
+// Method returns the visibility of a method definition, or nil if the
+// definition is not in the tree.
+func (r *Result) Method(node *parser.DefNode) *Method {
+	return r.nodes[node]
+}
+
+// alwaysPrivate are the methods Ruby makes private wherever they are
+// defined.
+var alwaysPrivate = map[string]bool{
+	"initialize":          true,
+	"initialize_copy":     true,
+	"initialize_clone":    true,
+	"initialize_dup":      true,
+	"respond_to_missing?": true,
+}
+
+// Analyze works out the visibility of the method definitions of the tree
+// rooted at root.
+func Analyze(root parser.Node) *Result {
+	r := &Result{nodes: map[*parser.DefNode]*Method{}}
+	if root != nil {
+		top := newFrame()
+		top.level = Private
+		r.visit(root, top)
+	}
+	return r
+}
+
+// frame is the visibility state of a class, module or singleton class
+// body, a block or a method body.
+type frame struct {
+	level          Level
+	moduleFunction bool
+	// singleton is set within class << self.
+	singleton bool
+	// methods are the methods defined in the body by name, the last
+	// definition of each, and singletons the singleton methods of self.
+	methods    map[string]*Method
+	singletons map[string]*Method
+}
+
+func newFrame() *frame {
+	return &frame{methods: map[string]*Method{}, singletons: map[string]*Method{}}
+}
+
+func (r *Result) visit(node parser.Node, f *frame) {
+	if node == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *parser.ClassNode:
+		r.visit(n.Superclass, f)
+		r.visit(n.Body, newFrame())
+		return
+	case *parser.ModuleNode:
+		r.visit(n.Body, newFrame())
+		return
+	case *parser.SingletonClassNode:
+		r.visit(n.Expression, f)
+		inner := newFrame()
+		inner.singleton = true
+		if _, ok := n.Expression.(*parser.SelfNode); ok {
+			// Methods defined within class << self are the singleton methods
+			// of the class body.
+			inner.methods = f.singletons
+		}
+		r.visit(n.Body, inner)
+		return
+	case *parser.BlockNode:
+		r.visit(n.Parameters, f)
+		r.visit(n.Body, newFrame())
+		return
+	case *parser.DefNode:
+		r.define(n, f, f.level, f.moduleFunction)
+		return
+	case *parser.CallNode:
+		if r.call(n, f) {
+			return
+		}
+	}
+	for _, child := range node.CompactChildNodes() {
+		r.visit(child, f)
+	}
+}
+
+// define records a method definition with the visibility it is defined
+// with, and visits its body.
+func (r *Result) define(node *parser.DefNode, f *frame, level Level, moduleFunction bool) *Method {
+	m := &Method{Node: node, Level: level}
+	switch {
+	case node.Receiver != nil:
+		m.Singleton, m.Level = true, Public
+		if _, ok := node.Receiver.(*parser.SelfNode); ok {
+			f.singletons[node.Name] = m
+		}
+	case f.singleton:
+		m.Singleton = true
+		f.methods[node.Name] = m
+	default:
+		if moduleFunction {
+			m.Level, m.ModuleFunction = Private, true
+		}
+		f.methods[node.Name] = m
+	}
+	if alwaysPrivate[node.Name] && !m.Singleton {
+		m.Level = Private
+	}
+	r.Methods = append(r.Methods, m)
+	r.nodes[node] = m
+	r.visit(node.Receiver, f)
+	if node.Parameters != nil {
+		r.visit(node.Parameters, newFrame())
+	}
+	r.visit(node.Body, newFrame())
+	return m
+}
+
+var levels = map[string]Level{
+	"public":    Public,
+	"private":   Private,
+	"protected": Protected,
+}
+
+// call applies a call that sets visibility, and reports whether it is one.
+func (r *Result) call(call *parser.CallNode, f *frame) bool {
+	switch call.Receiver.(type) {
+	case nil, *parser.SelfNode:
+	default:
+		return false
+	}
+	var arguments []parser.Node
+	if call.Arguments != nil {
+		arguments = call.Arguments.Arguments
+	}
+	switch name := call.Name; name {
+	case "public", "private", "protected":
+		if len(arguments) == 0 {
+			f.level, f.moduleFunction = levels[name], false
+			return true
+		}
+		r.apply(arguments, f, f.methods, func(m *Method) { m.Level = levels[name] }, func(def *parser.DefNode) {
+			r.define(def, f, levels[name], false)
+		})
+		return true
+	case "module_function":
+		if len(arguments) == 0 {
+			f.level, f.moduleFunction = Private, true
+			return true
+		}
+		r.apply(arguments, f, f.methods, func(m *Method) { m.Level, m.ModuleFunction = Private, true }, func(def *parser.DefNode) {
+			r.define(def, f, Private, true)
+		})
+		return true
+	case "private_class_method", "public_class_method":
+		level := Private
+		if name == "public_class_method" {
+			level = Public
+		}
+		r.apply(arguments, f, f.singletons, func(m *Method) { m.Level = level }, func(def *parser.DefNode) {
+			r.define(def, f, Public, false).Level = level
+		})
+		return true
+	}
+	return false
+}
+
+// apply applies a visibility call with arguments: names of methods defined
+// before, given as symbols, strings or arrays of them, and method
+// definitions. Other arguments are visited.
+func (r *Result) apply(arguments []parser.Node, f *frame, methods map[string]*Method, set func(*Method), define func(*parser.DefNode)) {
+	for _, argument := range arguments {
+		switch a := argument.(type) {
+		case *parser.DefNode:
+			define(a)
+		case *parser.SymbolNode, *parser.StringNode:
+			if m := methods[literal(a)]; m != nil {
+				set(m)
+			}
+		case *parser.ArrayNode:
+			for _, element := range a.Elements {
+				if m := methods[literal(element)]; m != nil {
+					set(m)
+				}
+			}
+		default:
+			r.visit(argument, f)
+		}
+	}
+}
+
+func literal(node parser.Node) string {
+	switch n := node.(type) {
+	case *parser.SymbolNode:
+		return n.Unescaped.Value
+	case *parser.StringNode:
+		return n.Unescaped.Value
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+package visibility_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/danielgatis/go-ruby-prism/internal/parsetest"
+	"github.com/danielgatis/go-ruby-prism/parser"
+	"github.com/danielgatis/go-ruby-prism/visibility"
+)
+
+// describe lists methods as name:level, with . before singleton methods
+// and a trailing + for module functions.
+func describe(result *visibility.Result) string {
+	parts := make([]string, len(result.Methods))
+	for index, m := range result.Methods {
+		name := m.Node.Name
+		if m.Singleton {
+			name = "." + name
+		}
+		parts[index] = name + ":" + m.Level.String()
+		if m.ModuleFunction {
+			parts[index] += "+"
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"def top; end", "top:private"},
+		{"class A\n  def a; end\n  private\n  def b; end\n  protected\n  def c; end\n  public\n  def d; end\nend", "a:public b:private c:protected d:public"},
+		{"class A\n  private def a; end\n  def b; end\nend", "a:private b:public"},
+		{"class A\n  def a; end\n  def b; end\n  def c; end\n  private :a, \"b\"\n  protected [:c]\nend", "a:private b:private c:protected"},
+		{"class A\n  private :later\n  def later; end\nend", "later:public"},
+		{"module M\n  module_function\n  def a; end\n  public\n  def b; end\nend", "a:private+ b:public"},
+		{"module M\n  def a; end\n  module_function :a\n  module_function def b; end\nend", "a:private+ b:private+"},
+		{"class A\n  def initialize; end\n  public def respond_to_missing?(*) = true\nend", "initialize:private respond_to_missing?:private"},
+		{"class A\n  private\n  def self.a; end\n  def self.b; end\n  private_class_method :a\n  private_class_method def self.c; end\nend", ".a:private .b:public .c:private"},
+		{"class A\n  class << self\n    def a; end\n    private\n    def b; end\n  end\n  public_class_method :b\nend", ".a:public .b:public"},
+		{"class A\n  class << self\n    def initialize; end\n  end\nend", ".initialize:public"},
+		{"class A\n  private\n  Class.new do\n    def a; end\n  end\n  included { def b; end }\n  def c; end\nend", "a:public b:public c:private"},
+		{"class A\n  private\n  class B\n    def a; end\n  end\n  def b; end\nend", "a:public b:private"},
+		{"class A\n  def a\n    private\n  end\n  def b; end\nend", "a:public b:public"},
+		{"class A\n  obj.private\n  def a; end\n  self.private\n  def b; end\nend", "a:public b:private"},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if got := describe(visibility.Analyze(parsetest.Parse(t, test.source).Value)); got != test.want {
+				t.Errorf("methods = %s\nwant      %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMethod(t *testing.T) {
+	result := parsetest.Parse(t, "class A\n  private\n  def a; end\nend")
+	analysis := visibility.Analyze(result.Value)
+	def := analysis.Methods[0].Node
+	if m := analysis.Method(def); m == nil || m.Level != visibility.Private {
+		t.Errorf("Method(a) = %+v", m)
+	}
+	if analysis.Method(&parser.DefNode{}) != nil {
+		t.Error("Method() found a definition outside of the tree")
+	}
+	if len(visibility.Analyze(nil).Methods) != 0 {
+		t.Error("Analyze(nil) found methods")
+	}
+	if got := visibility.Level(3).String(); got != "visibility.Level(3)" {
+		t.Errorf("Level(3).String() = %q", got)
+	}
+}